@@ -2,13 +2,15 @@ module protoc-gen-go-json
 
 go 1.21rc2
 
-require google.golang.org/protobuf v1.31.0
+require (
+	github.com/stretchr/testify v1.8.4
+	google.golang.org/protobuf v1.31.0
+)
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/google/go-cmp v0.5.9 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	github.com/stretchr/objx v0.5.0 // indirect
-	github.com/stretchr/testify v1.8.4 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -0,0 +1,215 @@
+package json
+
+import (
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// GenerateMessageDecode generate json decode function
+func (f *File) GenerateMessageDecode(ctx *Context, msg *protogen.Message) error {
+	runtimePackage := protogen.GoImportPath(ctx.ImportRuntime)
+
+	f.P()
+	f.P("// ", msg.Desc.FullName())
+	f.P("func (", Instance, " *", msg.GoIdent, ") ", ctx.DecodeMethodName, "(data []byte) error {")
+	if opts := DecodeOptions(ctx); opts != "" {
+		f.P(Dec, " := ", runtimePackage.Ident("UnmarshalOptions"), "{", opts, "}.NewDecoder(data)")
+	} else {
+		f.P(Dec, " := ", runtimePackage.Ident("NewDecoder"), "(data)")
+	}
+	f.P("if ", Dec, ".ReadNull() {")
+	f.P("return ", Dec, ".End()")
+	f.P("}")
+	f.P(Instance, ".Reset()")
+	f.P("if err := ", Instance, ".", DecodeFromMethodName, "(", Dec, "); err != nil {")
+	f.P("return ", runtimePackage.Ident("WithMessage"), "(err, ", strconv.Quote(string(msg.Desc.FullName())), ")")
+	f.P("}")
+	f.P("return ", Dec, ".End()")
+	f.P("}")
+	f.P()
+
+	f.P("func (", Instance, " *", msg.GoIdent, ") ", DecodeFromMethodName,
+		"(", Dec, " *", runtimePackage.Ident("Decoder"), ") error {")
+	f.P("if err := ", Dec, ".ObjectStart(); err != nil {")
+	f.P("return err")
+	f.P("}")
+	// 每个字段与 oneof 占一个 bit, 记录已经读到的字段, 检查重复的 key 与同一个 oneof 的多个成员
+	bits := len(msg.Fields) + len(msg.Oneofs)
+	switch {
+	case len(msg.Fields) == 0:
+	case bits <= 64:
+		f.P("var seen uint64")
+	default:
+		f.P("var seen [", (bits+63)/64, "]uint64")
+	}
+	f.P("for {")
+	f.P("key, ok, err := ", Dec, ".ObjectNext()")
+	f.P("if err != nil {")
+	f.P("return err")
+	f.P("}")
+	f.P("if !ok {")
+	f.P("return nil")
+	f.P("}")
+	f.P("switch string(key) {")
+	for _, fd := range msg.Fields {
+		f.GenerateFieldDecode(ctx, fd, bits)
+	}
+	// 未定义的 key 按解码器的 DiscardUnknown 选项跳过或返回错误
+	f.P("default:")
+	f.P("if err = ", Dec, ".Unknown(", strconv.Quote(string(msg.Desc.FullName())), ", key); err != nil {")
+	f.P("return err")
+	f.P("}")
+	f.P("}")
+	f.P("}")
+	f.P("}")
+	f.P()
+
+	return nil
+}
+
+// DecodeOptions 返回生成的 UnmarshalJSON 使用的 runtime.UnmarshalOptions 字段, 都是默认值时返回空字符串
+func DecodeOptions(ctx *Context) string {
+	var opts []string
+	if ctx.DiscardUnknown {
+		opts = append(opts, "DiscardUnknown: true")
+	}
+	if ctx.AllowDuplicates {
+		opts = append(opts, "AllowDuplicates: true")
+	}
+	return strings.Join(opts, ", ")
+}
+
+// seenBit 返回 seen 中第 i 个 bit 所在的变量与掩码, bits 不超过 64 时 seen 是 uint64, 否则是数组
+func seenBit(bits, i int) (string, string) {
+	mask := "1<<" + strconv.Itoa(i%64)
+	if bits <= 64 {
+		return "seen", mask
+	}
+	return "seen[" + strconv.Itoa(i/64) + "]", mask
+}
+
+// GenerateFieldDecode 生成单个字段的 case 分支, json name 与 proto name 都可以匹配.
+// 出错时通过 runtime.WithField 等在错误中加上 proto 字段名, list 加上下标, map 加上 key.
+// 重复的 key 在读取 null 之前检查, oneof 的多个成员在之后检查, map 中重复的 key 在读取值之前检查, 与 protojson 一致
+func (f *File) GenerateFieldDecode(ctx *Context, fd *protogen.Field, bits int) {
+	runtimePackage := protogen.GoImportPath(ctx.ImportRuntime)
+	name := strconv.Quote(string(fd.Desc.Name()))
+	if jsonName := fd.Desc.JSONName(); jsonName != string(fd.Desc.Name()) {
+		f.P("case \"", jsonName, "\", ", name, ":")
+	} else {
+		f.P("case ", name, ":")
+	}
+	f.P("// ", fd.Desc.Kind())
+
+	field := Instance + "." + fd.GoName
+	seen, mask := seenBit(bits, fd.Desc.Index())
+	f.P("if ", seen, "&(", mask, ") != 0 {")
+	f.P("if err = ", Dec, ".Duplicate(", strconv.Quote(string(fd.Parent.Desc.FullName())), ", key); err != nil {")
+	f.P("return err")
+	f.P("}")
+	if fd.Desc.IsList() || fd.Desc.IsMap() {
+		// AllowDuplicates 时后出现的 list 与 map 替换之前的, 而不是合并
+		f.P(field, " = nil")
+	}
+	f.P("}")
+	f.P(seen, " |= ", mask)
+	if !AcceptNull(fd) {
+		f.P("if ", Dec, ".ReadNull() {")
+		f.P("continue")
+		f.P("}")
+	}
+	oneof := fd.Oneof != nil && !fd.Oneof.Desc.IsSynthetic()
+	if oneof {
+		seen, mask := seenBit(bits, len(fd.Parent.Fields)+fd.Oneof.Desc.Index())
+		f.P("if ", seen, "&(", mask, ") != 0 {")
+		f.P("if err = ", Dec, ".OneofConflict(", strconv.Quote(string(fd.Oneof.Desc.FullName())), ", key); err != nil {")
+		f.P("return err")
+		f.P("}")
+		f.P("}")
+		f.P(seen, " |= ", mask)
+	}
+
+	ret := []interface{}{runtimePackage.Ident("WithField"), "(err, ", name, ")"}
+	switch {
+	case fd.Desc.IsList():
+		f.P("if err = ", Dec, ".ArrayStart(); err != nil {")
+		f.P(append([]interface{}{"return "}, ret...)...)
+		f.P("}")
+		f.P("for i := 0; ; i++ {")
+		f.P("if ok, err = ", Dec, ".ArrayNext(); err != nil {")
+		f.P(append([]interface{}{"return "}, ret...)...)
+		f.P("} else if !ok {")
+		f.P("break")
+		f.P("}")
+		DecodeValue(ctx, f.GeneratedFile, fd.Desc, fd.Message, fd.Enum, "v",
+			[]interface{}{runtimePackage.Ident("WithIndex"), "(err, ", name, ", i)"})
+		f.P(field, " = append(", field, ", v)")
+		f.P("}")
+	case fd.Desc.IsMap():
+		keyField, valField := fd.Message.Fields[0], fd.Message.Fields[1]
+		f.P("if err = ", Dec, ".ObjectStart(); err != nil {")
+		f.P(append([]interface{}{"return "}, ret...)...)
+		f.P("}")
+		f.P("if ", field, " == nil {")
+		f.P(field, " = make(map[", GoType(f.GeneratedFile, keyField), "]", GoType(f.GeneratedFile, valField), ")")
+		f.P("}")
+		f.P("for {")
+		f.P("k, ok, err := ", Dec, ".ObjectNext()")
+		f.P("if err != nil {")
+		f.P(append([]interface{}{"return "}, ret...)...)
+		f.P("}")
+		f.P("if !ok {")
+		f.P("break")
+		f.P("}")
+		DecodeMapKey(f.GeneratedFile, keyField.Desc.Kind(), "k", "mk", ret)
+		withKey := []interface{}{runtimePackage.Ident("WithKey"), "(err, ", name, ", mk)"}
+		f.P("if _, dup := ", field, "[mk]; dup {")
+		f.P("if err = ", Dec, ".DuplicateKey(k); err != nil {")
+		f.P(append([]interface{}{"return "}, withKey...)...)
+		f.P("}")
+		f.P("}")
+		DecodeValue(ctx, f.GeneratedFile, valField.Desc, valField.Message, valField.Enum, "v", withKey)
+		f.P(field, "[mk] = v")
+		f.P("}")
+	case oneof:
+		DecodeValue(ctx, f.GeneratedFile, fd.Desc, fd.Message, fd.Enum, "v", ret)
+		f.P(Instance, ".", fd.Oneof.GoName, " = &", fd.GoIdent, "{", fd.GoName, ": v}")
+	case fd.Desc.HasOptionalKeyword() && fd.Desc.Kind() != protoreflect.MessageKind:
+		DecodeValue(ctx, f.GeneratedFile, fd.Desc, fd.Message, fd.Enum, "v", ret)
+		f.P(field, " = &v")
+	default:
+		DecodeValue(ctx, f.GeneratedFile, fd.Desc, fd.Message, fd.Enum, "v", ret)
+		f.P(field, " = v")
+	}
+}
+
+// GoType 返回字段值在 go 代码中的类型, 用于 map 的 make
+func GoType(gf *protogen.GeneratedFile, fd *protogen.Field) string {
+	switch fd.Desc.Kind() {
+	case protoreflect.BoolKind:
+		return "bool"
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return "int32"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return "uint32"
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return "int64"
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return "uint64"
+	case protoreflect.FloatKind:
+		return "float32"
+	case protoreflect.DoubleKind:
+		return "float64"
+	case protoreflect.StringKind:
+		return "string"
+	case protoreflect.BytesKind:
+		return "[]byte"
+	case protoreflect.EnumKind:
+		return gf.QualifiedGoIdent(fd.Enum.GoIdent)
+	default:
+		return "*" + gf.QualifiedGoIdent(fd.Message.GoIdent)
+	}
+}
@@ -0,0 +1,90 @@
+package json
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// DecodeValue 生成读取单个值到新变量 name 的代码, 出错时返回 ret, ret 为加上字段路径的 err 表达式
+func DecodeValue(ctx *Context, gf *protogen.GeneratedFile, desc protoreflect.FieldDescriptor,
+	msg *protogen.Message, enum *protogen.Enum, name string, ret []interface{}) {
+	switch kind := desc.Kind(); kind {
+	case protoreflect.EnumKind:
+		runtimePackage := protogen.GoImportPath(ctx.ImportRuntime)
+		values := protogen.GoIdent{GoName: enum.GoIdent.GoName + "_value", GoImportPath: enum.GoIdent.GoImportPath}
+		read := runtimePackage.Ident("ReadEnum")
+		if enum.Desc.FullName() == "google.protobuf.NullValue" {
+			read = runtimePackage.Ident("ReadNullValue")
+		}
+		gf.P(name, ", err := ", read, "[", enum.GoIdent, "](", Dec, ", ", values, ")")
+		gf.P("if err != nil {")
+		gf.P(append([]interface{}{"return "}, ret...)...)
+		gf.P("}")
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if WellKnownDecode(ctx, gf, msg, name, ret) {
+			return
+		}
+		gf.P(name, " := new(", msg.GoIdent, ")")
+		gf.P("if err := ", name, ".", DecodeFromMethodName, "(", Dec, "); err != nil {")
+		gf.P(append([]interface{}{"return "}, ret...)...)
+		gf.P("}")
+	default:
+		gf.P(name, ", err := ", Dec, ".", ReadMethod(kind), "()")
+		gf.P("if err != nil {")
+		gf.P(append([]interface{}{"return "}, ret...)...)
+		gf.P("}")
+	}
+}
+
+// DecodeMapKey 生成把 object key 转换为 map key 的代码, 出错时返回 ret
+func DecodeMapKey(gf *protogen.GeneratedFile, kind protoreflect.Kind, key, name string, ret []interface{}) {
+	if kind == protoreflect.StringKind {
+		gf.P(name, " := string(", key, ")")
+		return
+	}
+	gf.P(name, ", err := ", Dec, ".", KeyMethod(kind), "(", key, ")")
+	gf.P("if err != nil {")
+	gf.P(append([]interface{}{"return "}, ret...)...)
+	gf.P("}")
+}
+
+// ReadMethod 返回读取标量值的 runtime.Decoder 方法名, 按 HandlerType 相同的分组选择位数,
+// 整数都接受数字与字符串形式以及 1e2, 1.0 等值为整数的写法, 超出位数范围时返回错误
+func ReadMethod(kind protoreflect.Kind) string {
+	switch kind {
+	case protoreflect.BoolKind:
+		return "ReadBool"
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return "ReadInt32"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return "ReadUint32"
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return "ReadInt64"
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return "ReadUint64"
+	case protoreflect.FloatKind:
+		return "ReadFloat32"
+	case protoreflect.DoubleKind:
+		return "ReadFloat64"
+	case protoreflect.StringKind:
+		return "ReadString"
+	default:
+		return "ReadBytes"
+	}
+}
+
+// KeyMethod 返回解析 map key 的 runtime.Decoder 方法名
+func KeyMethod(kind protoreflect.Kind) string {
+	switch kind {
+	case protoreflect.BoolKind:
+		return "KeyBool"
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return "KeyInt32"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return "KeyUint32"
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return "KeyInt64"
+	default:
+		return "KeyUint64"
+	}
+}
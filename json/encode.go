@@ -18,6 +18,9 @@ type Context struct {
 }
 
 func (c *Context) Generate() error {
+	if err := c.ValidateWriter(); err != nil {
+		return err
+	}
 	for _, file := range c.Files {
 		f := File{File: file}
 		err := f.Generate(c)
@@ -31,6 +34,10 @@ func (c *Context) Generate() error {
 type File struct {
 	*protogen.File
 	*protogen.GeneratedFile
+
+	// 当前 message 的逗号状态, 见 PlanComma
+	comma    commaState
+	commaVar bool
 }
 
 func (f *File) Generate(ctx *Context) error {
@@ -87,168 +94,345 @@ func (f *File) GenerateMessage(ctx *Context, msg *protogen.Message) error {
 		return nil
 	}
 	// generate json encode function
-	f.P("// ", msg.Desc.FullName())
+	f.GenerateMarshal(ctx, msg)
+	f.GenerateWriteTo(ctx, msg)
+	f.GenerateSizeHint(ctx, msg)
+	runtimePackage := protogen.GoImportPath(ctx.ImportRuntime)
+	f.P("// ", EncodeToMethodName, " 追加 ", msg.Desc.FullName(), " 的 json 编码到 dst, ", StreamVar, " 不为 nil 时在 list 与 map 元素之间分段写出")
+	f.P("func (", Instance, " *", msg.GoIdent, ") ", EncodeToMethodName, "(", Dst, " []byte, ", StreamVar, " *", runtimePackage.Ident("Stream"), ") ([]byte, error) {")
+	f.P("if ", Instance, " == nil {")
+	f.P("return append(", Dst, ", \"null\"...), nil")
+	f.P("}")
 	if len(msg.Fields) == 0 {
-		f.P("func (", Instance, " *", msg.GoIdent, ") ", ctx.EncodeMethodName, "() ([]byte, error) {")
-		f.P("return []byte(\"{}\"),nil")
+		f.P("return append(", Dst, ", \"{}\"...), nil")
 		f.P("}")
-		return nil
+		return f.GenerateMessageDecode(ctx, msg)
 	}
-	f.P("func (", Instance, " *", msg.GoIdent, ") ", ctx.EncodeMethodName, "() ([]byte, error) {")
-	f.P("if ", Instance, " == nil {")
-	f.P("return nil,nil")
-	f.P("}")
-	protoimplPackage := protogen.GoImportPath(ctx.ImportWriter)
-
-	f.P("var ", Buf, " ", protoimplPackage.Ident("Buffer"))
-	f.P(Buf, WriteByte, `('{')`)
-	size := len(msg.Fields)
-	if size > 1 {
+	f.P(AppendTo, "'{')")
+	f.PlanComma(ctx, msg)
+	if f.commaVar {
 		f.P("var ", CommaVarName, " bool")
 	}
 
-	for i := 0; i < size; i++ {
-		f.P("// go name ", msg.Fields[i].GoName, " : kind ", msg.Fields[i].Desc.Kind())
-		oneof := msg.Fields[i].Oneof != nil && !msg.Fields[i].Oneof.Desc.IsSynthetic()
-		if oneof {
-			i = f.GenerateMessageOneof(ctx, msg.Fields[i], size) - 1
-		} else {
-			f.P("// number ", msg.Fields[i].Desc.Number())
-			f.GenerateMessageField(ctx, msg.Fields[i], size)
+	for _, fd := range msg.Fields {
+		f.P("// go name ", fd.GoName, " : kind ", fd.Desc.Kind())
+		if oneof := fd.Oneof != nil && !fd.Oneof.Desc.IsSynthetic(); !oneof {
+			f.P("// number ", fd.Desc.Number())
+			f.GenerateMessageField(ctx, fd)
+		} else if fd == fd.Oneof.Fields[0] {
+			// oneof 的全部字段在第一个字段处一起生成
+			f.GenerateMessageOneof(ctx, fd)
 		}
 	}
-	f.P(Buf, WriteByte, `('}')`)
-	f.P("return ", Buf, ctx.WriteBytes, ",nil")
+	f.P(AppendTo, "'}')")
+	f.P("return ", Dst, ", nil")
+	f.P("}")
+
+	return f.GenerateMessageDecode(ctx, msg)
+}
+
+// GenerateWriteTo 生成 AppendJSON 与 WriteJSONTo, 两者都是 EncodeJSON 的包装, 后者通过 runtime.Stream 分段写出
+func (f *File) GenerateWriteTo(ctx *Context, msg *protogen.Message) {
+	runtimePackage := protogen.GoImportPath(ctx.ImportRuntime)
+	f.P("// ", AppendMethodName, " 追加 ", msg.Desc.FullName(), " 的 json 编码到 dst")
+	f.P("func (", Instance, " *", msg.GoIdent, ") ", AppendMethodName, "(", Dst, " []byte) ([]byte, error) {")
+	f.P("return ", Instance, ".", EncodeToMethodName, "(", Dst, ", nil)")
+	f.P("}")
+	f.P()
+	f.P("// ", WriteToMethodName, " 把 ", msg.Desc.FullName(), " 的 json 编码分段写入 w, 返回写入的字节数")
+	f.P("func (", Instance, " *", msg.GoIdent, ") ", WriteToMethodName, "(w ", protogen.GoImportPath("io").Ident("Writer"), ") (int64, error) {")
+	f.P(StreamVar, " := ", runtimePackage.Ident("NewStream"), "(w)")
+	f.P(Dst, ", err := ", Instance, ".", EncodeToMethodName, "(", StreamVar, ".Buffer(), ", StreamVar, ")")
+	f.P("return ", StreamVar, ".Done(", Dst, ", err)")
 	f.P("}")
 	f.P()
+}
 
-	return nil
+// GenerateMarshal 生成 EncodeMethodName 方法, 从 writer 取得可用的 buffer 交给 AppendJSON 追加
+func (f *File) GenerateMarshal(ctx *Context, msg *protogen.Message) {
+	if ctx.Writer == WriterPool {
+		f.P("var ", SizeHintVar(msg), " ", protogen.GoImportPath(ctx.ImportRuntime).Ident("SizeHint"))
+		f.P()
+	}
+	f.P("// ", msg.Desc.FullName())
+	f.P("func (", Instance, " *", msg.GoIdent, ") ", ctx.EncodeMethodName, "() ([]byte, error) {")
+	f.GenerateNewWriter(ctx, msg)
+	if ctx.writerGrow {
+		f.P(Buf, ".Grow(", Instance, ".", SizeHintMethodName, "())")
+	}
+	f.P("data, err := ", Instance, ".", AppendMethodName, "(", Buf, ".AvailableBuffer())")
+	f.P("if err != nil {")
+	if ctx.Writer == WriterPool {
+		f.P(Buf, ".Release()")
+	}
+	f.P("return nil, err")
+	f.P("}")
+	f.P(Buf, WriteBytes, "(data)")
+	f.P("return ", Buf, ctx.WriteBytes, ", nil")
+	f.P("}")
+	f.P()
 }
 
-func (f *File) GenerateMessageField(ctx *Context, fd *protogen.Field, size int) {
+func (f *File) GenerateMessageField(ctx *Context, fd *protogen.Field) {
 	switch {
+	case fd.Oneof != nil && !fd.Oneof.Desc.IsSynthetic():
+		// 已设置的 oneof 成员与 protojson 一致总是写出, 零值标量写零值, nil message 写成 {}
+		f.WriteComma(ctx, fd)
+		f.P(AppendTo, "`\"", FieldKey(ctx, fd), "\":`...)")
+		f.GenerateElement(ctx, fd.Desc, Instance+"."+fd.GoName)
 	case fd.Desc.IsList():
-		f.P("if len(", Instance, ".", fd.GoName, ") > 0 {")
-		f.WirteCommaAndTrue(fd)
-		f.P(Buf, WriteString, "(`\"", fd.Desc.JSONName(), "\":[`)")
+		// EmitUnpopulated 模式下空 list 也要写出
+		if !ctx.EmitUnpopulated {
+			f.P("if len(", Instance, ".", fd.GoName, ") > 0 {")
+		}
+		f.WriteComma(ctx, fd)
+		f.P(AppendTo, "`\"", FieldKey(ctx, fd), "\":[`...)")
 		f.P("for i,val := range ", Instance, ".", fd.GoName, "{")
 		f.P("// ", fd.Desc.Kind())
 		f.P(" if i > 0 {")
-		f.P(Buf, WriteByte, CommaValue)
+		f.P(AppendTo, CommaValue)
 		f.P("}")
-		_ = HandlerType(ctx, fd.Desc.Kind(), f.GeneratedFile, false, "val")
-		f.P("}")
-		f.P(Buf, WriteByte, "(']')")
-		f.WirteCommaTrue(fd, size)
+		f.GenerateElement(ctx, fd.Desc, "val")
+		f.P(Dst, " = ", StreamVar, ".Flush(", Dst, ")")
 		f.P("}")
+		f.P(AppendTo, "']')")
+		if !ctx.EmitUnpopulated {
+			f.P("}")
+		}
 	case fd.Desc.IsMap():
-		f.P("if len(", Instance, ".", fd.GoName, ") > 0 {")
-		f.WirteCommaAndTrue(fd)
-		f.P(Buf, WriteString, "(`\"", fd.Desc.JSONName(), "\":{`)")
+		// EmitUnpopulated 模式下空 map 也要写出, 仍用代码块限定 many 的作用域
+		if ctx.EmitUnpopulated {
+			f.P("{")
+		} else {
+			f.P("if len(", Instance, ".", fd.GoName, ") > 0 {")
+		}
+		f.WriteComma(ctx, fd)
+		f.P(AppendTo, "`\"", FieldKey(ctx, fd), "\":{`...)")
 		f.P("var many bool")
-		f.P("for key,val := range ", Instance, ".", fd.GoName, "{")
+		f.GenerateMapRange(ctx, fd)
 		f.P("// ", fd.Desc.Kind(), ", key ", fd.Desc.MapKey().Kind(), ", value ", fd.Desc.MapValue().Kind())
 		f.P("if many {")
-		f.P(Buf, WriteByte, CommaValue)
+		f.P(AppendTo, CommaValue)
 		f.P("} else {")
 		f.P("many=true")
 		f.P("}")
-		_ = HandlerType(ctx, fd.Desc.MapKey().Kind(), f.GeneratedFile, true, "key")
-		f.P(Buf, WriteByte, `(':')`)
-		_ = HandlerType(ctx, fd.Desc.MapValue().Kind(), f.GeneratedFile, false, "val")
+		_ = HandlerType(ctx, fd.Desc.MapKey(), f.GeneratedFile, true, "key")
+		f.P(AppendTo, "':')")
+		f.GenerateElement(ctx, fd.Desc.MapValue(), "val")
+		f.P(Dst, " = ", StreamVar, ".Flush(", Dst, ")")
 		f.P("}")
-		f.P(Buf, WriteByte, "('}')")
-		f.WirteCommaTrue(fd, size)
+		f.P(AppendTo, "'}')")
 		f.P("}")
 	case fd.Desc.Kind() == protoreflect.EnumKind:
 		expr := fmt.Sprintf("%s.%s", Instance, fd.GoName)
 		if fd.Desc.HasOptionalKeyword() {
 			f.P("if ", expr, " != nil {")
-			f.WirteCommaAndTrue(fd)
-			f.P(Buf, WriteString, "(`\"", fd.Desc.JSONName(), "\":`)")
-			_ = HandlerType(ctx, fd.Desc.Kind(), f.GeneratedFile, false, expr)
-			f.WirteCommaTrue(fd, size)
+			f.WriteComma(ctx, fd)
+			f.P(AppendTo, "`\"", FieldKey(ctx, fd), "\":`...)")
+			_ = HandlerType(ctx, fd.Desc, f.GeneratedFile, false, "*"+expr)
+			f.P("}")
+		} else if cond, ok := CheckTypeIsDefault(ctx, expr, fd); ok {
+			// 未设置的 NullValue 与 protojson 一致省略
+			f.P("if ", cond, " {")
+			f.WriteComma(ctx, fd)
+			f.P(AppendTo, "`\"", FieldKey(ctx, fd), "\":`...)")
+			_ = HandlerType(ctx, fd.Desc, f.GeneratedFile, false, expr)
 			f.P("}")
 		} else {
-			f.WirteCommaAndTrue(fd)
-			f.P(Buf, WriteString, "(`\"", fd.Desc.JSONName(), "\":`)")
-			_ = HandlerType(ctx, fd.Desc.Kind(), f.GeneratedFile, false, expr)
-			f.WirteCommaTrue(fd, size)
+			f.WriteComma(ctx, fd)
+			f.P(AppendTo, "`\"", FieldKey(ctx, fd), "\":`...)")
+			_ = HandlerType(ctx, fd.Desc, f.GeneratedFile, false, expr)
 		}
+	case fd.Desc.Kind() == protoreflect.MessageKind && ctx.EmitUnpopulated && fd.Oneof == nil:
+		// 未设置的 message 写成 null, optional 与 oneof 仍然省略
+		expr := Instance + "." + fd.GoName
+		f.WriteComma(ctx, fd)
+		f.P(AppendTo, "`\"", FieldKey(ctx, fd), "\":`...)")
+		f.P("if ", expr, " == nil {")
+		f.P(AppendTo, "\"null\"...)")
+		f.P("} else {")
+		_ = HandlerType(ctx, fd.Desc, f.GeneratedFile, false, expr)
+		f.P("}")
 	case fd.Desc.Kind() == protoreflect.MessageKind:
 		expr, ok := CheckTypeIsDefault(ctx, Instance+"."+fd.GoName, fd)
 		if ok {
 			f.P("if ", expr, "{")
-			f.WirteCommaAndTrue(fd)
-			f.P(Buf, WriteString, "(`\"", fd.Desc.JSONName(), "\":`)")
-			_ = HandlerType(ctx, fd.Desc.Kind(), f.GeneratedFile, false, Instance+"."+fd.GoName)
-			f.WirteCommaTrue(fd, size)
+			f.WriteComma(ctx, fd)
+			f.P(AppendTo, "`\"", FieldKey(ctx, fd), "\":`...)")
+			_ = HandlerType(ctx, fd.Desc, f.GeneratedFile, false, Instance+"."+fd.GoName)
 			f.P("}")
 		}
 	default:
 		if fd.Desc.HasOptionalKeyword() {
 			expr := fmt.Sprintf("%s.%s", Instance, fd.GoName)
 			f.P("if ", expr, " != nil {")
-			f.WirteCommaAndTrue(fd)
-			f.P(Buf, WriteString, "(`\"", fd.Desc.JSONName(), "\":`)")
-			_ = HandlerType(ctx, fd.Desc.Kind(), f.GeneratedFile, false, "*"+expr)
-			f.WirteCommaTrue(fd, size)
+			f.WriteComma(ctx, fd)
+			f.P(AppendTo, "`\"", FieldKey(ctx, fd), "\":`...)")
+			_ = HandlerType(ctx, fd.Desc, f.GeneratedFile, false, "*"+expr)
 			f.P("}")
 		} else if expr, ok := CheckTypeIsDefault(ctx, Instance+"."+fd.GoName, fd); ok {
 			f.P("if ", expr, "{")
-			f.WirteCommaAndTrue(fd)
-			f.P(Buf, WriteString, "(`\"", fd.Desc.JSONName(), "\":`)")
-			_ = HandlerType(ctx, fd.Desc.Kind(), f.GeneratedFile, false, Instance+"."+fd.GoName)
-			f.WirteCommaTrue(fd, size)
+			f.WriteComma(ctx, fd)
+			f.P(AppendTo, "`\"", FieldKey(ctx, fd), "\":`...)")
+			_ = HandlerType(ctx, fd.Desc, f.GeneratedFile, false, Instance+"."+fd.GoName)
 			f.P("}")
 		} else {
-			f.WirteCommaAndTrue(fd)
-			f.P(Buf, WriteString, "(`\"", fd.Desc.JSONName(), "\":`)")
-			_ = HandlerType(ctx, fd.Desc.Kind(), f.GeneratedFile, false, Instance+"."+fd.GoName)
-			f.WirteCommaTrue(fd, size)
+			f.WriteComma(ctx, fd)
+			f.P(AppendTo, "`\"", FieldKey(ctx, fd), "\":`...)")
+			_ = HandlerType(ctx, fd.Desc, f.GeneratedFile, false, Instance+"."+fd.GoName)
 		}
 	}
 }
 
-// WirteCommaTrue wirte first filed end set true
-func (f *File) WirteCommaTrue(fd *protogen.Field, size int) {
-	if fd.Desc.Number() == 1 && size > 1 {
-		f.P(CommaVarName, "=true")
+// GenerateMapRange 生成遍历 map 的 for 语句, 循环变量为 key, val.
+// Deterministic 模式下 bool key 依次检查 false, true, 其他 key 排序后遍历
+func (f *File) GenerateMapRange(ctx *Context, fd *protogen.Field) {
+	expr := Instance + "." + fd.GoName
+	switch {
+	case !ctx.Deterministic:
+		f.P("for key,val := range ", expr, "{")
+	case fd.Desc.MapKey().Kind() == protoreflect.BoolKind:
+		f.P("for _, key := range [2]bool{false, true} {")
+		f.P("val, ok := ", expr, "[key]")
+		f.P("if !ok {")
+		f.P("continue")
+		f.P("}")
+	default:
+		// 不超过 16 个 key 时在栈上的数组里排序, 避免每次编码都分配 key slice
+		runtimePackage := protogen.GoImportPath(ctx.ImportRuntime)
+		f.P("var keys [16]", GoType(f.GeneratedFile, fd.Message.Fields[0]))
+		f.P("for _, key := range ", runtimePackage.Ident("SortedKeys"), "(", expr, ", keys[:0]) {")
+		f.P("val := ", expr, "[key]")
+	}
+}
+
+// GenerateElement 生成 list 元素与 map value 的写入代码,
+// 与 protojson 一致, nil message 按空 message 写成 {}, well-known type 按零值写出
+func (f *File) GenerateElement(ctx *Context, desc protoreflect.FieldDescriptor, name string) {
+	if desc.Kind() != protoreflect.MessageKind || IsWellKnown(desc.Message()) {
+		_ = HandlerType(ctx, desc, f.GeneratedFile, false, name)
+		return
 	}
+	f.P("if ", name, " == nil {")
+	f.P(AppendTo, "\"{}\"...)")
+	f.P("} else {")
+	_ = HandlerType(ctx, desc, f.GeneratedFile, false, name)
+	f.P("}")
 }
 
 // GenerateMessageOneof generate oneof field
-//
-//	return used number
-func (f *File) GenerateMessageOneof(ctx *Context, fd *protogen.Field, size int) int {
+func (f *File) GenerateMessageOneof(ctx *Context, fd *protogen.Field) {
 	f.P("// ", fd.Oneof.GoName, " ", fd.GoName)
 	f.P("if ", Instance, ".", fd.Oneof.GoName, " != nil {")
 	f.P("switch ", Instance, ":=", Instance, ".", fd.Oneof.GoName, ".(type) {")
 
+	// 每个 case 都从同一个逗号状态开始
+	state := f.comma
 	for _, field := range fd.Oneof.Fields {
 		f.P("// ", field.GoName, " ", field.GoIdent, " ", field.Desc.Number())
 		f.P("case *", field.GoIdent, ":")
-		f.GenerateMessageField(ctx, field, size)
-		size = int(field.Desc.Number())
+		f.comma = state
+		f.GenerateMessageField(ctx, field)
 	}
 	f.P("}")
 	f.P("}")
-	return size
 }
 
-// WirteCommaAndTrue if comma is false change true
-func (f *File) WirteCommaAndTrue(fd *protogen.Field) {
-	if fd.Desc.Number() > 1 {
+// commaState 生成代码时记录在当前位置之前是否已经写出过字段
+type commaState int
+
+const (
+	// commaNone 之前没有写出任何字段, 不需要逗号
+	commaNone commaState = iota
+	// commaMaybe 之前的字段都是有条件写出的, 运行时通过 writeComma 判断
+	commaMaybe
+	// commaAlways 之前至少有一个字段一定会写出, 直接写逗号
+	commaAlways
+)
+
+// next 返回写出一个字段之后的状态, conditional 表示该字段是否有条件写出
+func (s commaState) next(conditional bool) commaState {
+	if s == commaNone && conditional {
+		return commaMaybe
+	}
+	if conditional {
+		return s
+	}
+	return commaAlways
+}
+
+// PlanComma 按声明顺序模拟逗号状态, 只有存在 commaMaybe 状态的字段时才需要 writeComma 变量
+func (f *File) PlanComma(ctx *Context, msg *protogen.Message) {
+	f.comma, f.commaVar = commaNone, false
+	state := commaNone
+	for _, fd := range msg.Fields {
+		if fd.Oneof != nil && !fd.Oneof.Desc.IsSynthetic() && fd != fd.Oneof.Fields[0] {
+			continue
+		}
+		if state == commaMaybe {
+			f.commaVar = true
+		}
+		state = state.next(IsConditional(ctx, fd))
+	}
+}
+
+// WriteComma 在字段 key 之前写逗号, 与字段编号无关, 只取决于之前字段的写出状态
+func (f *File) WriteComma(ctx *Context, fd *protogen.Field) {
+	conditional := IsConditional(ctx, fd)
+	switch f.comma {
+	case commaNone:
+		if conditional && f.commaVar {
+			f.P(CommaVarName, " = true")
+		}
+	case commaMaybe:
 		f.P("if ", CommaVarName, " {")
-		f.P(Buf, WriteByte, CommaValue)
-		f.P("} else {")
-		f.P(CommaVarName, "=true")
+		f.P(AppendTo, CommaValue)
+		if conditional {
+			f.P("} else {")
+			f.P(CommaVarName, " = true")
+		}
 		f.P("}")
+	case commaAlways:
+		f.P(AppendTo, CommaValue)
+	}
+	f.comma = f.comma.next(conditional)
+}
+
+// IsConditional 字段是否只在满足条件时写出, 必须与 GenerateMessageField 的分支保持一致.
+// oneof 成员在分支内总是写出, 但只在 oneof 设置为该成员时才会执行
+func IsConditional(ctx *Context, fd *protogen.Field) bool {
+	if fd.Oneof != nil && !fd.Oneof.Desc.IsSynthetic() {
+		return true
+	}
+	switch {
+	case fd.Desc.IsList(), fd.Desc.IsMap():
+		return !ctx.EmitUnpopulated
+	case fd.Desc.Kind() == protoreflect.EnumKind:
+		_, ok := CheckTypeIsDefault(ctx, "", fd)
+		return fd.Desc.HasOptionalKeyword() || ok
+	case fd.Desc.Kind() == protoreflect.MessageKind:
+		return !ctx.EmitUnpopulated || fd.Oneof != nil
+	case fd.Desc.HasOptionalKeyword():
+		return true
+	default:
+		_, ok := CheckTypeIsDefault(ctx, "", fd)
+		return ok
+	}
+}
+
+// FieldKey 返回字段写出的 json key, 默认 lowerCamelCase, UseProtoNames 模式下使用 proto 字段名
+func FieldKey(ctx *Context, fd *protogen.Field) string {
+	if ctx.UseProtoNames {
+		return string(fd.Desc.Name())
 	}
+	return fd.Desc.JSONName()
 }
 
-// CheckTypeIsDefault 检查 type is default
-func CheckTypeIsDefault(_ *Context, val string, fd *protogen.Field) (string, bool) {
+// CheckTypeIsDefault 检查 type is default, EmitUnpopulated 模式下标量不检查
+func CheckTypeIsDefault(ctx *Context, val string, fd *protogen.Field) (string, bool) {
+	if ctx.EmitUnpopulated && fd.Desc.Kind() != protoreflect.MessageKind {
+		return "", false
+	}
 	switch fd.Desc.Kind() {
 	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Uint32Kind,
 		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Uint64Kind,
@@ -260,6 +444,12 @@ func CheckTypeIsDefault(_ *Context, val string, fd *protogen.Field) (string, boo
 		return fmt.Sprintf("len(%s) != 0", val), true
 	case protoreflect.MessageKind:
 		return fmt.Sprintf("%s != nil", val), true
+	case protoreflect.EnumKind:
+		// 枚举的零值总是写出, 只有 NullValue 未设置时省略, 写出 null 无法与未设置区分
+		if fd.Desc.Enum().FullName() == "google.protobuf.NullValue" {
+			return fmt.Sprintf("%v != 0", val), true
+		}
+		return "", false
 	default:
 		return "", false
 	}
@@ -6,90 +6,113 @@ import (
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
-func HandlerType(ctx *Context, kind protoreflect.Kind, gf *protogen.GeneratedFile, mapKey bool, name string) error {
-	switch kind {
+func HandlerType(ctx *Context, desc protoreflect.FieldDescriptor, gf *protogen.GeneratedFile, mapKey bool, name string) error {
+	switch kind := desc.Kind(); kind {
 	case protoreflect.BoolKind:
 		Bool(gf, mapKey, name)
-	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Uint32Kind,
-		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Uint64Kind,
-		protoreflect.Sfixed32Kind, protoreflect.Fixed32Kind,
-		protoreflect.Fixed64Kind, protoreflect.Sfixed64Kind:
-		Integer(gf, mapKey, name)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		Integer(gf, true, mapKey, name)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		Integer(gf, false, mapKey, name)
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		// proto3 json 规定 64 位整数编码为字符串
+		Integer(gf, true, mapKey || !ctx.Int64AsNumber, name)
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		Integer(gf, false, mapKey || !ctx.Int64AsNumber, name)
 	case protoreflect.DoubleKind:
-		Float(gf, name, 64)
+		Float(ctx, gf, name, 64)
 	case protoreflect.FloatKind:
-		Float(gf, name, 32)
+		Float(ctx, gf, name, 32)
 	case protoreflect.StringKind:
-		String(gf, name)
+		String(ctx, gf, name)
 	case protoreflect.BytesKind:
-		Bytes(gf, name)
+		Bytes(ctx, gf, name)
 	case protoreflect.EnumKind:
-		Enum(gf, name)
+		Enum(ctx, gf, desc.Enum(), name)
 	case protoreflect.MessageKind:
-		MessageWriteType(ctx, gf, name)
+		if !WellKnownEncode(ctx, gf, desc.Message(), name) {
+			MessageWriteType(gf, name)
+		}
 	default:
 		return errors.New("not support type " + kind.String())
 	}
 	return nil
 }
 
-func Integer(gf *protogen.GeneratedFile, mapKey bool, name string) {
-	if mapKey {
-		gf.P(Buf, WriteByte, "('\"')")
+// Integer 有符号类型使用 AppendInt, 无符号类型使用 AppendUint, quoted 时写成 json 字符串
+func Integer(gf *protogen.GeneratedFile, signed bool, quoted bool, name string) {
+	if quoted {
+		gf.P(AppendTo, "'\"')")
 	}
 	protoimplPackage := protogen.GoImportPath("strconv")
-	gf.P(Buf, WriteString, "(", protoimplPackage.Ident("FormatUint"), "(uint64(", name, "),10))")
-	if mapKey {
-		gf.P(Buf, WriteByte, "('\"')")
+	if signed {
+		gf.P(Dst, " = ", protoimplPackage.Ident("AppendInt"), "(", Dst, ", int64(", name, "), 10)")
+	} else {
+		gf.P(Dst, " = ", protoimplPackage.Ident("AppendUint"), "(", Dst, ", uint64(", name, "), 10)")
+	}
+	if quoted {
+		gf.P(AppendTo, "'\"')")
 	}
 }
 
-func Float(gf *protogen.GeneratedFile, name string, bitSize int) {
-	protoimplPackage := protogen.GoImportPath("strconv")
-	gf.P(Buf, WriteString, "(", protoimplPackage.Ident("FormatFloat"),
-		"(float64(", name, "),'f', -1,", bitSize, "))")
+// Float NaN 与正负无穷写成字符串, 极大或极小的值使用指数格式
+func Float(ctx *Context, gf *protogen.GeneratedFile, name string, bitSize int) {
+	runtimePackage := protogen.GoImportPath(ctx.ImportRuntime)
+	gf.P(Dst, " = ", runtimePackage.Ident("AppendFloat"), "(", Dst, ", float64(", name, "), ", bitSize, ")")
 }
 
-func String(gf *protogen.GeneratedFile, name string) {
-	gf.P(Buf, WriteByte, "('\"')")
-	gf.P(Buf, WriteString, "(", name, ")")
-	gf.P(Buf, WriteByte, "('\"')")
+// String 转义后写入 json 字符串, map 的 string key 也经过这里
+func String(ctx *Context, gf *protogen.GeneratedFile, name string) {
+	runtimePackage := protogen.GoImportPath(ctx.ImportRuntime)
+	appendString := runtimePackage.Ident("AppendString")
+	if ctx.EscapeHTML {
+		appendString = runtimePackage.Ident("AppendStringHTML")
+	}
+	AppendChecked(gf, appendString, "(", Dst, ", ", name, ")")
 }
 
-func Bytes(gf *protogen.GeneratedFile, name string) {
-	protoimplPackage := protogen.GoImportPath("encoding/base64")
-	gf.P(Buf, WriteByte, "('\"')")
-	gf.P(Buf, WriteString, "(", protoimplPackage.Ident("StdEncoding"),
-		".EncodeToString", "(", name, "))")
-	gf.P(Buf, WriteByte, "('\"')")
+func Bytes(ctx *Context, gf *protogen.GeneratedFile, name string) {
+	runtimePackage := protogen.GoImportPath(ctx.ImportRuntime)
+	gf.P(Dst, " = ", runtimePackage.Ident("AppendBytes"), "(", Dst, ", ", name, ")")
 }
 
-func Enum(gf *protogen.GeneratedFile, name string) {
-	gf.P(Buf, WriteByte, "('\"')")
-	gf.P(Buf, WriteString, "(", name, ".String())")
-	gf.P(Buf, WriteByte, "('\"')")
+// Enum 默认写枚举名, 未知的枚举值写成数字, UseEnumNumbers 模式下总是写数字,
+// google.protobuf.NullValue 与 protojson 一致写成 null, 未设置的单个 NullValue 字段由 GenerateMessageField 省略
+func Enum(ctx *Context, gf *protogen.GeneratedFile, enum protoreflect.EnumDescriptor, name string) {
+	switch {
+	case enum.FullName() == "google.protobuf.NullValue":
+		// NullValue 只有 NULL_VALUE 一个值, list 与 map 的循环变量不会被用到
+		gf.P("_ = ", name)
+		gf.P(AppendTo, `"null"...)`)
+	case ctx.UseEnumNumbers:
+		Integer(gf, true, false, name)
+	default:
+		runtimePackage := protogen.GoImportPath(ctx.ImportRuntime)
+		gf.P(Dst, " = ", runtimePackage.Ident("AppendEnum"), "(", Dst, ", ", name, ")")
+	}
 }
 
+// Bool map key 写成 json 字符串
 func Bool(gf *protogen.GeneratedFile, mapKey bool, name string) {
-	gf.P("if ", name, " {")
 	if mapKey {
-		gf.P(Buf, WriteString, `("\"true\"")`)
-	} else {
-		gf.P(Buf, WriteString, `("true")`)
+		gf.P(AppendTo, "'\"')")
 	}
-	gf.P("} else {")
+	gf.P(Dst, " = ", protogen.GoImportPath("strconv").Ident("AppendBool"), "(", Dst, ", ", name, ")")
 	if mapKey {
-		gf.P(Buf, WriteString, `("\"false\"")`)
-	} else {
-		gf.P(Buf, WriteString, `("false")`)
+		gf.P(AppendTo, "'\"')")
 	}
-	gf.P("}")
 }
 
-func MessageWriteType(ctx *Context, gf *protogen.GeneratedFile, name string) {
-	gf.P("if data, err := ", name, ".", ctx.EncodeMethodName, "(); err != nil {")
-	gf.P("return nil,err")
+// MessageWriteType 嵌套 message 直接追加到 dst, 不再经过中间的 buffer, 分段写出时共用同一个 Stream
+func MessageWriteType(gf *protogen.GeneratedFile, name string) {
+	AppendChecked(gf, name, ".", EncodeToMethodName, "(", Dst, ", ", StreamVar, ")")
+}
+
+// AppendChecked 生成调用可能失败的追加函数的代码, call 为返回 ([]byte, error) 的调用表达式
+func AppendChecked(gf *protogen.GeneratedFile, call ...interface{}) {
+	gf.P(append(append([]interface{}{"if data, err := "}, call...), "; err != nil {")...)
+	gf.P("return ", Dst, ", err")
 	gf.P("} else {")
-	gf.P(Buf, WriteBytes, "(data)")
+	gf.P(Dst, " = data")
 	gf.P("}")
 }
@@ -0,0 +1,205 @@
+package json
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// 标量编码后的最大长度, 64 位整数按带引号计算
+const (
+	sizeBool    = len("false")
+	sizeInt32   = len("-2147483648")
+	sizeInt64   = len(`"-9223372036854775808"`)
+	sizeFloat   = len("-1.7976931348623157e+308")
+	sizeNull    = len("null")
+	sizeUnknown = 64
+)
+
+// wellKnownSizes 没有简单估算方法的 well-known type 按固定大小估算
+var wellKnownSizes = map[protoreflect.FullName]int{
+	"google.protobuf.Timestamp": len(`"2006-01-02T15:04:05.999999999Z"`),
+	"google.protobuf.Duration":  len(`"-315576000000.999999999s"`),
+	"google.protobuf.Empty":     len("{}"),
+	"google.protobuf.FieldMask": sizeUnknown,
+	"google.protobuf.Struct":    sizeUnknown,
+	"google.protobuf.Value":     sizeUnknown,
+	"google.protobuf.ListValue": sizeUnknown,
+	"google.protobuf.Any":       sizeUnknown,
+}
+
+// GenerateSizeHint 生成 JSONSizeHint 方法, 估算 json 编码的大小, 供 MarshalJSON 预先分配 buffer.
+// 固定长度的部分在生成时累加为常量, 只在运行时计算 string 与 bytes 的长度, list 与 map 的元素个数以及嵌套 message 的估算.
+// 数字按最大位数计算, 结果通常大于实际大小
+func (f *File) GenerateSizeHint(ctx *Context, msg *protogen.Message) {
+	f.P("// ", SizeHintMethodName, " 估算 ", msg.Desc.FullName(), " 的 json 编码大小")
+	f.P("func (", Instance, " *", msg.GoIdent, ") ", SizeHintMethodName, "() int {")
+	f.P("if ", Instance, " == nil {")
+	f.P("return ", sizeNull)
+	f.P("}")
+	fixed := len("{}")
+	var lines [][]interface{}
+	for _, fd := range msg.Fields {
+		if fd.Oneof != nil && !fd.Oneof.Desc.IsSynthetic() {
+			if fd == fd.Oneof.Fields[0] {
+				lines = append(lines, f.sizeHintOneof(ctx, fd.Oneof)...)
+			}
+			continue
+		}
+		n, field := f.sizeHintField(ctx, fd, Instance+"."+fd.GoName)
+		fixed += n
+		lines = append(lines, field...)
+	}
+	if len(lines) == 0 {
+		f.P("return ", fixed)
+		f.P("}")
+		f.P()
+		return
+	}
+	f.P("n := ", fixed)
+	for _, line := range lines {
+		f.P(line...)
+	}
+	f.P("return n")
+	f.P("}")
+	f.P()
+}
+
+// sizeHintOneof 按实际设置的成员累加, 每个成员单独计算 key 的长度
+func (f *File) sizeHintOneof(ctx *Context, oneof *protogen.Oneof) [][]interface{} {
+	var cases [][]interface{}
+	used := false
+	for _, field := range oneof.Fields {
+		cases = append(cases, []interface{}{"case *", field.GoIdent, ":"})
+		n, rest := f.sizeHintField(ctx, field, Instance+"."+field.GoName)
+		cases = append(cases, []interface{}{"n += ", n})
+		cases = append(cases, rest...)
+		used = used || rest != nil
+	}
+	// 所有成员大小都固定时不需要取出成员的值
+	lines := [][]interface{}{{"switch ", Instance, ".", oneof.GoName, ".(type) {"}}
+	if used {
+		lines[0] = []interface{}{"switch ", Instance, " := ", Instance, ".", oneof.GoName, ".(type) {"}
+	}
+	lines = append(lines, cases...)
+	return append(lines, []interface{}{"}"})
+}
+
+// sizeHintField 返回字段的固定大小与运行时累加的代码, 固定大小包含 key 与逗号
+func (f *File) sizeHintField(ctx *Context, fd *protogen.Field, name string) (int, [][]interface{}) {
+	fixed := len(`"":,`) + len(FieldKey(ctx, fd))
+	switch {
+	case fd.Desc.IsList():
+		fixed += len("[]")
+		n, expr := sizeHintValue(ctx, fd.Desc, "val")
+		lines := [][]interface{}{{"n += len(", name, ") * ", n + len(",")}}
+		if expr != nil {
+			lines = append(lines, []interface{}{"for _, val := range ", name, " {"},
+				append([]interface{}{"n += "}, expr...), []interface{}{"}"})
+		}
+		return fixed, lines
+	case fd.Desc.IsMap():
+		fixed += len("{}")
+		keyN, keyExpr := sizeHintValue(ctx, fd.Desc.MapKey(), "key")
+		if fd.Desc.MapKey().Kind() != protoreflect.StringKind {
+			// 非 string 的 key 写成带引号的字符串
+			keyN += len(`""`)
+		}
+		valN, valExpr := sizeHintValue(ctx, fd.Desc.MapValue(), "val")
+		lines := [][]interface{}{{"n += len(", name, ") * ", keyN + valN + len(":,")}}
+		if keyExpr == nil && valExpr == nil {
+			return fixed, lines
+		}
+		key, val := "_", "_"
+		var expr []interface{}
+		if keyExpr != nil {
+			key, expr = "key", keyExpr
+		}
+		if valExpr != nil {
+			val = "val"
+			if expr != nil {
+				expr = append(expr, " + ")
+			}
+			expr = append(expr, valExpr...)
+		}
+		if val == "_" {
+			lines = append(lines, []interface{}{"for ", key, " := range ", name, " {"})
+		} else {
+			lines = append(lines, []interface{}{"for ", key, ", ", val, " := range ", name, " {"})
+		}
+		return fixed, append(lines, append([]interface{}{"n += "}, expr...), []interface{}{"}"})
+	case fd.Desc.HasOptionalKeyword() && fd.Desc.Kind() != protoreflect.MessageKind:
+		n, expr := sizeHintValue(ctx, fd.Desc, "*"+name)
+		if expr == nil {
+			return fixed + n, nil
+		}
+		return fixed + n, [][]interface{}{
+			{"if ", name, " != nil {"},
+			append([]interface{}{"n += "}, expr...),
+			{"}"},
+		}
+	default:
+		n, expr := sizeHintValue(ctx, fd.Desc, name)
+		var lines [][]interface{}
+		if expr != nil {
+			lines = [][]interface{}{append([]interface{}{"n += "}, expr...)}
+		}
+		// 默认省略的零值字段只在非零时计算, 已设置的 oneof 成员与 GenerateMessageField 一致即使是零值也写出
+		if cond, ok := CheckTypeIsDefault(ctx, name, fd); ok && !ctx.EmitUnpopulated && fd.Oneof == nil {
+			lines = append([][]interface{}{{"if ", cond, " {"}, {"n += ", fixed + n}}, lines...)
+			return 0, append(lines, []interface{}{"}"})
+		}
+		return fixed + n, lines
+	}
+}
+
+// sizeHintValue 返回单个值的固定大小与运行时计算的表达式, 表达式为 nil 时大小完全固定
+func sizeHintValue(ctx *Context, desc protoreflect.FieldDescriptor, name string) (int, []interface{}) {
+	switch desc.Kind() {
+	case protoreflect.BoolKind:
+		return sizeBool, nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return sizeInt32, nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return sizeInt64, nil
+	case protoreflect.DoubleKind, protoreflect.FloatKind:
+		return sizeFloat, nil
+	case protoreflect.StringKind:
+		return len(`""`), []interface{}{"len(", name, ")"}
+	case protoreflect.BytesKind:
+		// 标准 base64 编码, 每 3 个字节写成 4 个字符
+		return len(`""`), []interface{}{"(len(", name, ") + 2) / 3 * 4"}
+	case protoreflect.EnumKind:
+		return sizeHintEnum(ctx, desc.Enum()), nil
+	case protoreflect.MessageKind:
+		md := desc.Message()
+		if wrapperTypes[md.FullName()] {
+			return sizeHintValue(ctx, md.Fields().ByName("value"), name+".GetValue()")
+		}
+		if n, ok := wellKnownSizes[md.FullName()]; ok {
+			return n, nil
+		}
+		return 0, []interface{}{name, ".", SizeHintMethodName, "()"}
+	default:
+		return sizeUnknown, nil
+	}
+}
+
+// sizeHintEnum 枚举名按最长的名字计算, 未定义的值写成数字
+func sizeHintEnum(ctx *Context, enum protoreflect.EnumDescriptor) int {
+	if enum.FullName() == "google.protobuf.NullValue" {
+		return sizeNull
+	}
+	n := sizeInt32
+	if ctx.UseEnumNumbers {
+		return n
+	}
+	values := enum.Values()
+	for i := 0; i < values.Len(); i++ {
+		if size := len(values.Get(i).Name()) + len(`""`); size > n {
+			n = size
+		}
+	}
+	return n
+}
@@ -12,16 +12,43 @@ type Config struct {
 	FileNameSuffix string
 	// encode json method name
 	EncodeMethodName string
+	// decode json method name
+	DecodeMethodName string
 
+	// 内置 writer: bytes 或 pool, 设置后覆盖 ImportWriter, NewWriter, WriteBytes
+	Writer string
 	// import writer
 	ImportWriter string
-	// new writer
+	// new writer, 类型名或无参数的构造函数调用
 	NewWriter string
-	// write bytes
+	// write bytes, 取得编码结果的方法调用
 	WriteBytes string
 
+	// import runtime, 生成代码依赖的运行时包
+	ImportRuntime string
+
+	// string 额外转义 <, >, &, 与 encoding/json 默认行为一致
+	EscapeHTML bool
+	// 64 位整数写成 json number, 默认按 proto3 json 规范写成字符串
+	Int64AsNumber bool
+	// map 按 key 排序输出, 保证相同的 message 输出相同的字节
+	Deterministic bool
+	// 未赋值的字段也写出: 标量写零值, list 写 [], map 写 {}, message 写 null
+	EmitUnpopulated bool
+	// 使用 proto 字段名作为 json key, 默认使用 lowerCamelCase 的 json name
+	UseProtoNames bool
+	// 枚举写成数字, 默认写枚举名
+	UseEnumNumbers bool
+	// 解码时跳过 message 中未定义的 key, 默认返回错误
+	DiscardUnknown bool
+	// 解码时允许重复的 key 与同一个 oneof 的多个成员, 后出现的生效, 默认返回错误
+	AllowDuplicates bool
+
 	// debug logging
 	Debug bool
+
+	// writer 是否有 Grow(int) 方法, 由 ValidateWriter 设置
+	writerGrow bool
 }
 
 func (c *Config) String() string {
@@ -29,14 +56,15 @@ func (c *Config) String() string {
 		return ""
 	}
 	return fmt.Sprintf(
-		"FileNameSuffix=%s,EncodeMethodName=%s,ImportWriter=%s,NewWriter=%s, WriteBytes=%s, Debug=%t",
-		c.FileNameSuffix, c.EncodeMethodName, c.ImportWriter, c.NewWriter, c.WriteBytes, c.Debug)
+		"FileNameSuffix=%s,EncodeMethodName=%s,DecodeMethodName=%s,Writer=%s,ImportWriter=%s,NewWriter=%s, WriteBytes=%s, ImportRuntime=%s, EscapeHTML=%t, Int64AsNumber=%t, Deterministic=%t, EmitUnpopulated=%t, UseProtoNames=%t, UseEnumNumbers=%t, DiscardUnknown=%t, AllowDuplicates=%t, Debug=%t",
+		c.FileNameSuffix, c.EncodeMethodName, c.DecodeMethodName, c.Writer, c.ImportWriter, c.NewWriter, c.WriteBytes, c.ImportRuntime,
+		c.EscapeHTML, c.Int64AsNumber, c.Deterministic, c.EmitUnpopulated, c.UseProtoNames, c.UseEnumNumbers, c.DiscardUnknown, c.AllowDuplicates, c.Debug)
 }
 
 func (c *Config) Usage() string {
 	return "config args, format: key=val, " +
-		"support keys: [FileNameSuffix,EncodeMethodName,ImportWriter,NewWriter,WriteBytes,Debug]" +
-		"example: FileNameSuffix=.json.go,EncodeMethodName=MarshalJSON,ImportWriter=bytes,NewWriter=Buffer,WriteBytes=.Bytes(),Debug=true"
+		"support keys: [FileNameSuffix,EncodeMethodName,DecodeMethodName,Writer,ImportWriter,NewWriter,WriteBytes,ImportRuntime,EscapeHTML,Int64AsNumber,Deterministic,EmitUnpopulated,UseProtoNames,UseEnumNumbers,DiscardUnknown,AllowDuplicates,Debug]" +
+		"example: FileNameSuffix=.json.go,EncodeMethodName=MarshalJSON,DecodeMethodName=UnmarshalJSON,Writer=bytes,ImportWriter=bytes,NewWriter=Buffer,WriteBytes=.Bytes(),ImportRuntime=protoc-gen-go-json/runtime,EscapeHTML=false,Int64AsNumber=false,Deterministic=true,EmitUnpopulated=false,UseProtoNames=false,UseEnumNumbers=false,DiscardUnknown=false,AllowDuplicates=false,Debug=true"
 }
 
 func (c *Config) Set(s string) error {
@@ -62,9 +90,21 @@ func (c *Config) SetDefaults() Config {
 	if len(cfg.EncodeMethodName) == 0 {
 		cfg.EncodeMethodName = "MarshalJSON"
 	}
+	if len(cfg.DecodeMethodName) == 0 {
+		cfg.DecodeMethodName = "UnmarshalJSON"
+	}
+	if len(cfg.ImportRuntime) == 0 {
+		cfg.ImportRuntime = "protoc-gen-go-json/runtime"
+	}
+	// 三项分别设置默认值, 只指定其中一部分时保留指定的值
+	cfg.applyWriter()
 	if len(cfg.ImportWriter) == 0 {
 		cfg.ImportWriter = "bytes"
+	}
+	if len(cfg.NewWriter) == 0 && cfg.ImportWriter == "bytes" {
 		cfg.NewWriter = "Buffer"
+	}
+	if len(cfg.WriteBytes) == 0 {
 		cfg.WriteBytes = ".Bytes()"
 	}
 
@@ -86,12 +126,37 @@ func (c *Config) parseStr(s string) error {
 			c.FileNameSuffix = list[1]
 		case "EncodeMethodName":
 			c.EncodeMethodName = list[1]
+		case "DecodeMethodName":
+			c.DecodeMethodName = list[1]
+		case "Writer":
+			if list[1] != WriterBytes && list[1] != WriterPool {
+				return fmt.Errorf("not support writer %s, expect %s or %s", list[1], WriterBytes, WriterPool)
+			}
+			c.Writer = list[1]
 		case "ImportWriter":
 			c.ImportWriter = list[1]
 		case "NewWriter":
 			c.NewWriter = list[1]
 		case "WriteBytes":
 			c.WriteBytes = list[1]
+		case "ImportRuntime":
+			c.ImportRuntime = list[1]
+		case "EscapeHTML":
+			c.EscapeHTML = list[1] == "true" || list[1] == "True"
+		case "Int64AsNumber":
+			c.Int64AsNumber = list[1] == "true" || list[1] == "True"
+		case "Deterministic":
+			c.Deterministic = list[1] == "true" || list[1] == "True"
+		case "EmitUnpopulated":
+			c.EmitUnpopulated = list[1] == "true" || list[1] == "True"
+		case "UseProtoNames":
+			c.UseProtoNames = list[1] == "true" || list[1] == "True"
+		case "UseEnumNumbers":
+			c.UseEnumNumbers = list[1] == "true" || list[1] == "True"
+		case "DiscardUnknown":
+			c.DiscardUnknown = list[1] == "true" || list[1] == "True"
+		case "AllowDuplicates":
+			c.AllowDuplicates = list[1] == "true" || list[1] == "True"
 		case "Debug":
 			c.Debug = list[1] == "true" || list[1] == "True"
 		default:
@@ -108,10 +173,27 @@ const (
 
 	Buf = "buf"
 
-	WriteString = ".WriteString"
-	WriteBytes  = ".Write"
-	WriteByte   = ".WriteByte"
+	WriteBytes = ".Write"
+	// Dst AppendJSON 追加编码结果的 slice 变量名
+	Dst = "dst"
+	// AppendTo 追加到 dst 的语句前缀, 例如 AppendTo + "'{')"
+	AppendTo = Dst + " = append(" + Dst + ", "
+	// AppendMethodName 追加编码结果的方法名
+	AppendMethodName = "AppendJSON"
+	// EncodeToMethodName 追加编码结果并分段写出的方法名, 嵌套 message 通过它共用同一个 slice 与 Stream
+	EncodeToMethodName = "EncodeJSON"
+	// WriteToMethodName 把编码结果写入 io.Writer 的方法名
+	WriteToMethodName = "WriteJSONTo"
+	// SizeHintMethodName 估算编码大小的方法名
+	SizeHintMethodName = "JSONSizeHint"
+	// StreamVar 分段写出的 runtime.Stream 变量名
+	StreamVar = "stream"
 	// CommaVarName 逗号变量名
 	CommaVarName = "writeComma"
-	CommaValue   = "(',')"
+	CommaValue   = "',')"
+
+	// Dec 解码器变量名
+	Dec = "d"
+	// DecodeFromMethodName 从解码器读取 message 的方法名, 嵌套 message 通过它共用同一个解码器
+	DecodeFromMethodName = "DecodeJSON"
 )
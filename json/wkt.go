@@ -0,0 +1,107 @@
+package json
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// wellKnownTypes 内联编解码的 well-known type, Append 为 runtime 包中的编码函数, Read 为 Decoder 的读取方法,
+// Flags 表示编码函数需要 runtime.Flags 参数
+var wellKnownTypes = map[protoreflect.FullName]struct {
+	Append string
+	Read   string
+	Flags  bool
+}{
+	"google.protobuf.Timestamp": {Append: "AppendTimestamp", Read: "ReadTimestamp"},
+	"google.protobuf.Duration":  {Append: "AppendDuration", Read: "ReadDuration"},
+	"google.protobuf.Struct":    {Append: "AppendStruct", Read: "ReadStruct", Flags: true},
+	"google.protobuf.Value":     {Append: "AppendValue", Read: "ReadValue", Flags: true},
+	"google.protobuf.ListValue": {Append: "AppendListValue", Read: "ReadListValue", Flags: true},
+	"google.protobuf.Any":       {Append: "AppendAny", Read: "ReadAny", Flags: true},
+	"google.protobuf.FieldMask": {Append: "AppendFieldMask", Read: "ReadFieldMask"},
+	"google.protobuf.Empty":     {Append: "AppendEmpty", Read: "ReadEmpty"},
+}
+
+// wrapperTypes wrappers.proto 中的包装类型, 编码为内部 value 字段的值
+var wrapperTypes = map[protoreflect.FullName]bool{
+	"google.protobuf.DoubleValue": true,
+	"google.protobuf.FloatValue":  true,
+	"google.protobuf.Int64Value":  true,
+	"google.protobuf.UInt64Value": true,
+	"google.protobuf.Int32Value":  true,
+	"google.protobuf.UInt32Value": true,
+	"google.protobuf.BoolValue":   true,
+	"google.protobuf.StringValue": true,
+	"google.protobuf.BytesValue":  true,
+}
+
+// IsWellKnown message 是否按 proto3 json 规范内联编解码, 这些类型没有生成的 json 方法
+func IsWellKnown(md protoreflect.MessageDescriptor) bool {
+	_, ok := wellKnownTypes[md.FullName()]
+	return ok || wrapperTypes[md.FullName()]
+}
+
+// WellKnownEncode 生成 well-known type 的编码代码, 不是 well-known type 时返回 false
+func WellKnownEncode(ctx *Context, gf *protogen.GeneratedFile, md protoreflect.MessageDescriptor, name string) bool {
+	if wrapperTypes[md.FullName()] {
+		// 包装类型写成 value 的值, list 与 map 中的 nil 通过 GetValue 按零值写出
+		_ = HandlerType(ctx, md.Fields().ByName("value"), gf, false, name+".GetValue()")
+		return true
+	}
+	wkt, ok := wellKnownTypes[md.FullName()]
+	if !ok {
+		return false
+	}
+	runtimePackage := protogen.GoImportPath(ctx.ImportRuntime)
+	args := []interface{}{runtimePackage.Ident(wkt.Append), "(", Dst, ", ", name}
+	if wkt.Flags {
+		args = append(args, ", ")
+		args = append(args, WellKnownFlags(ctx)...)
+	}
+	AppendChecked(gf, append(args, ")")...)
+	return true
+}
+
+// WellKnownDecode 生成读取 well-known type 到新变量 name 的代码, 不是 well-known type 时返回 false
+func WellKnownDecode(ctx *Context, gf *protogen.GeneratedFile, msg *protogen.Message, name string, ret []interface{}) bool {
+	if wrapperTypes[msg.Desc.FullName()] {
+		value := msg.Fields[0]
+		DecodeValue(ctx, gf, value.Desc, value.Message, value.Enum, name+"Value", ret)
+		gf.P(name, " := &", msg.GoIdent, "{Value: ", name, "Value}")
+		return true
+	}
+	wkt, ok := wellKnownTypes[msg.Desc.FullName()]
+	if !ok {
+		return false
+	}
+	gf.P(name, ", err := ", Dec, ".", wkt.Read, "()")
+	gf.P("if err != nil {")
+	gf.P(append([]interface{}{"return "}, ret...)...)
+	gf.P("}")
+	return true
+}
+
+// WellKnownFlags 返回传给 runtime 编码函数的 runtime.Flags 表达式
+func WellKnownFlags(ctx *Context) []interface{} {
+	runtimePackage := protogen.GoImportPath(ctx.ImportRuntime)
+	var flags []interface{}
+	if ctx.EscapeHTML {
+		flags = append(flags, runtimePackage.Ident("EscapeHTML"))
+	}
+	if ctx.Deterministic {
+		if len(flags) > 0 {
+			flags = append(flags, "|")
+		}
+		flags = append(flags, runtimePackage.Ident("Deterministic"))
+	}
+	if len(flags) == 0 {
+		return []interface{}{"0"}
+	}
+	return flags
+}
+
+// AcceptNull 字段值为 null 时是否仍需读取, google.protobuf.Value 把 null 读取为 NullValue
+func AcceptNull(fd *protogen.Field) bool {
+	return !fd.Desc.IsList() && !fd.Desc.IsMap() && fd.Message != nil &&
+		fd.Message.Desc.FullName() == "google.protobuf.Value"
+}
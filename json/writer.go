@@ -0,0 +1,163 @@
+package json
+
+import (
+	"fmt"
+	"go/importer"
+	"go/token"
+	"go/types"
+	"regexp"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// 内置的 writer, 通过 Writer=bytes 或 Writer=pool 一次设置 ImportWriter, NewWriter, WriteBytes
+const (
+	WriterBytes = "bytes"
+	WriterPool  = "pool"
+)
+
+var (
+	// NewWriter 为类型名, 例如 Buffer, 或无参数的构造函数调用, 例如 NewPooledBuffer()
+	newWriterPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\(\))?$`)
+	// WriteBytes 为无参数的方法调用, 例如 .Bytes()
+	writeBytesPattern = regexp.MustCompile(`^\.([A-Za-z_][A-Za-z0-9_]*)\(\)$`)
+)
+
+// applyWriter 按内置 writer 的名字设置 ImportWriter, NewWriter, WriteBytes, 在 ImportRuntime 确定之后调用
+func (c *Config) applyWriter() {
+	switch c.Writer {
+	case WriterBytes:
+		c.ImportWriter, c.NewWriter, c.WriteBytes = "bytes", "Buffer", ".Bytes()"
+	case WriterPool:
+		c.ImportWriter, c.NewWriter, c.WriteBytes = c.ImportRuntime, "NewPooledBuffer()", ".Detach()"
+	}
+}
+
+// IsWriterConstructor NewWriter 是否为构造函数调用, 否则为类型名
+func (c *Config) IsWriterConstructor() bool {
+	return strings.HasSuffix(c.NewWriter, ")")
+}
+
+// GenerateNewWriter 生成声明 writer 变量 buf 的代码, Writer=pool 时按 message 类型的 SizeHint 借出 buffer
+func (f *File) GenerateNewWriter(ctx *Context, msg *protogen.Message) {
+	writerPackage := protogen.GoImportPath(ctx.ImportWriter)
+	switch {
+	case ctx.Writer == WriterPool:
+		f.P(Buf, " := ", writerPackage.Ident("NewPooledBufferHint"), "(&", SizeHintVar(msg), ")")
+	case ctx.IsWriterConstructor():
+		name := strings.TrimSuffix(ctx.NewWriter, "()")
+		f.P(Buf, " := ", writerPackage.Ident(name), "()")
+	default:
+		f.P("var ", Buf, " ", writerPackage.Ident(ctx.NewWriter))
+	}
+}
+
+// SizeHintVar 返回 message 的 runtime.SizeHint 变量名, 记录同一类型最近的编码大小
+func SizeHintVar(msg *protogen.Message) string {
+	return "jsonSizeHint_" + msg.GoIdent.GoName
+}
+
+// ValidateWriter 在生成代码之前检查 writer 的配置, 内置 writer 直接通过,
+// 其他 writer 从源码加载 ImportWriter 包, 检查 NewWriter 的类型具有生成代码调用的方法:
+// AvailableBuffer() []byte, Write([]byte) (int, error) 以及 WriteBytes 对应的 func() []byte,
+// 同时记录是否有可选的 Grow(int)
+func (c *Config) ValidateWriter() error {
+	if !newWriterPattern.MatchString(c.NewWriter) {
+		return fmt.Errorf("NewWriter %q must be a type name such as Buffer or a constructor call such as NewBuffer()", c.NewWriter)
+	}
+	match := writeBytesPattern.FindStringSubmatch(c.WriteBytes)
+	if match == nil {
+		return fmt.Errorf("WriteBytes %q must be a method call such as .Bytes()", c.WriteBytes)
+	}
+	if c.isBuiltinWriter() {
+		// PooledBuffer 按 SizeHint 记录的实际大小借出, 不再按估算的大小 Grow
+		c.writerGrow = c.ImportWriter == "bytes"
+		return nil
+	}
+
+	pkg, err := importer.ForCompiler(token.NewFileSet(), "source", nil).Import(c.ImportWriter)
+	if err != nil {
+		return fmt.Errorf("ImportWriter %s: unable to load package to check the writer: %w", c.ImportWriter, err)
+	}
+	tv, err := types.Eval(token.NewFileSet(), pkg, token.NoPos, c.NewWriter)
+	if err != nil {
+		return fmt.Errorf("NewWriter %s.%s: %w", c.ImportWriter, c.NewWriter, err)
+	}
+	if c.IsWriterConstructor() == tv.IsType() {
+		return fmt.Errorf("NewWriter %s.%s: expect a type name or a call returning a single value", c.ImportWriter, c.NewWriter)
+	}
+	// buf 是可寻址的变量, 指针接收者的方法也可以调用
+	typ := tv.Type
+	if _, ok := typ.Underlying().(*types.Pointer); !ok && !types.IsInterface(typ) {
+		typ = types.NewPointer(typ)
+	}
+	methods := []struct {
+		name    string
+		params  []types.Type
+		results []types.Type
+	}{
+		{"AvailableBuffer", nil, []types.Type{byteSlice}},
+		{"Write", []types.Type{byteSlice}, []types.Type{types.Typ[types.Int], errorType}},
+		{match[1], nil, []types.Type{byteSlice}},
+	}
+	for _, m := range methods {
+		if err := checkMethod(typ, m.name, m.params, m.results); err != nil {
+			return fmt.Errorf("writer %s: %w", tv.Type, err)
+		}
+	}
+	// Grow(int) 是可选的, 有该方法时 MarshalJSON 按 JSONSizeHint 预先分配
+	c.writerGrow = checkMethod(typ, "Grow", []types.Type{types.Typ[types.Int]}, nil) == nil
+	return nil
+}
+
+// isBuiltinWriter 内置 writer 不需要加载包检查
+func (c *Config) isBuiltinWriter() bool {
+	switch {
+	case c.ImportWriter == "bytes" && c.NewWriter == "Buffer":
+		return c.WriteBytes == ".Bytes()"
+	case c.ImportWriter == c.ImportRuntime && c.NewWriter == "NewPooledBuffer()":
+		return c.WriteBytes == ".Detach()"
+	default:
+		return false
+	}
+}
+
+var (
+	byteSlice = types.NewSlice(types.Universe.Lookup("byte").Type())
+	errorType = types.Universe.Lookup("error").Type()
+)
+
+func checkMethod(typ types.Type, name string, params, results []types.Type) error {
+	obj, _, _ := types.LookupFieldOrMethod(typ, true, nil, name)
+	fn, ok := obj.(*types.Func)
+	if !ok {
+		return fmt.Errorf("missing method %s", name)
+	}
+	sig := fn.Type().(*types.Signature)
+	if !tupleIs(sig.Params(), params) || !tupleIs(sig.Results(), results) || sig.Variadic() {
+		want := types.NewSignatureType(nil, nil, nil, newTuple(params), newTuple(results), false)
+		return fmt.Errorf("method %s has signature %s, expect %s", name, sig, want)
+	}
+	return nil
+}
+
+func tupleIs(tuple *types.Tuple, want []types.Type) bool {
+	if tuple.Len() != len(want) {
+		return false
+	}
+	for i, typ := range want {
+		if !types.Identical(tuple.At(i).Type(), typ) {
+			return false
+		}
+	}
+	return true
+}
+
+func newTuple(list []types.Type) *types.Tuple {
+	vars := make([]*types.Var, len(list))
+	for i, typ := range list {
+		vars[i] = types.NewParam(token.NoPos, nil, "", typ)
+	}
+	return types.NewTuple(vars...)
+}
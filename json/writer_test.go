@@ -0,0 +1,66 @@
+package json
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_SetDefaults_Writer(t *testing.T) {
+	tests := []struct {
+		name string
+		args string
+		want [3]string
+	}{
+		{name: "default", args: "", want: [3]string{"bytes", "Buffer", ".Bytes()"}},
+		{name: "pool", args: "Writer=pool", want: [3]string{"protoc-gen-go-json/runtime", "NewPooledBuffer()", ".Detach()"}},
+		{name: "pool runtime", args: "Writer=pool,ImportRuntime=example.com/runtime", want: [3]string{"example.com/runtime", "NewPooledBuffer()", ".Detach()"}},
+		{name: "custom bytes method", args: "WriteBytes=.AvailableBuffer()", want: [3]string{"bytes", "Buffer", ".AvailableBuffer()"}},
+		{name: "custom writer", args: "ImportWriter=example.com/w,NewWriter=New()", want: [3]string{"example.com/w", "New()", ".Bytes()"}},
+		{name: "custom package only", args: "ImportWriter=example.com/w", want: [3]string{"example.com/w", "", ".Bytes()"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var c Config
+			require.NoError(t, c.Set(tt.args))
+			cfg := c.SetDefaults()
+			require.Equal(t, tt.want, [3]string{cfg.ImportWriter, cfg.NewWriter, cfg.WriteBytes})
+		})
+	}
+	require.Error(t, new(Config).Set("Writer=bufio"))
+}
+
+func TestConfig_ValidateWriter(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     string
+		wantGrow bool
+		wantErr  string
+	}{
+		{name: "bytes", args: "", wantGrow: true},
+		{name: "pool", args: "Writer=pool"},
+		{name: "checked method", args: "WriteBytes=.AvailableBuffer()", wantGrow: true},
+		{name: "bad method", args: "WriteBytes=.String()", wantErr: "method String has signature func() string, expect func() []byte"},
+		{name: "missing method", args: "ImportWriter=strings,NewWriter=Builder,WriteBytes=.String()", wantErr: "missing method AvailableBuffer"},
+		{name: "bufio", args: "ImportWriter=bufio,NewWriter=Writer", wantErr: "missing method Bytes"},
+		{name: "constructor args", args: "NewWriter=NewBuffer()", wantErr: "NewWriter bytes.NewBuffer()"},
+		{name: "not a type", args: "NewWriter=MinRead", wantErr: "expect a type name"},
+		{name: "empty", args: "ImportWriter=strings", wantErr: "NewWriter \"\" must be"},
+		{name: "bytes expr", args: "WriteBytes=Bytes", wantErr: "WriteBytes \"Bytes\" must be"},
+		{name: "no package", args: "ImportWriter=example.invalid/w,NewWriter=W", wantErr: "unable to load package"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var c Config
+			require.NoError(t, c.Set(tt.args))
+			cfg := c.SetDefaults()
+			err := cfg.ValidateWriter()
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				require.Equal(t, tt.wantGrow, cfg.writerGrow)
+				return
+			}
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,355 @@
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// Appender 由生成代码实现, 把 message 的 json 编码追加到 dst
+type Appender interface {
+	AppendJSON(dst []byte) ([]byte, error)
+}
+
+// TypeResolver 根据 google.protobuf.Any 的 type url 查找 message 类型, *protoregistry.Types 实现了该接口
+type TypeResolver interface {
+	FindMessageByURL(url string) (protoreflect.MessageType, error)
+}
+
+// AnyResolver 编解码 google.protobuf.Any 时使用的类型解析器, 默认为 protoregistry.GlobalTypes,
+// 可以在程序初始化时替换为自定义实现
+var AnyResolver TypeResolver = protoregistry.GlobalTypes
+
+// wellKnownNames 在 Any 中以 "value" 字段写出的 well-known type
+var wellKnownNames = map[protoreflect.FullName]bool{
+	"google.protobuf.Any":         true,
+	"google.protobuf.Timestamp":   true,
+	"google.protobuf.Duration":    true,
+	"google.protobuf.Struct":      true,
+	"google.protobuf.Value":       true,
+	"google.protobuf.ListValue":   true,
+	"google.protobuf.FieldMask":   true,
+	"google.protobuf.Empty":       true,
+	"google.protobuf.DoubleValue": true,
+	"google.protobuf.FloatValue":  true,
+	"google.protobuf.Int64Value":  true,
+	"google.protobuf.UInt64Value": true,
+	"google.protobuf.Int32Value":  true,
+	"google.protobuf.UInt32Value": true,
+	"google.protobuf.BoolValue":   true,
+	"google.protobuf.StringValue": true,
+	"google.protobuf.BytesValue":  true,
+}
+
+// AppendAny 把 google.protobuf.Any 写成 {"@type":url, ...}, 普通 message 的字段直接展开,
+// well-known type 写在 "value" 字段中. 解析出的类型有生成的 AppendJSON 或 MarshalJSON 时优先使用, 否则使用 protojson
+func AppendAny(dst []byte, a *anypb.Any, flags Flags) ([]byte, error) {
+	url := a.GetTypeUrl()
+	if url == "" {
+		if len(a.GetValue()) > 0 {
+			return dst, errors.New("google.protobuf.Any: empty type_url with non-empty value")
+		}
+		return append(dst, '{', '}'), nil
+	}
+	mt, err := AnyResolver.FindMessageByURL(url)
+	if err != nil {
+		return dst, fmt.Errorf("google.protobuf.Any: unable to resolve %q: %w", url, err)
+	}
+	m := mt.New().Interface()
+	if err = (proto.UnmarshalOptions{AllowPartial: true}).Unmarshal(a.GetValue(), m); err != nil {
+		return dst, fmt.Errorf("google.protobuf.Any: unable to unmarshal %q: %w", url, err)
+	}
+
+	dst = append(dst, `{"@type":`...)
+	if dst, err = appendString(dst, url, flags&EscapeHTML != 0); err != nil {
+		return dst, err
+	}
+	if wellKnownNames[mt.Descriptor().FullName()] {
+		dst = append(dst, `,"value":`...)
+		if dst, err = appendWellKnown(dst, m, flags); err != nil {
+			return dst, err
+		}
+		return append(dst, '}'), nil
+	}
+
+	if appender, ok := m.(Appender); ok {
+		// 直接追加到 dst, 再把 message 自身的 '{' 改为 ',' 与 @type 拼接
+		n := len(dst)
+		if dst, err = appender.AppendJSON(dst); err != nil {
+			return dst, err
+		}
+		if len(dst) < n+2 || dst[n] != '{' {
+			return dst, fmt.Errorf("google.protobuf.Any: %q is not encoded as a json object", url)
+		}
+		if dst[n+1] == '}' {
+			return append(dst[:n], '}'), nil
+		}
+		dst[n] = ','
+		return dst, nil
+	}
+	var data []byte
+	if marshaler, ok := m.(json.Marshaler); ok {
+		data, err = marshaler.MarshalJSON()
+	} else {
+		data, err = protojson.Marshal(m)
+	}
+	if err != nil {
+		return dst, err
+	}
+	// 去掉 message 自身的 '{', 与 @type 拼接为同一个对象
+	data = bytes.TrimSpace(data)
+	if len(data) < 2 || data[0] != '{' {
+		return dst, fmt.Errorf("google.protobuf.Any: %q is not encoded as a json object", url)
+	}
+	body := bytes.TrimSpace(data[1:])
+	if body[0] != '}' {
+		dst = append(dst, ',')
+	}
+	return append(dst, body...), nil
+}
+
+// appendWellKnown 写出 Any 中 well-known type 的值, 不是 known 包中的具体类型时 (例如 dynamicpb) 使用 protojson
+func appendWellKnown(dst []byte, m proto.Message, flags Flags) ([]byte, error) {
+	html := flags&EscapeHTML != 0
+	switch m := m.(type) {
+	case *anypb.Any:
+		return AppendAny(dst, m, flags)
+	case *timestamppb.Timestamp:
+		return AppendTimestamp(dst, m)
+	case *durationpb.Duration:
+		return AppendDuration(dst, m)
+	case *structpb.Struct:
+		return AppendStruct(dst, m, flags)
+	case *structpb.Value:
+		return AppendValue(dst, m, flags)
+	case *structpb.ListValue:
+		return AppendListValue(dst, m, flags)
+	case *fieldmaskpb.FieldMask:
+		return AppendFieldMask(dst, m)
+	case *emptypb.Empty:
+		return AppendEmpty(dst, m)
+	case *wrapperspb.DoubleValue:
+		return AppendFloat(dst, m.Value, 64), nil
+	case *wrapperspb.FloatValue:
+		return AppendFloat(dst, float64(m.Value), 32), nil
+	case *wrapperspb.Int64Value:
+		return append(strconv.AppendInt(append(dst, '"'), m.Value, 10), '"'), nil
+	case *wrapperspb.UInt64Value:
+		return append(strconv.AppendUint(append(dst, '"'), m.Value, 10), '"'), nil
+	case *wrapperspb.Int32Value:
+		return strconv.AppendInt(dst, int64(m.Value), 10), nil
+	case *wrapperspb.UInt32Value:
+		return strconv.AppendUint(dst, uint64(m.Value), 10), nil
+	case *wrapperspb.BoolValue:
+		return strconv.AppendBool(dst, m.Value), nil
+	case *wrapperspb.StringValue:
+		return appendString(dst, m.Value, html)
+	case *wrapperspb.BytesValue:
+		return AppendBytes(dst, m.Value), nil
+	default:
+		data, err := protojson.Marshal(m)
+		if err != nil {
+			return dst, err
+		}
+		return append(dst, bytes.TrimSpace(data)...), nil
+	}
+}
+
+// ReadAny 读取 {"@type":url, ...} 形式的 google.protobuf.Any, @type 可以出现在对象的任意位置.
+// 解析出的类型有生成的 UnmarshalJSON 时优先使用, 否则使用 protojson
+func (d *Decoder) ReadAny() (*anypb.Any, error) {
+	start := d.pos
+	if err := d.ObjectStart(); err != nil {
+		return nil, err
+	}
+	// 收集除 @type 之外的成员, 拼成 message 自身的 json 对象
+	var url string
+	var hasURL bool
+	var value []byte
+	var hasValue, hasOther bool
+	fields := []byte{'{'}
+	// spans 记录 fields 中每个成员的值在输入中的位置, valueSpan 对应 value 成员
+	var spans []anySpan
+	var valueSpan anySpan
+	for {
+		key, ok, err := d.ObjectNext()
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		if string(key) == "@type" {
+			if hasURL {
+				return nil, d.errorf("duplicate @type field")
+			}
+			s, err := d.ReadString()
+			if err != nil {
+				return nil, err
+			}
+			url, hasURL = s, true
+			continue
+		}
+		if len(fields) > 1 {
+			fields = append(fields, ',')
+		}
+		isValue := string(key) == "value"
+		if fields, err = appendString(fields, string(key), false); err != nil {
+			return nil, err
+		}
+		fields = append(fields, ':')
+		d.skipSpace()
+		valueStart := d.pos
+		if err = d.Skip(); err != nil {
+			return nil, err
+		}
+		spans = append(spans, anySpan{at: len(fields), pos: valueStart, n: d.pos - valueStart})
+		fields = append(fields, d.data[valueStart:d.pos]...)
+		if isValue {
+			value, hasValue = d.data[valueStart:d.pos], true
+			valueSpan = anySpan{pos: valueStart, n: d.pos - valueStart}
+		} else {
+			hasOther = true
+		}
+	}
+	fields = append(fields, '}')
+	end := d.pos
+
+	if !hasURL {
+		if len(fields) == 2 {
+			return &anypb.Any{}, nil
+		}
+		d.pos = start
+		return nil, d.errorf("google.protobuf.Any: missing @type field")
+	}
+	d.pos = start
+	mt, err := AnyResolver.FindMessageByURL(url)
+	if err != nil {
+		return nil, d.errorf("google.protobuf.Any: unable to resolve %q: %v", url, err)
+	}
+	m := mt.New().Interface()
+	if wellKnownNames[mt.Descriptor().FullName()] {
+		if hasOther && !d.opts.DiscardUnknown {
+			return nil, d.errorf("google.protobuf.Any: %s must only have @type and value fields", mt.Descriptor().FullName())
+		}
+		if !hasValue {
+			return nil, d.errorf("google.protobuf.Any: missing \"value\" field")
+		}
+		// value 比 Any 自身多嵌套一层
+		if err = unmarshalJSON(value, m, d.opts, d.depth+1); err != nil {
+			return nil, d.anyError(withPath(err, "value"), []anySpan{valueSpan}, start)
+		}
+	} else if err = unmarshalJSON(fields, m, d.opts, d.depth); err != nil {
+		return nil, d.anyError(err, spans, start)
+	}
+	data, err := proto.MarshalOptions{AllowPartial: true, Deterministic: true}.Marshal(m)
+	if err != nil {
+		return nil, d.errorf("google.protobuf.Any: %v", err)
+	}
+	d.pos = end
+	return &anypb.Any{TypeUrl: url, Value: data}, nil
+}
+
+// anySpan 是 ReadAny 重新拼出的 json 中从 at 开始的 n 个字节, 原样复制自输入中 pos 开始的值
+type anySpan struct {
+	at, pos, n int
+}
+
+// anyError 把 Any 中 message 的解码错误映射回输入: 值内部的位置逐字节对应,
+// 其他位置对应到前一个成员的值的末尾, 第一个成员之前对应到 Any 的起点 start. 字段路径保留, 由调用方继续加上外层字段.
+// 不是 *Error 的错误 (例如来自 protojson) 在 Any 的起点报告
+func (d *Decoder) anyError(err error, spans []anySpan, start int) error {
+	e, ok := err.(*Error)
+	if !ok {
+		d.pos = start
+		return d.errorf("google.protobuf.Any: %v", err)
+	}
+	d.pos = start
+	for _, s := range spans {
+		if e.Offset < s.at {
+			break
+		}
+		off := e.Offset - s.at
+		if off > s.n {
+			off = s.n
+		}
+		d.pos = s.pos + off
+	}
+	mapped := d.newError(e.Msg)
+	mapped.Path, mapped.Expected, mapped.Actual = e.Path, e.Expected, e.Actual
+	return mapped
+}
+
+// unmarshalJSON 有生成的 DecodeJSON 时优先使用, well-known type 使用 Decoder, 其次是 UnmarshalJSON, 其他使用 protojson.
+// structpb 的类型自带基于 protojson 的 UnmarshalJSON, 因此先检查 well-known type.
+// opts 传递给 DecodeJSON 与 protojson, UnmarshalJSON 使用生成时的配置.
+// depth 是 data 在外层输入中的嵌套深度, 使 Any 中的值与外层共用 maxDepth 的限制
+func unmarshalJSON(data []byte, m proto.Message, opts UnmarshalOptions, depth int) error {
+	d := opts.NewDecoder(data)
+	d.depth = depth
+	if unmarshaler, ok := m.(Unmarshaler); ok {
+		return d.unmarshal(unmarshaler, false)
+	}
+	var err error
+	switch m := m.(type) {
+	case *anypb.Any:
+		var v *anypb.Any
+		if v, err = d.ReadAny(); err == nil {
+			proto.Merge(m, v)
+		}
+	case *timestamppb.Timestamp:
+		var v *timestamppb.Timestamp
+		if v, err = d.ReadTimestamp(); err == nil {
+			m.Seconds, m.Nanos = v.Seconds, v.Nanos
+		}
+	case *durationpb.Duration:
+		var v *durationpb.Duration
+		if v, err = d.ReadDuration(); err == nil {
+			m.Seconds, m.Nanos = v.Seconds, v.Nanos
+		}
+	case *structpb.Struct:
+		var v *structpb.Struct
+		if v, err = d.ReadStruct(); err == nil {
+			m.Fields = v.Fields
+		}
+	case *structpb.Value:
+		var v *structpb.Value
+		if v, err = d.ReadValue(); err == nil {
+			m.Kind = v.Kind
+		}
+	case *structpb.ListValue:
+		var v *structpb.ListValue
+		if v, err = d.ReadListValue(); err == nil {
+			m.Values = v.Values
+		}
+	case *fieldmaskpb.FieldMask:
+		var v *fieldmaskpb.FieldMask
+		if v, err = d.ReadFieldMask(); err == nil {
+			m.Paths = v.Paths
+		}
+	case *emptypb.Empty:
+		_, err = d.ReadEmpty()
+	case json.Unmarshaler:
+		return m.UnmarshalJSON(data)
+	default:
+		return protojson.UnmarshalOptions{DiscardUnknown: opts.DiscardUnknown}.Unmarshal(data, m)
+	}
+	if err != nil {
+		return err
+	}
+	return d.End()
+}
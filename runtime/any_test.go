@@ -0,0 +1,110 @@
+package runtime
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestAppendAny(t *testing.T) {
+	// descriptorpb 没有生成的 json 方法, 使用 protojson
+	a, err := anypb.New(&descriptorpb.EnumValueDescriptorProto{Name: proto.String("A"), Number: proto.Int32(1)})
+	require.NoError(t, err)
+	got, err := AppendAny(nil, a, 0)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"@type":"type.googleapis.com/google.protobuf.EnumValueDescriptorProto","name":"A","number":1}`, string(got))
+
+	d := NewDecoder(got)
+	v, err := d.ReadAny()
+	require.NoError(t, err)
+	require.NoError(t, d.End())
+	require.True(t, proto.Equal(a, v))
+
+	b, err := anypb.New(wrapperspb.String("<"))
+	require.NoError(t, err)
+	got, err = AppendAny(nil, b, EscapeHTML)
+	require.NoError(t, err)
+	require.Equal(t, `{"@type":"type.googleapis.com/google.protobuf.StringValue","value":"\u003c"}`, string(got))
+
+	for _, m := range []proto.Message{&emptypb.Empty{}, &fieldmaskpb.FieldMask{Paths: []string{"a_b"}}} {
+		a, err := anypb.New(m)
+		require.NoError(t, err)
+		got, err := AppendAny(nil, a, 0)
+		require.NoError(t, err)
+		expect, err := protojson.Marshal(a)
+		require.NoError(t, err)
+		require.JSONEq(t, string(expect), string(got))
+		v, err := NewDecoder(got).ReadAny()
+		require.NoError(t, err)
+		require.True(t, proto.Equal(a, v))
+	}
+
+	got, err = AppendAny(nil, nil, 0)
+	require.NoError(t, err)
+	require.Equal(t, `{}`, string(got))
+}
+
+func TestDecoder_ReadAny(t *testing.T) {
+	for _, data := range []string{
+		`{"@type":"type.googleapis.com/google.protobuf.StringValue","@type":"x","value":""}`,
+		`{"@type":1}`,
+		`{"@type":"type.googleapis.com/google.protobuf.StringValue","value":1}`,
+		`{"@type":"type.googleapis.com/google.protobuf.StringValue"}`,
+		`{"@type":"type.googleapis.com/google.protobuf.StringValue","value":"","x":1}`,
+		`{"@type":"type.googleapis.com/google.protobuf.Empty","value":{"x":1}}`,
+		`{"@type":"type.googleapis.com/google.protobuf.Duration","seconds":1}`,
+		`[]`,
+	} {
+		_, err := NewDecoder([]byte(data)).ReadAny()
+		require.Error(t, err, data)
+	}
+
+	// message 的错误保留路径与 token, 位置对应到输入中的值而不是重新拼出的 json
+	data := "{\"@type\":\"type.googleapis.com/google.protobuf.FieldMask\",\n \"value\": 1}"
+	_, err := NewDecoder([]byte(data)).ReadAny()
+	var e *Error
+	require.ErrorAs(t, err, &e)
+	require.Equal(t, "value", e.Path)
+	require.Equal(t, strings.Index(data, "1}"), e.Offset)
+	require.Equal(t, 2, e.Line)
+	require.Equal(t, "string", e.Expected)
+	require.Equal(t, "number", e.Actual)
+
+	// DiscardUnknown 跳过 well-known type 之外的成员以及 value 中未定义的 key
+	for _, data := range []string{
+		`{"@type":"type.googleapis.com/google.protobuf.StringValue","value":"","x":1}`,
+		`{"@type":"type.googleapis.com/google.protobuf.Empty","value":{"x":1}}`,
+		`{"@type":"type.googleapis.com/google.protobuf.Duration","seconds":1}`,
+	} {
+		opts := UnmarshalOptions{DiscardUnknown: true}
+		got, err := opts.NewDecoder([]byte(data)).ReadAny()
+		expect := new(anypb.Any)
+		expectErr := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal([]byte(data), expect)
+		require.Equal(t, expectErr == nil, err == nil, "%s: %v, protojson %v", data, err, expectErr)
+		if err == nil {
+			require.True(t, proto.Equal(expect, got), data)
+		}
+	}
+}
+
+func TestUnmarshalJSON_Depth(t *testing.T) {
+	// Any 中的值从外层的嵌套深度开始计数
+	require.NoError(t, unmarshalJSON([]byte(`[[1]]`), &structpb.Value{}, UnmarshalOptions{}, maxDepth-2))
+	err := unmarshalJSON([]byte(`[[1]]`), &structpb.Value{}, UnmarshalOptions{}, maxDepth-1)
+	require.ErrorContains(t, err, "exceeded max nesting depth")
+	err = unmarshalJSON([]byte(`{"a":[]}`), &anypb.Any{}, UnmarshalOptions{}, maxDepth)
+	require.ErrorContains(t, err, "exceeded max nesting depth")
+
+	data := `{"@type":"type.googleapis.com/google.protobuf.Value","value":` + strings.Repeat("[", 20e6)
+	_, err = NewDecoder([]byte(data)).ReadAny()
+	require.ErrorContains(t, err, "exceeded max nesting depth")
+}
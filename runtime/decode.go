@@ -0,0 +1,907 @@
+// Package runtime 是 protoc-gen-go-json 生成代码依赖的运行时库
+package runtime
+
+import (
+	"bytes"
+	"encoding/base64"
+	"math"
+	"strconv"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Decoder 是生成的 UnmarshalJSON 使用的 json 词法解析器,
+// 直接在输入的 []byte 上逐个读取 token, 不经过反射
+type Decoder struct {
+	data []byte
+	pos  int
+	// 存放带转义字符的 key 解码结果, 下一次读取前有效
+	scratch []byte
+	opts    UnmarshalOptions
+	// depth 当前所在对象与数组的嵌套层数, 由 ObjectStart, ArrayStart 增加, 读到结束符时减少
+	depth int
+}
+
+// NewDecoder 创建 data 的解码器, 使用默认的 UnmarshalOptions
+func NewDecoder(data []byte) *Decoder {
+	return &Decoder{data: data}
+}
+
+// UnmarshalOptions 解码选项, 与 protojson.UnmarshalOptions 中同名的选项含义相同
+type UnmarshalOptions struct {
+	// DiscardUnknown 跳过 message 中未定义的 key, 默认返回错误
+	DiscardUnknown bool
+	// AllowDuplicates 允许重复的 key 与同一个 oneof 的多个成员, 后出现的生效, list 与 map 也被替换而不是合并.
+	// 默认与 protojson 一致返回错误
+	AllowDuplicates bool
+}
+
+// NewDecoder 创建使用当前选项的解码器
+func (o UnmarshalOptions) NewDecoder(data []byte) *Decoder {
+	return &Decoder{data: data, opts: o}
+}
+
+// Unmarshaler 由生成代码实现, 从解码器读取 message
+type Unmarshaler interface {
+	Reset()
+	DecodeJSON(d *Decoder) error
+}
+
+// Unmarshal 按当前选项把 data 解码到 m, 与生成的 UnmarshalJSON 一致, null 不修改 m.
+// m 是 proto.Message 时错误的字段路径以 message 全名开头
+func (o UnmarshalOptions) Unmarshal(data []byte, m Unmarshaler) error {
+	return o.NewDecoder(data).unmarshal(m, true)
+}
+
+// unmarshal 从当前位置读取整个输入到 m, Any 中的 message 使用继承了外层深度的解码器.
+// withMessage 为 false 时错误路径不含 message 全名, 由 ReadAny 接在 Any 字段之后
+func (d *Decoder) unmarshal(m Unmarshaler, withMessage bool) error {
+	if d.ReadNull() {
+		return d.End()
+	}
+	m.Reset()
+	if err := m.DecodeJSON(d); err != nil {
+		if pm, ok := m.(proto.Message); ok && withMessage {
+			return WithMessage(err, string(pm.ProtoReflect().Descriptor().FullName()))
+		}
+		return err
+	}
+	return d.End()
+}
+
+// Unknown 处理 message 中未定义的 key, DiscardUnknown 时跳过它的值, 否则返回包含 message 名与 key 的错误
+func (d *Decoder) Unknown(message string, key []byte) error {
+	if d.opts.DiscardUnknown {
+		return d.Skip()
+	}
+	return d.errorf("unknown field %q in %s", key, message)
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func (d *Decoder) skipSpace() {
+	for d.pos < len(d.data) && isSpace(d.data[d.pos]) {
+		d.pos++
+	}
+}
+
+// peek 跳过空白并返回下一个字符, 输入结束时返回 0
+func (d *Decoder) peek() byte {
+	d.skipSpace()
+	if d.pos < len(d.data) {
+		return d.data[d.pos]
+	}
+	return 0
+}
+
+// prev 返回当前位置之前最后一个非空白字符
+func (d *Decoder) prev() byte {
+	for i := d.pos - 1; i >= 0; i-- {
+		if !isSpace(d.data[i]) {
+			return d.data[i]
+		}
+	}
+	return 0
+}
+
+func (d *Decoder) literal(lit string) bool {
+	if len(d.data)-d.pos >= len(lit) && string(d.data[d.pos:d.pos+len(lit)]) == lit {
+		d.pos += len(lit)
+		return true
+	}
+	return false
+}
+
+// ReadNull 如果下一个 token 是 null 则读取它并返回 true
+func (d *Decoder) ReadNull() bool {
+	return d.peek() == 'n' && d.literal("null")
+}
+
+// End 检查输入在一个完整的值之后只剩空白
+func (d *Decoder) End() error {
+	if d.skipSpace(); d.pos < len(d.data) {
+		return d.unexpected("end of input")
+	}
+	return nil
+}
+
+// ObjectStart 读取对象起始 '{', 嵌套超过 maxDepth 层时返回错误
+func (d *Decoder) ObjectStart() error {
+	if d.peek() != '{' {
+		return d.unexpected("object")
+	}
+	if err := d.enter(); err != nil {
+		return err
+	}
+	d.pos++
+	return nil
+}
+
+// ObjectNext 读取对象的下一个 key 及其后的 ':',
+// 遇到 '}' 时返回 ok=false. 返回的 key 在下一次读取前有效
+func (d *Decoder) ObjectNext() (key []byte, ok bool, err error) {
+	c := d.peek()
+	if c == '}' {
+		d.pos++
+		d.depth--
+		return nil, false, nil
+	}
+	if d.prev() != '{' {
+		if c != ',' {
+			return nil, false, d.unexpected("',' or '}'")
+		}
+		d.pos++
+		c = d.peek()
+	}
+	if c != '"' {
+		return nil, false, d.unexpected("object key")
+	}
+	if key, err = d.readString(); err != nil {
+		return nil, false, err
+	}
+	if d.peek() != ':' {
+		return nil, false, d.unexpected("':'")
+	}
+	d.pos++
+	return key, true, nil
+}
+
+// ArrayStart 读取数组起始 '[', 嵌套超过 maxDepth 层时返回错误
+func (d *Decoder) ArrayStart() error {
+	if d.peek() != '[' {
+		return d.unexpected("array")
+	}
+	if err := d.enter(); err != nil {
+		return err
+	}
+	d.pos++
+	return nil
+}
+
+// ArrayNext 准备读取数组的下一个元素, 遇到 ']' 时返回 false
+func (d *Decoder) ArrayNext() (bool, error) {
+	c := d.peek()
+	if c == ']' {
+		d.pos++
+		d.depth--
+		return false, nil
+	}
+	if d.prev() != '[' {
+		if c != ',' {
+			return false, d.unexpected("',' or ']'")
+		}
+		d.pos++
+	}
+	return true, nil
+}
+
+// Duplicate 处理 message 中重复出现的字段, AllowDuplicates 时返回 nil, 否则返回包含 message 名与 key 的错误
+func (d *Decoder) Duplicate(message string, key []byte) error {
+	if d.opts.AllowDuplicates {
+		return nil
+	}
+	return d.errorf("duplicate field %q in %s", key, message)
+}
+
+// DuplicateKey 处理 map 或 Struct 中重复出现的 key, 按解析后的值比较, 例如 uint32 的 "1" 与 "01" 相同.
+// AllowDuplicates 时返回 nil, 后出现的值生效, 否则与 protojson 一致返回错误
+func (d *Decoder) DuplicateKey(key []byte) error {
+	if d.opts.AllowDuplicates {
+		return nil
+	}
+	return d.errorf("duplicate map key %q", key)
+}
+
+// OneofConflict 处理同一个 oneof 中出现的第二个成员, AllowDuplicates 时返回 nil, 否则返回错误
+func (d *Decoder) OneofConflict(oneof string, key []byte) error {
+	if d.opts.AllowDuplicates {
+		return nil
+	}
+	return d.errorf("oneof %s is already set, got field %q", oneof, key)
+}
+
+// maxDepth 对象与数组允许的最大嵌套深度, 与 protojson 的默认 RecursionLimit 一致.
+// 解码与跳过共用同一个计数, 超出时返回错误而不是耗尽栈空间
+const maxDepth = 10000
+
+// enter 进入一层对象或数组
+func (d *Decoder) enter() error {
+	if d.depth >= maxDepth {
+		return d.errorf("exceeded max nesting depth %d", maxDepth)
+	}
+	d.depth++
+	return nil
+}
+
+// Skip 跳过下一个任意 json 值, 检查语法但不解码字符串, 不分配内存
+func (d *Decoder) Skip() error {
+	switch d.peek() {
+	case '{':
+		if err := d.ObjectStart(); err != nil {
+			return err
+		}
+		for first := true; ; first = false {
+			c := d.peek()
+			if c == '}' {
+				d.pos++
+				d.depth--
+				return nil
+			}
+			if !first {
+				if c != ',' {
+					return d.unexpected("',' or '}'")
+				}
+				d.pos++
+				c = d.peek()
+			}
+			if c != '"' {
+				return d.unexpected("object key")
+			}
+			if err := d.skipString(); err != nil {
+				return err
+			}
+			if d.peek() != ':' {
+				return d.unexpected("':'")
+			}
+			d.pos++
+			if err := d.Skip(); err != nil {
+				return err
+			}
+		}
+	case '[':
+		if err := d.ArrayStart(); err != nil {
+			return err
+		}
+		for {
+			ok, err := d.ArrayNext()
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return nil
+			}
+			if err = d.Skip(); err != nil {
+				return err
+			}
+		}
+	case '"':
+		return d.skipString()
+	case 't', 'f':
+		_, err := d.ReadBool()
+		return err
+	case 'n':
+		if d.ReadNull() {
+			return nil
+		}
+	default:
+		if _, err := d.readNumber(); err == nil {
+			return nil
+		}
+	}
+	return d.unexpected("value")
+}
+
+// skipString 跳过一个 json 字符串, 与 readString 做相同的检查但不解码转义字符
+func (d *Decoder) skipString() error {
+	for i := d.pos + 1; i < len(d.data); {
+		c := d.data[i]
+		switch {
+		case c == '"':
+			d.pos = i + 1
+			return nil
+		case c == '\\':
+			if i+1 >= len(d.data) {
+				i++
+				continue
+			}
+			switch d.data[i+1] {
+			case '"', '\\', '/', 'b', 'f', 'n', 'r', 't':
+				i += 2
+			case 'u':
+				_, n := decodeUnicodeEscape(d.data[i:])
+				if n == 0 {
+					d.pos = i
+					return d.errorf("invalid escape sequence in string")
+				}
+				i += n
+			default:
+				d.pos = i
+				return d.errorf("invalid escape sequence in string")
+			}
+		case c < 0x20:
+			d.pos = i
+			return d.errorf("invalid control character %q in string", c)
+		case c >= utf8.RuneSelf:
+			r, size := utf8.DecodeRune(d.data[i:])
+			if r == utf8.RuneError && size == 1 {
+				d.pos = i
+				return d.errorf("invalid UTF-8 in string")
+			}
+			i += size
+		default:
+			i++
+		}
+	}
+	d.pos = len(d.data)
+	return d.errorf("unterminated string")
+}
+
+// readString 读取一个 json 字符串, 没有转义字符时直接返回输入的切片
+func (d *Decoder) readString() ([]byte, error) {
+	if d.peek() != '"' {
+		return nil, d.unexpected("string")
+	}
+	start := d.pos + 1
+	for i := start; i < len(d.data); i++ {
+		c := d.data[i]
+		switch {
+		case c == '"':
+			d.pos = i + 1
+			return d.data[start:i], nil
+		case c == '\\':
+			return d.readEscapedString(start, i)
+		case c < 0x20:
+			d.pos = i
+			return nil, d.errorf("invalid control character %q in string", c)
+		case c >= utf8.RuneSelf:
+			r, size := utf8.DecodeRune(d.data[i:])
+			if r == utf8.RuneError && size == 1 {
+				d.pos = i
+				return nil, d.errorf("invalid UTF-8 in string")
+			}
+			i += size - 1
+		}
+	}
+	d.pos = len(d.data)
+	return nil, d.errorf("unterminated string")
+}
+
+func (d *Decoder) readEscapedString(start, i int) ([]byte, error) {
+	buf := append(d.scratch[:0], d.data[start:i]...)
+	for i < len(d.data) {
+		c := d.data[i]
+		switch {
+		case c == '"':
+			d.pos = i + 1
+			d.scratch = buf
+			return buf, nil
+		case c == '\\':
+			if i+1 >= len(d.data) {
+				i++
+				continue
+			}
+			switch esc := d.data[i+1]; esc {
+			case '"', '\\', '/':
+				buf = append(buf, esc)
+			case 'b':
+				buf = append(buf, '\b')
+			case 'f':
+				buf = append(buf, '\f')
+			case 'n':
+				buf = append(buf, '\n')
+			case 'r':
+				buf = append(buf, '\r')
+			case 't':
+				buf = append(buf, '\t')
+			case 'u':
+				r, n := decodeUnicodeEscape(d.data[i:])
+				if n == 0 {
+					d.pos = i
+					return nil, d.errorf("invalid escape sequence in string")
+				}
+				buf = utf8.AppendRune(buf, r)
+				i += n
+				continue
+			default:
+				d.pos = i
+				return nil, d.errorf("invalid escape sequence in string")
+			}
+			i += 2
+		case c < 0x20:
+			d.pos = i
+			return nil, d.errorf("invalid control character %q in string", c)
+		case c >= utf8.RuneSelf:
+			r, size := utf8.DecodeRune(d.data[i:])
+			if r == utf8.RuneError && size == 1 {
+				d.pos = i
+				return nil, d.errorf("invalid UTF-8 in string")
+			}
+			buf = append(buf, d.data[i:i+size]...)
+			i += size
+		default:
+			buf = append(buf, c)
+			i++
+		}
+	}
+	d.pos = len(d.data)
+	return nil, d.errorf("unterminated string")
+}
+
+// decodeUnicodeEscape 解析 \uXXXX (含代理对), 返回字符与消耗的字节数.
+// 与 protojson 一致, 没有配对的代理项是无效的转义, 返回 0
+func decodeUnicodeEscape(b []byte) (rune, int) {
+	r, ok := hex4(b)
+	if !ok {
+		return 0, 0
+	}
+	if utf16.IsSurrogate(r) {
+		if r2, ok := hex4(b[6:]); ok {
+			if dec := utf16.DecodeRune(r, r2); dec != utf8.RuneError {
+				return dec, 12
+			}
+		}
+		return 0, 0
+	}
+	return r, 6
+}
+
+func hex4(b []byte) (rune, bool) {
+	if len(b) < 6 || b[0] != '\\' || b[1] != 'u' {
+		return 0, false
+	}
+	var r rune
+	for _, c := range b[2:6] {
+		switch {
+		case c >= '0' && c <= '9':
+			c -= '0'
+		case c >= 'a' && c <= 'f':
+			c = c - 'a' + 10
+		case c >= 'A' && c <= 'F':
+			c = c - 'A' + 10
+		default:
+			return 0, false
+		}
+		r = r<<4 | rune(c)
+	}
+	return r, true
+}
+
+// readNumber 按 json 语法读取一个数字字面量
+func (d *Decoder) readNumber() ([]byte, error) {
+	d.skipSpace()
+	start := d.pos
+	end, ok := scanNumber(d.data, start)
+	if !ok {
+		if end == start {
+			return nil, d.unexpected("number")
+		}
+		d.pos = end
+		return nil, d.errorf("invalid number")
+	}
+	d.pos = end
+	return d.data[start:end], nil
+}
+
+// readQuotedNumber 读取字符串形式的数字, 与 protojson 一致字符串中必须是完整的 json 数字, 前后不能有空白,
+// 否则按 token 不符返回 expected number, got string
+func (d *Decoder) readQuotedNumber() ([]byte, error) {
+	start := d.pos
+	lit, err := d.readString()
+	if err != nil {
+		return nil, err
+	}
+	if end, ok := scanNumber(lit, 0); !ok || end != len(lit) {
+		d.pos = start
+		return nil, d.unexpected("number")
+	}
+	return lit, nil
+}
+
+// scanNumber 从 b[i] 开始按 json 语法扫描数字, 返回数字结束的位置,
+// 格式错误时 ok 为 false, 没有数字开头时 end 等于 i
+func scanNumber(b []byte, i int) (end int, ok bool) {
+	start := i
+	if i < len(b) && b[i] == '-' {
+		i++
+	}
+	switch {
+	case i < len(b) && b[i] == '0':
+		i++
+	case i < len(b) && b[i] >= '1' && b[i] <= '9':
+		i = skipDigits(b, i)
+	case i == start:
+		return start, false
+	default:
+		return i, false
+	}
+	if i < len(b) && b[i] == '.' {
+		j := skipDigits(b, i+1)
+		if j == i+1 {
+			return j, false
+		}
+		i = j
+	}
+	if i < len(b) && (b[i] == 'e' || b[i] == 'E') {
+		i++
+		if i < len(b) && (b[i] == '+' || b[i] == '-') {
+			i++
+		}
+		j := skipDigits(b, i)
+		if j == i {
+			return j, false
+		}
+		i = j
+	}
+	return i, true
+}
+
+func skipDigits(b []byte, i int) int {
+	for i < len(b) && b[i] >= '0' && b[i] <= '9' {
+		i++
+	}
+	return i
+}
+
+// parseUint 解析十进制无符号整数, 溢出或格式错误返回 false
+func parseUint(b []byte) (uint64, bool) {
+	if len(b) == 0 {
+		return 0, false
+	}
+	var n uint64
+	for _, c := range b {
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+		v := uint64(c - '0')
+		if n > (math.MaxUint64-v)/10 {
+			return 0, false
+		}
+		n = n*10 + v
+	}
+	return n, true
+}
+
+// parseInt 解析十进制有符号整数, 溢出或格式错误返回 false
+func parseInt(b []byte) (int64, bool) {
+	neg := len(b) > 0 && b[0] == '-'
+	if neg {
+		b = b[1:]
+	}
+	n, ok := parseUint(b)
+	switch {
+	case !ok:
+		return 0, false
+	case neg && n <= 1<<63:
+		return -int64(n), true
+	case !neg && n < 1<<63:
+		return int64(n), true
+	}
+	return 0, false
+}
+
+// ReadBool 读取 true 或 false
+func (d *Decoder) ReadBool() (bool, error) {
+	switch d.peek() {
+	case 't':
+		if d.literal("true") {
+			return true, nil
+		}
+	case 'f':
+		if d.literal("false") {
+			return false, nil
+		}
+	}
+	return false, d.unexpected("bool")
+}
+
+// parseIntegral 解析 json 数字表示的整数, 与 protojson 一致接受 1e2 与 1.0 等值为整数的写法, 返回绝对值与符号.
+// 值不是整数时返回 strconv.ErrSyntax, 绝对值超出 uint64 时返回 strconv.ErrRange
+func parseIntegral(lit []byte) (n uint64, neg bool, err error) {
+	if len(lit) > 0 && lit[0] == '-' {
+		neg, lit = true, lit[1:]
+	}
+	exp := 0
+	if i := bytes.IndexAny(lit, "eE"); i >= 0 {
+		exp = parseExponent(lit[i+1:])
+		lit = lit[:i]
+	}
+	digits, frac := lit, []byte(nil)
+	if i := bytes.IndexByte(lit, '.'); i >= 0 {
+		digits, frac = lit[:i], bytes.TrimRight(lit[i+1:], "0")
+	}
+	if len(frac) == 0 {
+		// 整数部分末尾的 0 移到指数中, 之后有效数字的最后一位不是 0
+		trimmed := bytes.TrimRight(digits, "0")
+		exp += len(digits) - len(trimmed)
+		digits = trimmed
+		if len(digits) == 0 {
+			return 0, neg, nil
+		}
+	}
+	exp -= len(frac)
+	if exp < 0 {
+		return 0, neg, strconv.ErrSyntax
+	}
+	for _, part := range [2][]byte{digits, frac} {
+		for _, c := range part {
+			v := uint64(c - '0')
+			if n > (math.MaxUint64-v)/10 {
+				return 0, neg, strconv.ErrRange
+			}
+			n = n*10 + v
+		}
+	}
+	for ; exp > 0; exp-- {
+		if n > math.MaxUint64/10 {
+			return 0, neg, strconv.ErrRange
+		}
+		n *= 10
+	}
+	return n, neg, nil
+}
+
+// parseExponent 解析指数部分, 绝对值很大时截断, 结果仍然足以判断溢出
+func parseExponent(b []byte) int {
+	neg := len(b) > 0 && b[0] == '-'
+	if len(b) > 0 && (b[0] == '-' || b[0] == '+') {
+		b = b[1:]
+	}
+	exp := 0
+	for _, c := range b {
+		if exp < 1e6 {
+			exp = exp*10 + int(c-'0')
+		}
+	}
+	if neg {
+		return -exp
+	}
+	return exp
+}
+
+// readIntLiteral 读取整数的数字或字符串形式, 所有整数类型都接受两种形式
+func (d *Decoder) readIntLiteral() ([]byte, error) {
+	if d.peek() == '"' {
+		return d.readQuotedNumber()
+	}
+	return d.readNumber()
+}
+
+// readInt 读取有符号整数, 值不是整数时返回 invalid 错误, 超出 bitSize 范围时返回 out of range 错误
+func (d *Decoder) readInt(bitSize int) (int64, error) {
+	start := d.pos
+	lit, err := d.readIntLiteral()
+	if err != nil {
+		return 0, err
+	}
+	n, neg, err := parseIntegral(lit)
+	limit := uint64(1)<<(bitSize-1) - 1
+	if neg {
+		limit++
+	}
+	switch {
+	case err == strconv.ErrSyntax:
+		d.pos = start
+		return 0, d.errorf("invalid int%d value %s", bitSize, lit)
+	case err != nil || n > limit:
+		d.pos = start
+		return 0, d.errorf("int%d value %s out of range", bitSize, lit)
+	case neg:
+		return -int64(n), nil
+	default:
+		return int64(n), nil
+	}
+}
+
+// readUint 读取无符号整数, 值不是整数时返回 invalid 错误, 超出 bitSize 范围或为负数时返回 out of range 错误
+func (d *Decoder) readUint(bitSize int) (uint64, error) {
+	start := d.pos
+	lit, err := d.readIntLiteral()
+	if err != nil {
+		return 0, err
+	}
+	n, neg, err := parseIntegral(lit)
+	switch {
+	case err == strconv.ErrSyntax:
+		d.pos = start
+		return 0, d.errorf("invalid uint%d value %s", bitSize, lit)
+	case err != nil || n > 1<<bitSize-1 || neg && n != 0:
+		d.pos = start
+		return 0, d.errorf("uint%d value %s out of range", bitSize, lit)
+	default:
+		return n, nil
+	}
+}
+
+// ReadInt32 读取 int32, sint32, sfixed32
+func (d *Decoder) ReadInt32() (int32, error) {
+	n, err := d.readInt(32)
+	return int32(n), err
+}
+
+// ReadInt64 读取 int64, sint64, sfixed64
+func (d *Decoder) ReadInt64() (int64, error) {
+	return d.readInt(64)
+}
+
+// ReadUint32 读取 uint32, fixed32
+func (d *Decoder) ReadUint32() (uint32, error) {
+	n, err := d.readUint(32)
+	return uint32(n), err
+}
+
+// ReadUint64 读取 uint64, fixed64
+func (d *Decoder) ReadUint64() (uint64, error) {
+	return d.readUint(64)
+}
+
+// readFloat 读取数字或字符串形式的浮点数, 字符串还可以是 NaN, Infinity 与 -Infinity,
+// 超出 bitSize 范围时返回 out of range 错误
+func (d *Decoder) readFloat(bitSize int) (float64, error) {
+	start := d.pos
+	var lit []byte
+	var err error
+	if d.peek() == '"' {
+		if lit, err = d.readString(); err != nil {
+			return 0, err
+		}
+		switch string(lit) {
+		case "NaN":
+			return math.NaN(), nil
+		case "Infinity":
+			return math.Inf(1), nil
+		case "-Infinity":
+			return math.Inf(-1), nil
+		}
+		if end, ok := scanNumber(lit, 0); !ok || end != len(lit) {
+			d.pos = start
+			return 0, d.unexpected("number")
+		}
+	} else if lit, err = d.readNumber(); err != nil {
+		return 0, err
+	}
+	f, err := strconv.ParseFloat(string(lit), bitSize)
+	if err != nil {
+		d.pos = start
+		return 0, d.errorf("float%d value %s out of range", bitSize, lit)
+	}
+	return f, nil
+}
+
+// ReadFloat32 读取 float
+func (d *Decoder) ReadFloat32() (float32, error) {
+	f, err := d.readFloat(32)
+	return float32(f), err
+}
+
+// ReadFloat64 读取 double
+func (d *Decoder) ReadFloat64() (float64, error) {
+	return d.readFloat(64)
+}
+
+// ReadString 读取 string
+func (d *Decoder) ReadString() (string, error) {
+	b, err := d.readString()
+	return string(b), err
+}
+
+// ReadBytes 读取 base64 编码的 bytes, 兼容标准与 URL 编码以及省略填充
+func (d *Decoder) ReadBytes() ([]byte, error) {
+	start := d.pos
+	b, err := d.readString()
+	if err != nil {
+		return nil, err
+	}
+	enc := base64.StdEncoding
+	for _, c := range b {
+		if c == '-' || c == '_' {
+			enc = base64.URLEncoding
+			break
+		}
+	}
+	if len(b)%4 != 0 {
+		enc = enc.WithPadding(base64.NoPadding)
+	}
+	out := make([]byte, enc.DecodedLen(len(b)))
+	n, err := enc.Decode(out, b)
+	if err != nil {
+		d.pos = start
+		return nil, d.errorf("invalid base64 value")
+	}
+	return out[:n], nil
+}
+
+// ReadEnum 读取枚举值, 支持枚举名字符串与数字两种形式
+func ReadEnum[T ~int32](d *Decoder, values map[string]int32) (T, error) {
+	if d.peek() != '"' {
+		n, err := d.ReadInt32()
+		return T(n), err
+	}
+	start := d.pos
+	name, err := d.readString()
+	if err != nil {
+		return 0, err
+	}
+	n, ok := values[string(name)]
+	if !ok {
+		d.pos = start
+		return 0, d.errorf("invalid enum value %q", name)
+	}
+	return T(n), nil
+}
+
+// ReadNullValue 读取 google.protobuf.NullValue, json 中写作 null
+func ReadNullValue[T ~int32](d *Decoder, values map[string]int32) (T, error) {
+	if d.ReadNull() {
+		return 0, nil
+	}
+	return ReadEnum[T](d, values)
+}
+
+// KeyBool 解析 map 的 bool key
+func (d *Decoder) KeyBool(key []byte) (bool, error) {
+	switch string(key) {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	}
+	return false, d.errorf("invalid map key %q", key)
+}
+
+// KeyInt32 解析 map 的 int32, sint32, sfixed32 key
+func (d *Decoder) KeyInt32(key []byte) (int32, error) {
+	n, err := d.keyInt(key, 32)
+	return int32(n), err
+}
+
+// KeyInt64 解析 map 的 int64, sint64, sfixed64 key
+func (d *Decoder) KeyInt64(key []byte) (int64, error) {
+	return d.keyInt(key, 64)
+}
+
+// KeyUint32 解析 map 的 uint32, fixed32 key
+func (d *Decoder) KeyUint32(key []byte) (uint32, error) {
+	n, err := d.keyUint(key, 32)
+	return uint32(n), err
+}
+
+// KeyUint64 解析 map 的 uint64, fixed64 key
+func (d *Decoder) KeyUint64(key []byte) (uint64, error) {
+	return d.keyUint(key, 64)
+}
+
+// keyInt 与 protojson 使用的 strconv.ParseInt 一致, 有符号整数 key 可以带 '+' 前缀,
+// 无符号整数 key 与 strconv.ParseUint 一致不能带符号. key 总是十进制整数, 不接受值那样的指数与小数写法
+func (d *Decoder) keyInt(key []byte, bitSize int) (int64, error) {
+	digits := key
+	if len(digits) > 1 && digits[0] == '+' && digits[1] != '-' {
+		digits = digits[1:]
+	}
+	n, ok := parseInt(digits)
+	if !ok || n < -1<<(bitSize-1) || n > 1<<(bitSize-1)-1 {
+		return 0, d.errorf("invalid map key %q", key)
+	}
+	return n, nil
+}
+
+func (d *Decoder) keyUint(key []byte, bitSize int) (uint64, error) {
+	n, ok := parseUint(key)
+	if !ok || n > 1<<bitSize-1 {
+		return 0, d.errorf("invalid map key %q", key)
+	}
+	return n, nil
+}
@@ -0,0 +1,347 @@
+package runtime
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestDecoder_ReadString(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    string
+		wantErr bool
+	}{
+		{name: "plain", data: `"abc"`, want: "abc"},
+		{name: "escape", data: `"a\"\\\/\b\f\n\r\t"`, want: "a\"\\/\b\f\n\r\t"},
+		{name: "unicode", data: `"é😀é"`, want: "é😀é"},
+		{name: "control", data: "\"a\nb\"", wantErr: true},
+		{name: "invalid utf8", data: "\"\xff\"", wantErr: true},
+		{name: "bad escape", data: `"\x"`, wantErr: true},
+		{name: "unterminated", data: `"abc`, wantErr: true},
+		{name: "unicode escape", data: `"\u00e9\ud83d\ude00\u0041"`, want: "é😀A"},
+		{name: "lone high surrogate", data: `"\ud800"`, wantErr: true},
+		{name: "lone low surrogate", data: `"a\udc00"`, wantErr: true},
+		{name: "high surrogate then text", data: `"\ud83da"`, wantErr: true},
+		{name: "high surrogate then char", data: `"\ud83d\u0041"`, wantErr: true},
+		{name: "two high surrogates", data: `"\ud83d\ud83d"`, wantErr: true},
+		{name: "reversed surrogates", data: `"\ude00\ud83d"`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Skip 与 ReadString 做相同的检查
+			skipErr := NewDecoder([]byte(tt.data)).Skip()
+			got, err := NewDecoder([]byte(tt.data)).ReadString()
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Error(t, skipErr)
+				return
+			}
+			require.NoError(t, skipErr)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDecoder_ReadInt(t *testing.T) {
+	d := NewDecoder([]byte(`[-2147483648, 2147483647, 2147483648, -9223372036854775808, 9223372036854775808, 1.5]`))
+	require.NoError(t, d.ArrayStart())
+
+	next := func() {
+		ok, err := d.ArrayNext()
+		require.NoError(t, err)
+		require.True(t, ok)
+	}
+	next()
+	i32, err := d.ReadInt32()
+	require.NoError(t, err)
+	require.Equal(t, int32(math.MinInt32), i32)
+	next()
+	i32, err = d.ReadInt32()
+	require.NoError(t, err)
+	require.Equal(t, int32(math.MaxInt32), i32)
+	next()
+	_, err = d.ReadInt32()
+	require.Error(t, err)
+	i64, err := d.ReadInt64()
+	require.NoError(t, err)
+	require.Equal(t, int64(2147483648), i64)
+	next()
+	i64, err = d.ReadInt64()
+	require.NoError(t, err)
+	require.Equal(t, int64(math.MinInt64), i64)
+	next()
+	_, err = d.ReadInt64()
+	require.Error(t, err)
+	u64, err := d.ReadUint64()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1<<63), u64)
+	next()
+	_, err = d.ReadUint32()
+	require.Error(t, err)
+}
+
+func TestDecoder_Key(t *testing.T) {
+	tests := []struct {
+		key     string
+		int32   int32
+		uint32  uint32
+		int64   int64
+		uint64  uint64
+		signed  bool
+		wantErr bool
+	}{
+		{key: "1", int32: 1, uint32: 1, int64: 1, uint64: 1},
+		{key: "+1", int32: 1, int64: 1, signed: true},
+		{key: "-0", signed: true},
+		{key: "+0", signed: true},
+		{key: "-2147483648", int32: math.MinInt32, int64: math.MinInt32, signed: true},
+		{key: "+", wantErr: true},
+		{key: "+-1", wantErr: true},
+		{key: "++1", wantErr: true},
+		{key: "1e2", wantErr: true},
+		{key: "1.0", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			d := NewDecoder(nil)
+			i32, err32 := d.KeyInt32([]byte(tt.key))
+			i64, err64 := d.KeyInt64([]byte(tt.key))
+			u32, errU32 := d.KeyUint32([]byte(tt.key))
+			u64, errU64 := d.KeyUint64([]byte(tt.key))
+			// 与 protojson 解析 map key 使用的 strconv 一致
+			_, expect32 := strconv.ParseInt(tt.key, 10, 32)
+			_, expectU32 := strconv.ParseUint(tt.key, 10, 32)
+			require.Equal(t, expect32 == nil, err32 == nil, "int32")
+			require.Equal(t, expect32 == nil, err64 == nil, "int64")
+			require.Equal(t, expectU32 == nil, errU32 == nil, "uint32")
+			require.Equal(t, expectU32 == nil, errU64 == nil, "uint64")
+			if tt.wantErr {
+				require.Error(t, err32)
+				return
+			}
+			require.NoError(t, err32)
+			require.Equal(t, tt.int32, i32)
+			require.Equal(t, tt.int64, i64)
+			if !tt.signed {
+				require.Equal(t, tt.uint32, u32)
+				require.Equal(t, tt.uint64, u64)
+			}
+		})
+	}
+}
+
+func TestDecoder_ReadNumber(t *testing.T) {
+	read := map[string]struct {
+		decode func(d *Decoder) (interface{}, error)
+		expect func() proto.Message
+	}{
+		"int32": {
+			func(d *Decoder) (interface{}, error) { n, err := d.ReadInt32(); return wrapperspb.Int32(n), err },
+			func() proto.Message { return new(wrapperspb.Int32Value) },
+		},
+		"uint32": {
+			func(d *Decoder) (interface{}, error) { n, err := d.ReadUint32(); return wrapperspb.UInt32(n), err },
+			func() proto.Message { return new(wrapperspb.UInt32Value) },
+		},
+		"int64": {
+			func(d *Decoder) (interface{}, error) { n, err := d.ReadInt64(); return wrapperspb.Int64(n), err },
+			func() proto.Message { return new(wrapperspb.Int64Value) },
+		},
+		"uint64": {
+			func(d *Decoder) (interface{}, error) { n, err := d.ReadUint64(); return wrapperspb.UInt64(n), err },
+			func() proto.Message { return new(wrapperspb.UInt64Value) },
+		},
+		"float": {
+			func(d *Decoder) (interface{}, error) { f, err := d.ReadFloat32(); return wrapperspb.Float(f), err },
+			func() proto.Message { return new(wrapperspb.FloatValue) },
+		},
+		"double": {
+			func(d *Decoder) (interface{}, error) { f, err := d.ReadFloat64(); return wrapperspb.Double(f), err },
+			func() proto.Message { return new(wrapperspb.DoubleValue) },
+		},
+	}
+	tests := []struct {
+		kind    string
+		data    string
+		want    proto.Message
+		wantErr string
+	}{
+		{kind: "int32", data: `"123"`, want: wrapperspb.Int32(123)},
+		{kind: "int32", data: `1e2`, want: wrapperspb.Int32(100)},
+		{kind: "int32", data: `1.0`, want: wrapperspb.Int32(1)},
+		{kind: "int32", data: `-0.5e1`, want: wrapperspb.Int32(-5)},
+		{kind: "int32", data: `1500e-2`, want: wrapperspb.Int32(15)},
+		{kind: "int32", data: `"-2.147483648e9"`, want: wrapperspb.Int32(math.MinInt32)},
+		{kind: "int32", data: `0e999999999`, want: wrapperspb.Int32(0)},
+		{kind: "int32", data: `-0`, want: wrapperspb.Int32(0)},
+		{kind: "int32", data: `1.5`, wantErr: "invalid int32 value 1.5"},
+		{kind: "int32", data: `1e-1`, wantErr: "invalid int32 value 1e-1"},
+		{kind: "int32", data: `2147483648`, wantErr: "int32 value 2147483648 out of range"},
+		{kind: "int32", data: `"-3e9"`, wantErr: "int32 value -3e9 out of range"},
+		{kind: "int32", data: `1e999999999`, wantErr: "int32 value 1e999999999 out of range"},
+		{kind: "int32", data: `" 1"`, wantErr: "expected number, got string"},
+		{kind: "int32", data: `"1x"`, wantErr: "expected number, got string"},
+		{kind: "int32", data: `""`, wantErr: "expected number, got string"},
+		{kind: "int32", data: `"01"`, wantErr: "expected number, got string"},
+		{kind: "int32", data: `true`, wantErr: "expected number, got bool"},
+		{kind: "uint32", data: `"4294967295"`, want: wrapperspb.UInt32(math.MaxUint32)},
+		{kind: "uint32", data: `4.294967295e9`, want: wrapperspb.UInt32(math.MaxUint32)},
+		{kind: "uint32", data: `-0.0`, want: wrapperspb.UInt32(0)},
+		{kind: "uint32", data: `4294967296`, wantErr: "uint32 value 4294967296 out of range"},
+		{kind: "uint32", data: `-1`, wantErr: "uint32 value -1 out of range"},
+		{kind: "int64", data: `"-9223372036854775808"`, want: wrapperspb.Int64(math.MinInt64)},
+		{kind: "int64", data: `9.223372036854775807e18`, want: wrapperspb.Int64(math.MaxInt64)},
+		{kind: "int64", data: `"9223372036854775808"`, wantErr: "int64 value 9223372036854775808 out of range"},
+		{kind: "uint64", data: `"18446744073709551615"`, want: wrapperspb.UInt64(math.MaxUint64)},
+		{kind: "uint64", data: `1.8446744073709551615e19`, want: wrapperspb.UInt64(math.MaxUint64)},
+		{kind: "uint64", data: `18446744073709551616`, wantErr: "uint64 value 18446744073709551616 out of range"},
+		{kind: "uint64", data: `1844674407370955161.6`, wantErr: "invalid uint64 value 1844674407370955161.6"},
+		{kind: "float", data: `"NaN"`, want: wrapperspb.Float(float32(math.NaN()))},
+		{kind: "float", data: `"-Infinity"`, want: wrapperspb.Float(float32(math.Inf(-1)))},
+		{kind: "float", data: `"1.5"`, want: wrapperspb.Float(1.5)},
+		{kind: "float", data: `3.4028234663852886e38`, want: wrapperspb.Float(math.MaxFloat32)},
+		{kind: "float", data: `3.5e38`, wantErr: "float32 value 3.5e38 out of range"},
+		{kind: "float", data: `"inf"`, wantErr: "expected number, got string"},
+		{kind: "double", data: `"Infinity"`, want: wrapperspb.Double(math.Inf(1))},
+		{kind: "double", data: `"1e2"`, want: wrapperspb.Double(100)},
+		{kind: "double", data: `1e309`, wantErr: "float64 value 1e309 out of range"},
+		{kind: "double", data: `"nan"`, wantErr: "expected number, got string"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.kind+" "+tt.data, func(t *testing.T) {
+			r := read[tt.kind]
+			got, err := r.decode(NewDecoder([]byte(tt.data)))
+			expect := r.expect()
+			expectErr := protojson.Unmarshal([]byte(tt.data), expect)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				require.Error(t, expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, proto.Equal(tt.want, got.(proto.Message)), "got %v", got)
+			require.NoError(t, expectErr)
+			require.True(t, proto.Equal(expect, got.(proto.Message)), "protojson %v", expect)
+		})
+	}
+}
+
+func TestDecoder_Skip(t *testing.T) {
+	d := NewDecoder([]byte(` {"a": [1, -2.5e+3, "x\"", true, false, null, {}], "b": {"c": []}} `))
+	require.NoError(t, d.Skip())
+	require.NoError(t, d.End())
+
+	for _, data := range []string{`{"a":}`, `[1,]`, `{"a" 1}`, `01`, `-`, `1.`, `tru`, `{"a":1,}`, `{,"a":1}`,
+		`"\x"`, `"\u12"`, "\"\x01\"", "\"\xff\"", `"abc`, `{"a\u":1}`} {
+		d := NewDecoder([]byte(data))
+		err := d.Skip()
+		if err == nil {
+			err = d.End()
+		}
+		require.Error(t, err, data)
+	}
+
+	deep := strings.Repeat("[", maxDepth+1) + strings.Repeat("]", maxDepth+1)
+	require.ErrorContains(t, NewDecoder([]byte(deep)).Skip(), "max nesting depth")
+
+	data := []byte(`{"a\u00e9\n":[{"b":"\ud83d\ude00é"},1e3,null],"c":{}}`)
+	allocs := testing.AllocsPerRun(100, func() {
+		d := NewDecoder(data)
+		if err := d.Skip(); err != nil {
+			t.Fatal(err)
+		}
+	})
+	require.LessOrEqual(t, allocs, 1.0) // 只有 Decoder 本身
+}
+
+func TestDecoder_Depth(t *testing.T) {
+	// 依次读取 n 层数组, 返回第一个错误
+	open := func(d *Decoder, n int) error {
+		for i := 0; i < n; i++ {
+			if err := d.ArrayStart(); err != nil {
+				return err
+			}
+			if _, err := d.ArrayNext(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	deep := strings.Repeat("[", maxDepth) + strings.Repeat("]", maxDepth)
+	d := NewDecoder([]byte(deep))
+	require.NoError(t, open(d, maxDepth))
+	require.Equal(t, maxDepth-1, d.depth) // 最内层是空数组, ArrayNext 已经读到它的 ']'
+
+	d = NewDecoder([]byte("[" + deep + "]"))
+	err := open(d, maxDepth+1)
+	var e *Error
+	require.ErrorAs(t, err, &e)
+	require.Equal(t, "exceeded max nesting depth 10000", e.Msg)
+	require.Equal(t, maxDepth, e.Offset)
+
+	// 读完的对象与数组不计入深度, 并列的值不会累积
+	siblings := "[" + strings.Repeat(`{"a":[]},`, maxDepth) + "{}]"
+	d = NewDecoder([]byte(siblings))
+	require.NoError(t, d.Skip())
+	require.Zero(t, d.depth)
+	d = NewDecoder([]byte(`{"a":{"b":[1]}}`))
+	require.NoError(t, d.ObjectStart())
+	_, _, err = d.ObjectNext()
+	require.NoError(t, err)
+	require.NoError(t, d.Skip())
+	_, ok, err := d.ObjectNext()
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Zero(t, d.depth)
+}
+
+func TestDecoder_Unknown(t *testing.T) {
+	read := func(opts UnmarshalOptions, data string) error {
+		d := opts.NewDecoder([]byte(data))
+		if err := d.ObjectStart(); err != nil {
+			return err
+		}
+		for {
+			key, ok, err := d.ObjectNext()
+			if err != nil || !ok {
+				return err
+			}
+			if err = d.Unknown("pb.Test", key); err != nil {
+				return err
+			}
+		}
+	}
+	data := `{"x":{"y":[1,"}"]},"z":null}`
+	require.EqualError(t, read(UnmarshalOptions{}, data), `json: unknown field "x" in pb.Test at line 1 column 6`)
+	require.NoError(t, read(UnmarshalOptions{DiscardUnknown: true}, data))
+	require.Error(t, read(UnmarshalOptions{DiscardUnknown: true}, `{"x":{"y":}}`))
+}
+
+func TestDecoder_ReadBytes(t *testing.T) {
+	for _, data := range []string{`"-_8="`, `"+/8="`, `"+/8"`, `"-_8"`} {
+		got, err := NewDecoder([]byte(data)).ReadBytes()
+		require.NoError(t, err, data)
+		require.Equal(t, []byte{0xfb, 0xff}, got, data)
+	}
+}
+
+func TestDecoder_Duplicate(t *testing.T) {
+	d := NewDecoder([]byte(`{"a":1}`))
+	require.EqualError(t, d.Duplicate("pb.Test", []byte("a")), `json: duplicate field "a" in pb.Test at line 1 column 1`)
+	require.EqualError(t, d.OneofConflict("pb.Test.o", []byte("b")), `json: oneof pb.Test.o is already set, got field "b" at line 1 column 1`)
+	require.EqualError(t, d.DuplicateKey([]byte("01")), `json: duplicate map key "01" at line 1 column 1`)
+
+	d = UnmarshalOptions{AllowDuplicates: true}.NewDecoder([]byte(`{"a":1}`))
+	require.NoError(t, d.Duplicate("pb.Test", []byte("a")))
+	require.NoError(t, d.OneofConflict("pb.Test.o", []byte("b")))
+	require.NoError(t, d.DuplicateKey([]byte("01")))
+}
@@ -0,0 +1,143 @@
+package runtime
+
+import (
+	"cmp"
+	"encoding/base64"
+	"errors"
+	"math"
+	"slices"
+	"strconv"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ErrInvalidUTF8 string 字段包含非法 UTF-8, 与 protojson 一致拒绝编码
+var ErrInvalidUTF8 = errors.New("json: string field contains invalid UTF-8")
+
+const hex = "0123456789abcdef"
+
+// AppendString 按 RFC 8259 转义 s 并以 json 字符串形式追加到 dst
+func AppendString(dst []byte, s string) ([]byte, error) {
+	return appendString(dst, s, false)
+}
+
+// AppendStringHTML 同 AppendString, 额外转义 <, >, &, U+2028, U+2029,
+// 与 encoding/json 默认行为一致, 可以安全嵌入 html
+func AppendStringHTML(dst []byte, s string) ([]byte, error) {
+	return appendString(dst, s, true)
+}
+
+func appendString(dst []byte, s string, html bool) ([]byte, error) {
+	dst = append(dst, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c < utf8.RuneSelf {
+			if c >= 0x20 && c != '"' && c != '\\' && (!html || c != '<' && c != '>' && c != '&') {
+				i++
+				continue
+			}
+			dst = append(dst, s[start:i]...)
+			switch c {
+			case '"', '\\':
+				dst = append(dst, '\\', c)
+			case '\b':
+				dst = append(dst, '\\', 'b')
+			case '\f':
+				dst = append(dst, '\\', 'f')
+			case '\n':
+				dst = append(dst, '\\', 'n')
+			case '\r':
+				dst = append(dst, '\\', 'r')
+			case '\t':
+				dst = append(dst, '\\', 't')
+			default:
+				dst = append(dst, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xf])
+			}
+			i++
+			start = i
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			return dst, ErrInvalidUTF8
+		}
+		if html && (r == '\u2028' || r == '\u2029') {
+			dst = append(dst, s[start:i]...)
+			dst = append(dst, '\\', 'u', '2', '0', '2', hex[r&0xf])
+			start = i + size
+		}
+		i += size
+	}
+	dst = append(dst, s[start:]...)
+	return append(dst, '"'), nil
+}
+
+// AppendFloat 以 proto3 json 格式追加浮点数, NaN 与正负无穷写成 "NaN", "Infinity", "-Infinity",
+// 绝对值小于 1e-6 或不小于 1e21 时使用指数格式, 与 protojson 以及 encoding/json 一致
+func AppendFloat(dst []byte, f float64, bitSize int) []byte {
+	switch {
+	case math.IsNaN(f):
+		return append(dst, `"NaN"`...)
+	case math.IsInf(f, 1):
+		return append(dst, `"Infinity"`...)
+	case math.IsInf(f, -1):
+		return append(dst, `"-Infinity"`...)
+	}
+	format := byte('f')
+	if abs := math.Abs(f); abs != 0 {
+		if bitSize == 64 && (abs < 1e-6 || abs >= 1e21) ||
+			bitSize == 32 && (float32(abs) < 1e-6 || float32(abs) >= 1e21) {
+			format = 'e'
+		}
+	}
+	dst = strconv.AppendFloat(dst, f, format, -1, bitSize)
+	if format == 'e' {
+		// 1e-07 简写为 1e-7
+		n := len(dst)
+		if n >= 4 && dst[n-4] == 'e' && dst[n-3] == '-' && dst[n-2] == '0' {
+			dst[n-2] = dst[n-1]
+			dst = dst[:n-1]
+		}
+	}
+	return dst
+}
+
+// AppendBytes 以带 padding 的标准 base64 编码追加 bytes 字段, 结果为 json 字符串
+func AppendBytes(dst []byte, b []byte) []byte {
+	n := base64.StdEncoding.EncodedLen(len(b))
+	dst = slices.Grow(dst, n+2)
+	dst = append(dst, '"')
+	base64.StdEncoding.Encode(dst[len(dst):len(dst)+n], b)
+	dst = dst[:len(dst)+n]
+	return append(dst, '"')
+}
+
+// AppendEnum 追加枚举名, 未定义的枚举值与 protojson 一致写成数字
+func AppendEnum[T interface {
+	~int32
+	protoreflect.Enum
+}](dst []byte, v T) []byte {
+	if ev := v.Descriptor().Values().ByNumber(protoreflect.EnumNumber(v)); ev != nil {
+		dst = append(dst, '"')
+		dst = append(dst, ev.Name()...)
+		return append(dst, '"')
+	}
+	return strconv.AppendInt(dst, int64(v), 10)
+}
+
+// SortedKeys 把 map key 追加到 buf[:0] 并升序排列, Deterministic 模式下按 key 顺序写 map,
+// 与 protojson 的 Deterministic 排序一致: 数字按大小, 字符串按字节序.
+// 生成代码传入栈上数组的切片, 容量足够时不分配, 不够时按 len(m) 分配一次
+func SortedKeys[K cmp.Ordered, V any](m map[K]V, buf []K) []K {
+	keys := buf[:0]
+	if cap(keys) < len(m) {
+		keys = make([]K, 0, len(m))
+	}
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,119 @@
+package runtime
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestAppendString(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     string
+		want     string
+		wantHTML string
+		wantErr  bool
+	}{
+		{name: "empty", args: "", want: `""`, wantHTML: `""`},
+		{name: "plain", args: "abc", want: `"abc"`, wantHTML: `"abc"`},
+		{name: "quote", args: `a"b\c`, want: `"a\"b\\c"`, wantHTML: `"a\"b\\c"`},
+		{
+			name:     "control",
+			args:     "\b\f\n\r\t\x00\x1f",
+			want:     `"\b\f\n\r\t\u0000\u001f"`,
+			wantHTML: `"\b\f\n\r\t\u0000\u001f"`,
+		},
+		{name: "html", args: "<a>&", want: `"<a>&"`, wantHTML: `"\u003ca\u003e\u0026"`},
+		{name: "unicode", args: "é😀\u2028\u2029", want: "\"é😀\u2028\u2029\"", wantHTML: `"é😀\u2028\u2029"`},
+		{name: "invalid utf8", args: "a\xffb", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AppendString([]byte("x"), tt.args)
+			gotHTML, errHTML := AppendStringHTML(nil, tt.args)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidUTF8)
+				require.ErrorIs(t, errHTML, ErrInvalidUTF8)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, errHTML)
+			require.Equal(t, "x"+tt.want, string(got))
+			require.Equal(t, tt.wantHTML, string(gotHTML))
+
+			decoded, err := NewDecoder(got[1:]).ReadString()
+			require.NoError(t, err)
+			require.Equal(t, tt.args, decoded)
+		})
+	}
+}
+
+func TestAppendFloat(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    float64
+		bitSize int
+		want    string
+	}{
+		{name: "zero", args: 0, bitSize: 64, want: `0`},
+		{name: "fraction", args: 0.1, bitSize: 64, want: `0.1`},
+		{name: "float32 fraction", args: float64(float32(0.1)), bitSize: 32, want: `0.1`},
+		{name: "negative", args: -1.5, bitSize: 64, want: `-1.5`},
+		{name: "large", args: 1e300, bitSize: 64, want: `1e+300`},
+		{name: "large float32", args: float64(float32(1e30)), bitSize: 32, want: `1e+30`},
+		{name: "boundary", args: 1e20, bitSize: 64, want: `100000000000000000000`},
+		{name: "small", args: 1e-7, bitSize: 64, want: `1e-7`},
+		{name: "small negative", args: -1.5e-300, bitSize: 64, want: `-1.5e-300`},
+		{name: "nan", args: math.NaN(), bitSize: 64, want: `"NaN"`},
+		{name: "infinity", args: math.Inf(1), bitSize: 32, want: `"Infinity"`},
+		{name: "negative infinity", args: math.Inf(-1), bitSize: 64, want: `"-Infinity"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AppendFloat(nil, tt.args, tt.bitSize)
+			require.Equal(t, tt.want, string(got))
+
+			decoded, err := NewDecoder(got).readFloat(tt.bitSize)
+			require.NoError(t, err)
+			if math.IsNaN(tt.args) {
+				require.True(t, math.IsNaN(decoded))
+			} else {
+				require.Equal(t, tt.args, decoded)
+			}
+		})
+	}
+}
+
+func TestAppendBytes(t *testing.T) {
+	require.Equal(t, `""`, string(AppendBytes(nil, nil)))
+	require.Equal(t, `"MDs="`, string(AppendBytes(nil, []byte{48, 59})))
+	require.Equal(t, `x"/+8="`, string(AppendBytes([]byte("x"), []byte{0xff, 0xef})))
+}
+
+func TestAppendEnum(t *testing.T) {
+	require.Equal(t, `"NULL_VALUE"`, string(AppendEnum(nil, structpb.NullValue_NULL_VALUE)))
+	require.Equal(t, `7`, string(AppendEnum(nil, structpb.NullValue(7))))
+	require.Equal(t, `-1`, string(AppendEnum(nil, structpb.NullValue(-1))))
+}
+
+func TestSortedKeys(t *testing.T) {
+	require.Equal(t, []int32{-3, -1, 0, 2}, SortedKeys(map[int32]bool{2: true, -1: true, 0: true, -3: true}, nil))
+	require.Equal(t, []string{"", "A", "a", "b"}, SortedKeys(map[string]int{"b": 1, "a": 2, "A": 3, "": 4}, nil))
+	require.Empty(t, SortedKeys(map[uint64]int(nil), nil))
+
+	// buf 的旧内容被覆盖, 容量足够时复用 buf 的底层数组
+	var buf [4]uint32
+	buf[0] = 9
+	keys := SortedKeys(map[uint32]bool{3: true, 1: true}, buf[:1])
+	require.Equal(t, []uint32{1, 3}, keys)
+	require.Same(t, &buf[0], &keys[0])
+	require.Equal(t, []uint32{0, 1, 2, 3, 4}, SortedKeys(map[uint32]bool{4: true, 3: true, 2: true, 1: true, 0: true}, buf[:0]))
+
+	m := map[string]int{"b": 1, "a": 2}
+	require.Zero(t, testing.AllocsPerRun(100, func() {
+		var buf [16]string
+		_ = SortedKeys(m, buf[:0])
+	}))
+}
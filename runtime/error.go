@@ -0,0 +1,133 @@
+package runtime
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+)
+
+// Error 解码错误, 记录出错的位置与 proto 字段路径, 供调用方返回精确的错误信息.
+// 生成的 DecodeJSON 在错误返回时逐层加上字段名, 解码成功时没有额外开销
+type Error struct {
+	// Offset 出错位置的字节偏移
+	Offset int
+	// Line, Column 出错位置的行号与列号, 从 1 开始, 列号按字节计算
+	Line, Column int
+	// Path proto 字段路径, 例如 pb.Map.numbers["12"].u32, 直接使用 Decoder 时可能为空
+	Path string
+	// Expected, Actual 期望与实际的 token, 例如 number 与 string, 不是 token 不符时为空
+	Expected, Actual string
+	// Msg 错误描述, 不含位置与路径
+	Msg string
+}
+
+// Error 格式为 pb.Map.numbers["12"].u32: expected number, got string at line 3 column 17,
+// 没有字段路径时以 json 开头
+func (e *Error) Error() string {
+	path := e.Path
+	if path == "" {
+		path = "json"
+	}
+	return fmt.Sprintf("%s: %s at line %d column %d", path, e.Msg, e.Line, e.Column)
+}
+
+func (d *Decoder) errorf(format string, args ...interface{}) error {
+	return d.newError(fmt.Sprintf(format, args...))
+}
+
+func (d *Decoder) newError(msg string) *Error {
+	pos := d.pos
+	if pos > len(d.data) {
+		pos = len(d.data)
+	}
+	return &Error{
+		Offset: pos,
+		Line:   bytes.Count(d.data[:pos], []byte{'\n'}) + 1,
+		Column: pos - bytes.LastIndexByte(d.data[:pos], '\n'),
+		Msg:    msg,
+	}
+}
+
+// unexpected 返回当前位置 token 不符合预期的错误
+func (d *Decoder) unexpected(expect string) error {
+	actual := "end of input"
+	if d.pos < len(d.data) {
+		actual = tokenName(d.data[d.pos:])
+	}
+	e := d.newError("expected " + expect + ", got " + actual)
+	e.Expected, e.Actual = expect, actual
+	return e
+}
+
+// tokenName 返回 b 开头的 token 的名称, true, false, null 要求字面量完整,
+// 否则例如 tru 报告为 invalid token "tru" 而不是 bool
+func tokenName(b []byte) string {
+	switch c := b[0]; {
+	case c == '{' || c == '}' || c == '[' || c == ']' || c == ',' || c == ':':
+		return strconv.QuoteRune(rune(c))
+	case c == '"':
+		return "string"
+	case bytes.HasPrefix(b, []byte("true")) || bytes.HasPrefix(b, []byte("false")):
+		return "bool"
+	case bytes.HasPrefix(b, []byte("null")):
+		return "null"
+	case c == 't' || c == 'f' || c == 'n':
+		return "invalid token " + strconv.Quote(string(literalWord(b)))
+	case c == '-' || (c >= '0' && c <= '9'):
+		return "number"
+	default:
+		return "invalid character " + strconv.QuoteRune(rune(c))
+	}
+}
+
+// WithField 在 err 的字段路径前加上字段名, 生成的 DecodeJSON 在字段解码出错时调用, 其他错误原样返回
+func WithField(err error, field string) error {
+	return withPath(err, field)
+}
+
+// WithIndex 在 err 的字段路径前加上 list 字段名与元素下标
+func WithIndex(err error, field string, index int) error {
+	if e, ok := err.(*Error); ok {
+		return withPath(e, field+"["+strconv.Itoa(index)+"]")
+	}
+	return err
+}
+
+// WithKey 在 err 的字段路径前加上 map 字段名与 key, key 按 json 中的字符串形式写出
+func WithKey[K comparable](err error, field string, key K) error {
+	if e, ok := err.(*Error); ok {
+		return withPath(e, field+"["+strconv.Quote(fmt.Sprint(key))+"]")
+	}
+	return err
+}
+
+// WithMessage 在 err 的字段路径前加上 message 的全名, 生成的 UnmarshalJSON 在最外层调用
+func WithMessage(err error, message string) error {
+	return withPath(err, message)
+}
+
+func withPath(err error, name string) error {
+	e, ok := err.(*Error)
+	if !ok {
+		return err
+	}
+	if e.Path == "" {
+		e.Path = name
+	} else {
+		e.Path = name + "." + e.Path
+	}
+	return e
+}
+
+// literalWord 返回 b 开头由字母与数字组成的部分, 最多 16 个字节
+func literalWord(b []byte) []byte {
+	n := 0
+	for n < len(b) && n < 16 {
+		c := b[n]
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9') {
+			break
+		}
+		n++
+	}
+	return b[:n]
+}
@@ -0,0 +1,107 @@
+package runtime
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestError(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		read func(d *Decoder) error
+		want Error
+	}{
+		{
+			name: "token",
+			data: "{\n  \"a\": \"x\"}",
+			read: func(d *Decoder) error {
+				_, _, _ = d.ObjectNext()
+				_, err := d.ReadInt32()
+				return err
+			},
+			want: Error{Offset: 9, Line: 2, Column: 8, Expected: "number", Actual: "string", Msg: "expected number, got string"},
+		},
+		{
+			name: "end of input",
+			data: "[\r\n1,",
+			read: func(d *Decoder) error {
+				_, _ = d.ArrayNext()
+				_, _ = d.ReadInt32()
+				_, _ = d.ArrayNext()
+				_, err := d.ReadInt32()
+				return err
+			},
+			want: Error{Offset: 5, Line: 2, Column: 3, Expected: "number", Actual: "end of input", Msg: "expected number, got end of input"},
+		},
+		{
+			name: "invalid literal",
+			data: "[1,tru]",
+			read: func(d *Decoder) error {
+				_, _ = d.ArrayNext()
+				_, _ = d.ReadInt32()
+				_, _ = d.ArrayNext()
+				_, err := d.ReadBool()
+				return err
+			},
+			want: Error{Offset: 3, Line: 1, Column: 4, Expected: "bool", Actual: `invalid token "tru"`, Msg: `expected bool, got invalid token "tru"`},
+		},
+		{
+			name: "literal",
+			data: "[null]",
+			read: func(d *Decoder) error {
+				_, _ = d.ArrayNext()
+				_, err := d.ReadBool()
+				return err
+			},
+			want: Error{Offset: 1, Line: 1, Column: 2, Expected: "bool", Actual: "null", Msg: "expected bool, got null"},
+		},
+		{
+			name: "value",
+			data: `"a\x"`,
+			read: func(d *Decoder) error {
+				_, err := d.ReadString()
+				return err
+			},
+			want: Error{Offset: 2, Line: 1, Column: 3, Msg: "invalid escape sequence in string"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDecoder([]byte(tt.data))
+			if tt.data[0] == '{' {
+				require.NoError(t, d.ObjectStart())
+			} else if tt.data[0] == '[' {
+				require.NoError(t, d.ArrayStart())
+			}
+			err := tt.read(d)
+			var e *Error
+			require.True(t, errors.As(err, &e), "%v", err)
+			require.Equal(t, tt.want, *e)
+		})
+	}
+}
+
+func TestError_Path(t *testing.T) {
+	d := NewDecoder([]byte("[\n true]"))
+	_ = d.ArrayStart()
+	_, _ = d.ArrayNext()
+	_, err := d.ReadUint32()
+	err = WithField(err, "u32")
+	err = WithKey(err, "numbers", uint32(12))
+	err = WithIndex(err, "maps", 3)
+	err = WithMessage(err, "pb.Message")
+	require.EqualError(t, err, `pb.Message.maps[3].numbers["12"].u32: expected number, got bool at line 2 column 2`)
+	require.Equal(t, `pb.Message.maps[3].numbers["12"].u32`, err.(*Error).Path)
+
+	require.EqualError(t, WithKey(d.errorf("bad"), "strings", `a"b`), `strings["a\"b"]: bad at line 2 column 2`)
+	require.EqualError(t, d.errorf("bad"), `json: bad at line 2 column 2`)
+
+	// 不是 *Error 的错误原样返回
+	other := errors.New("other")
+	require.Equal(t, other, WithField(other, "a"))
+	require.Equal(t, other, WithIndex(other, "a", 1))
+	require.Equal(t, other, WithKey(other, "a", "k"))
+}
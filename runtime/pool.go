@@ -0,0 +1,116 @@
+package runtime
+
+import (
+	"math/bits"
+	"sync"
+	"sync/atomic"
+)
+
+// buffer 按容量分级放在不同的 pool 中, 第 i 级的容量至少为 minPooledSize << i,
+// 超过 maxPooledSize 的 buffer 不放回 pool, 避免偶发的大 message 长期占用内存
+const (
+	minPooledSize = 256
+	maxPooledSize = 64 << 10
+	sizeClasses   = 9
+)
+
+var bufferPools [sizeClasses]sync.Pool
+
+// sizeClass 返回容量不小于 n 的最小级别, n 超过 maxPooledSize 时返回 sizeClasses
+func sizeClass(n int) int {
+	if n <= minPooledSize {
+		return 0
+	}
+	return bits.Len(uint(n-1)) - bits.Len(minPooledSize-1)
+}
+
+// SizeHint 记录同一个 message 类型最近的编码大小, 生成代码为每个 message 声明一个,
+// 借出 buffer 时按它选择容量级别. 记录的值是衰减的最大值, 偶发的大 message 会逐渐被遗忘
+type SizeHint struct {
+	size atomic.Int64
+}
+
+// Size 返回预计的编码大小
+func (h *SizeHint) Size() int {
+	if h == nil {
+		return 0
+	}
+	return int(h.size.Load())
+}
+
+// Record 记录一次编码的大小, 并发调用时可能丢失部分记录, 只影响预计的精度
+func (h *SizeHint) Record(n int) {
+	if h == nil {
+		return
+	}
+	size := h.size.Load()
+	size -= size >> 3
+	if int64(n) > size {
+		size = int64(n)
+	}
+	h.size.Store(size)
+}
+
+// PooledBuffer 是从 sync.Pool 借出的 writer, 配置 Writer=pool 时生成的 MarshalJSON 使用它代替 bytes.Buffer,
+// 反复编码时复用已经增长的内存
+type PooledBuffer struct {
+	buf  []byte
+	hint *SizeHint
+}
+
+// NewPooledBuffer 从 pool 借出一个空的 PooledBuffer, 用完后必须调用 Detach 或 Release 归还
+func NewPooledBuffer() *PooledBuffer {
+	return NewPooledBufferHint(nil)
+}
+
+// NewPooledBufferHint 按 hint 预计的大小借出容量足够的 PooledBuffer, Detach 时把编码大小记录到 hint
+func NewPooledBufferHint(hint *SizeHint) *PooledBuffer {
+	size := hint.Size()
+	class := sizeClass(size)
+	var b *PooledBuffer
+	if class < sizeClasses {
+		b, _ = bufferPools[class].Get().(*PooledBuffer)
+		size = minPooledSize << class
+	}
+	if b == nil {
+		b = &PooledBuffer{buf: make([]byte, 0, size)}
+	}
+	b.hint = hint
+	return b
+}
+
+// AvailableBuffer 返回长度为 0 的可用空间, 追加后通过 Write 写回时不会发生复制以外的分配
+func (b *PooledBuffer) AvailableBuffer() []byte {
+	return b.buf[len(b.buf):]
+}
+
+// Write 追加 p, 总是返回 len(p), nil
+func (b *PooledBuffer) Write(p []byte) (int, error) {
+	b.buf = append(b.buf, p...)
+	return len(p), nil
+}
+
+// Len 返回已写入的字节数
+func (b *PooledBuffer) Len() int {
+	return len(b.buf)
+}
+
+// Detach 返回已写入内容的副本并归还 b, 返回的 slice 大小正好, 不与 pool 共享内存,
+// 调用者可以任意持有与修改. 之后不能再使用 b
+func (b *PooledBuffer) Detach() []byte {
+	b.hint.Record(len(b.buf))
+	data := make([]byte, len(b.buf))
+	copy(data, b.buf)
+	b.Release()
+	return data
+}
+
+// Release 丢弃已写入的内容并归还 b, 编码出错时使用, 之后不能再使用 b
+func (b *PooledBuffer) Release() {
+	// 放回容量不超过 cap 的最大级别, 保证从该级别借出的 buffer 容量足够
+	if cap(b.buf) < minPooledSize || cap(b.buf) > maxPooledSize {
+		return
+	}
+	b.buf, b.hint = b.buf[:0], nil
+	bufferPools[sizeClass(cap(b.buf)+1)-1].Put(b)
+}
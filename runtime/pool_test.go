@@ -0,0 +1,75 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSizeClass(t *testing.T) {
+	tests := []struct {
+		n    int
+		want int
+	}{
+		{0, 0}, {256, 0}, {257, 1}, {512, 1}, {1000, 2}, {65536, 8}, {65537, sizeClasses},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.want, sizeClass(tt.n), tt.n)
+	}
+}
+
+func TestSizeHint(t *testing.T) {
+	var nilHint *SizeHint
+	nilHint.Record(10)
+	require.Zero(t, nilHint.Size())
+
+	var h SizeHint
+	h.Record(800)
+	require.Equal(t, 800, h.Size())
+	h.Record(100)
+	require.Equal(t, 700, h.Size())
+	h.Record(1000)
+	require.Equal(t, 1000, h.Size())
+}
+
+func TestPooledBuffer(t *testing.T) {
+	b := NewPooledBuffer()
+	require.Zero(t, b.Len())
+	require.GreaterOrEqual(t, cap(b.AvailableBuffer()), minPooledSize)
+	n, err := b.Write(append(b.AvailableBuffer(), "hello"...))
+	require.NoError(t, err)
+	require.Equal(t, 5, n)
+	data := b.Detach()
+	require.Equal(t, "hello", string(data))
+	require.Equal(t, 5, cap(data))
+
+	// 归还后的 buffer 再次借出时为空, 之前返回的结果不受影响
+	b = NewPooledBuffer()
+	require.Zero(t, b.Len())
+	b.Write(append(b.AvailableBuffer(), "world"...))
+	require.Equal(t, "world", string(b.Detach()))
+	require.Equal(t, "hello", string(data))
+
+	b = NewPooledBuffer()
+	b.Write(make([]byte, maxPooledSize+1))
+	b.Release()
+}
+
+func TestPooledBuffer_Hint(t *testing.T) {
+	var h SizeHint
+	b := NewPooledBufferHint(&h)
+	b.Write(make([]byte, 3000))
+	require.Len(t, b.Detach(), 3000)
+	require.Equal(t, 3000, h.Size())
+
+	// 按记录的大小借出容量足够的 buffer
+	b = NewPooledBufferHint(&h)
+	require.GreaterOrEqual(t, cap(b.AvailableBuffer()), 3000)
+	b.Release()
+
+	// 超过最大级别时按预计大小分配, 不放回 pool
+	h.Record(maxPooledSize * 2)
+	b = NewPooledBufferHint(&h)
+	require.GreaterOrEqual(t, cap(b.AvailableBuffer()), maxPooledSize*2)
+	b.Release()
+}
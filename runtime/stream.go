@@ -0,0 +1,72 @@
+package runtime
+
+import "io"
+
+// DefaultStreamSize Stream 默认的缓冲大小
+const DefaultStreamSize = 32 << 10
+
+// Stream 是生成的 WriteJSONTo 使用的缓冲写入器, 生成的 EncodeJSON 在字段与元素之间调用 Flush,
+// 已编码的部分超过缓冲大小时写入 io.Writer, 峰值内存与 message 的大小无关.
+// 第一个写入错误会被记录, 之后的数据都被丢弃
+type Stream struct {
+	w    io.Writer
+	buf  []byte
+	size int
+	n    int64
+	err  error
+}
+
+// NewStream 创建默认缓冲大小的 Stream
+func NewStream(w io.Writer) *Stream {
+	return NewStreamSize(w, DefaultStreamSize)
+}
+
+// NewStreamSize 创建缓冲大小为 size 的 Stream, size 不大于 0 时使用默认大小
+func NewStreamSize(w io.Writer, size int) *Stream {
+	if size <= 0 {
+		size = DefaultStreamSize
+	}
+	return &Stream{w: w, size: size}
+}
+
+// Buffer 返回用于追加编码结果的空 slice
+func (s *Stream) Buffer() []byte {
+	if s.buf == nil {
+		s.buf = make([]byte, 0, s.size+s.size/4)
+	}
+	return s.buf[:0]
+}
+
+// Flush dst 达到缓冲大小时写入 io.Writer 并返回清空的 dst, 否则原样返回.
+// s 为 nil 时什么也不做, AppendJSON 通过 nil Stream 共用 EncodeJSON
+func (s *Stream) Flush(dst []byte) []byte {
+	if s == nil || len(dst) < s.size {
+		return dst
+	}
+	s.write(dst)
+	return dst[:0]
+}
+
+// Done 写入剩余的 dst, 返回写入的总字节数与编码错误或第一个写入错误.
+// err 不为 nil 时剩余的部分不再写入
+func (s *Stream) Done(dst []byte, err error) (int64, error) {
+	if err != nil {
+		return s.n, err
+	}
+	if len(dst) > 0 {
+		s.write(dst)
+	}
+	return s.n, s.err
+}
+
+func (s *Stream) write(p []byte) {
+	if s.err != nil {
+		return
+	}
+	n, err := s.w.Write(p)
+	s.n += int64(n)
+	if err == nil && n < len(p) {
+		err = io.ErrShortWrite
+	}
+	s.err = err
+}
@@ -0,0 +1,59 @@
+package runtime
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type errWriter struct {
+	n   int
+	err error
+}
+
+func (w *errWriter) Write([]byte) (int, error) {
+	return w.n, w.err
+}
+
+func TestStream(t *testing.T) {
+	var nilStream *Stream
+	require.Equal(t, "abc", string(nilStream.Flush([]byte("abc"))))
+
+	var out bytes.Buffer
+	s := NewStreamSize(&out, 4)
+	dst := append(s.Buffer(), "abc"...)
+	dst = s.Flush(dst)
+	require.Equal(t, "abc", string(dst))
+	require.Zero(t, out.Len())
+	dst = s.Flush(append(dst, 'd'))
+	require.Empty(t, dst)
+	require.Equal(t, "abcd", out.String())
+	n, err := s.Done(append(dst, "ef"...), nil)
+	require.NoError(t, err)
+	require.EqualValues(t, 6, n)
+	require.Equal(t, "abcdef", out.String())
+}
+
+func TestStream_Error(t *testing.T) {
+	encodeErr := errors.New("encode")
+	s := NewStreamSize(io.Discard, 1)
+	n, err := s.Done(s.Flush([]byte("ab")), encodeErr)
+	require.Equal(t, encodeErr, err)
+	require.EqualValues(t, 2, n)
+
+	writeErr := errors.New("write")
+	s = NewStreamSize(&errWriter{n: 1, err: writeErr}, 1)
+	dst := s.Flush([]byte("ab"))
+	dst = s.Flush(append(dst, "cd"...))
+	n, err = s.Done(dst, nil)
+	require.Equal(t, writeErr, err)
+	require.EqualValues(t, 1, n)
+
+	s = NewStreamSize(&errWriter{n: 1}, 0)
+	n, err = s.Done([]byte("ab"), nil)
+	require.Equal(t, io.ErrShortWrite, err)
+	require.EqualValues(t, 1, n)
+}
@@ -0,0 +1,184 @@
+package runtime
+
+import (
+	"errors"
+	"math"
+	"strconv"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Flags 影响 Struct, Value, ListValue 编码的生成选项, 与插件配置同名
+type Flags uint8
+
+const (
+	// EscapeHTML 字符串使用 AppendStringHTML 转义
+	EscapeHTML Flags = 1 << iota
+	// Deterministic Struct 的 key 排序后写出
+	Deterministic
+)
+
+// AppendStruct 把 google.protobuf.Struct 写成 json 对象, nil 写成 {}
+func AppendStruct(dst []byte, s *structpb.Struct, flags Flags) ([]byte, error) {
+	fields := s.GetFields()
+	dst = append(dst, '{')
+	var err error
+	if flags&Deterministic != 0 {
+		var buf [16]string
+		for i, key := range SortedKeys(fields, buf[:0]) {
+			if dst, err = appendStructField(dst, i, key, fields[key], flags); err != nil {
+				return dst, err
+			}
+		}
+	} else {
+		i := 0
+		for key, val := range fields {
+			if dst, err = appendStructField(dst, i, key, val, flags); err != nil {
+				return dst, err
+			}
+			i++
+		}
+	}
+	return append(dst, '}'), nil
+}
+
+func appendStructField(dst []byte, i int, key string, val *structpb.Value, flags Flags) ([]byte, error) {
+	if i > 0 {
+		dst = append(dst, ',')
+	}
+	dst, err := appendString(dst, key, flags&EscapeHTML != 0)
+	if err != nil {
+		return dst, err
+	}
+	dst = append(dst, ':')
+	return AppendValue(dst, val, flags)
+}
+
+// AppendListValue 把 google.protobuf.ListValue 写成 json 数组, nil 写成 []
+func AppendListValue(dst []byte, l *structpb.ListValue, flags Flags) ([]byte, error) {
+	dst = append(dst, '[')
+	var err error
+	for i, val := range l.GetValues() {
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		if dst, err = AppendValue(dst, val, flags); err != nil {
+			return dst, err
+		}
+	}
+	return append(dst, ']'), nil
+}
+
+// AppendValue 按 kind 把 google.protobuf.Value 写成任意 json 值,
+// 与 protojson 一致, 没有设置 kind 以及 NaN, Infinity 是错误
+func AppendValue(dst []byte, v *structpb.Value, flags Flags) ([]byte, error) {
+	switch kind := v.GetKind().(type) {
+	case *structpb.Value_NullValue:
+		return append(dst, "null"...), nil
+	case *structpb.Value_NumberValue:
+		if math.IsNaN(kind.NumberValue) || math.IsInf(kind.NumberValue, 0) {
+			return dst, errors.New("google.protobuf.Value: invalid number value " +
+				strconv.FormatFloat(kind.NumberValue, 'g', -1, 64))
+		}
+		return AppendFloat(dst, kind.NumberValue, 64), nil
+	case *structpb.Value_StringValue:
+		return appendString(dst, kind.StringValue, flags&EscapeHTML != 0)
+	case *structpb.Value_BoolValue:
+		return strconv.AppendBool(dst, kind.BoolValue), nil
+	case *structpb.Value_StructValue:
+		return AppendStruct(dst, kind.StructValue, flags)
+	case *structpb.Value_ListValue:
+		return AppendListValue(dst, kind.ListValue, flags)
+	default:
+		return dst, errors.New("google.protobuf.Value: none of the oneof fields is set")
+	}
+}
+
+// ReadStruct 读取任意 json 对象为 google.protobuf.Struct
+func (d *Decoder) ReadStruct() (*structpb.Struct, error) {
+	if err := d.ObjectStart(); err != nil {
+		return nil, err
+	}
+	s := &structpb.Struct{Fields: make(map[string]*structpb.Value)}
+	for {
+		key, ok, err := d.ObjectNext()
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return s, nil
+		}
+		name := string(key)
+		if _, dup := s.Fields[name]; dup {
+			if err = d.DuplicateKey(key); err != nil {
+				return nil, err
+			}
+		}
+		if s.Fields[name], err = d.ReadValue(); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// ReadListValue 读取任意 json 数组为 google.protobuf.ListValue
+func (d *Decoder) ReadListValue() (*structpb.ListValue, error) {
+	if err := d.ArrayStart(); err != nil {
+		return nil, err
+	}
+	l := &structpb.ListValue{}
+	for {
+		ok, err := d.ArrayNext()
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return l, nil
+		}
+		v, err := d.ReadValue()
+		if err != nil {
+			return nil, err
+		}
+		l.Values = append(l.Values, v)
+	}
+}
+
+// ReadValue 读取任意 json 值为 google.protobuf.Value, null 读取为 NullValue
+func (d *Decoder) ReadValue() (*structpb.Value, error) {
+	switch d.peek() {
+	case 'n':
+		if d.ReadNull() {
+			return structpb.NewNullValue(), nil
+		}
+	case 't', 'f':
+		b, err := d.ReadBool()
+		if err != nil {
+			return nil, err
+		}
+		return structpb.NewBoolValue(b), nil
+	case '"':
+		s, err := d.ReadString()
+		if err != nil {
+			return nil, err
+		}
+		return structpb.NewStringValue(s), nil
+	case '{':
+		s, err := d.ReadStruct()
+		if err != nil {
+			return nil, err
+		}
+		return structpb.NewStructValue(s), nil
+	case '[':
+		l, err := d.ReadListValue()
+		if err != nil {
+			return nil, err
+		}
+		return structpb.NewListValue(l), nil
+	case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		f, err := d.ReadFloat64()
+		if err != nil {
+			return nil, err
+		}
+		return structpb.NewNumberValue(f), nil
+	}
+	return nil, d.unexpected("value")
+}
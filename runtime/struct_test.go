@@ -0,0 +1,77 @@
+package runtime
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestAppendStruct(t *testing.T) {
+	s, err := structpb.NewStruct(map[string]interface{}{"b": "<&>", "a": []interface{}{1.0, nil}, "c": map[string]interface{}{}})
+	require.NoError(t, err)
+
+	got, err := AppendStruct(nil, s, Deterministic)
+	require.NoError(t, err)
+	require.Equal(t, `{"a":[1,null],"b":"<&>","c":{}}`, string(got))
+
+	got, err = AppendStruct(nil, s, Deterministic|EscapeHTML)
+	require.NoError(t, err)
+	require.Equal(t, `{"a":[1,null],"b":"\u003c\u0026\u003e","c":{}}`, string(got))
+
+	got, err = AppendStruct(nil, s, 0)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"a":[1,null],"b":"<&>","c":{}}`, string(got))
+
+	got, err = AppendStruct(nil, nil, 0)
+	require.NoError(t, err)
+	require.Equal(t, `{}`, string(got))
+}
+
+func TestAppendValue(t *testing.T) {
+	_, err := AppendValue(nil, nil, 0)
+	require.Error(t, err)
+	_, err = AppendValue(nil, structpb.NewNumberValue(math.Inf(-1)), 0)
+	require.Error(t, err)
+	_, err = AppendListValue(nil, &structpb.ListValue{Values: []*structpb.Value{{}}}, 0)
+	require.Error(t, err)
+	_, err = AppendValue(nil, structpb.NewStringValue("\xff"), 0)
+	require.ErrorIs(t, err, ErrInvalidUTF8)
+}
+
+func TestDecoder_ReadValue(t *testing.T) {
+	d := NewDecoder([]byte(` {"a" : [ 1 , "x" , null , true , { } ] , "b" : -0.5 } `))
+	v, err := d.ReadValue()
+	require.NoError(t, err)
+	require.NoError(t, d.End())
+	require.Equal(t, map[string]interface{}{"a": []interface{}{1.0, "x", nil, true, map[string]interface{}{}}, "b": -0.5}, v.AsInterface())
+
+	for _, data := range []string{`[1,]`, `{"a"}`, `nul`, `-`, `"\x"`, `}`} {
+		_, err := NewDecoder([]byte(data)).ReadValue()
+		require.Error(t, err, data)
+	}
+
+	// 重复的 key 与 protojson 一致是错误, AllowDuplicates 时后出现的值生效
+	_, err = NewDecoder([]byte(`{"a":1,"b":{},"a":2}`)).ReadStruct()
+	require.EqualError(t, err, `json: duplicate map key "a" at line 1 column 19`)
+	s, err := UnmarshalOptions{AllowDuplicates: true}.NewDecoder([]byte(`{"a":1,"b":{},"a":2}`)).ReadStruct()
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"a": 2.0, "b": map[string]interface{}{}}, s.AsMap())
+
+	// 每层只需一个字节的输入, 超过 maxDepth 层时返回错误而不是耗尽栈空间
+	deep := strings.Repeat("[", maxDepth) + strings.Repeat("]", maxDepth)
+	_, err = NewDecoder([]byte(deep)).ReadValue()
+	require.NoError(t, err)
+	for _, data := range []string{strings.Repeat("[", 20e6), `{"a":` + strings.Repeat(`{"a":`, 20e6), "[" + deep + "]"} {
+		_, err = NewDecoder([]byte(data)).ReadValue()
+		var e *Error
+		require.ErrorAs(t, err, &e)
+		require.Equal(t, "exceeded max nesting depth 10000", e.Msg)
+	}
+	_, err = NewDecoder([]byte(`{"a":` + deep + "}")).ReadStruct()
+	require.ErrorContains(t, err, "exceeded max nesting depth")
+	_, err = NewDecoder([]byte("[" + deep + "]")).ReadListValue()
+	require.ErrorContains(t, err, "exceeded max nesting depth")
+}
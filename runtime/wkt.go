@@ -0,0 +1,275 @@
+package runtime
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// well-known type 的取值范围, 与 protojson 一致
+const (
+	// 0001-01-01T00:00:00Z
+	minTimestampSeconds = -62135596800
+	// 9999-12-31T23:59:59Z
+	maxTimestampSeconds = 253402300799
+	// 约 10000 年
+	maxDurationSeconds = 315576000000
+)
+
+// AppendTimestamp 以 RFC 3339 格式追加 google.protobuf.Timestamp, 统一使用 UTC 时区 Z,
+// 小数部分按精度写 0, 3, 6 或 9 位, nil 按零值处理
+func AppendTimestamp(dst []byte, t *timestamppb.Timestamp) ([]byte, error) {
+	secs, nanos := t.GetSeconds(), t.GetNanos()
+	if secs < minTimestampSeconds || secs > maxTimestampSeconds {
+		return dst, fmt.Errorf("google.protobuf.Timestamp: seconds out of range %v", secs)
+	}
+	if nanos < 0 || nanos > 999999999 {
+		return dst, fmt.Errorf("google.protobuf.Timestamp: nanos out of range %v", nanos)
+	}
+	dst = append(dst, '"')
+	dst = time.Unix(secs, int64(nanos)).UTC().AppendFormat(dst, "2006-01-02T15:04:05")
+	dst = appendNanos(dst, nanos)
+	return append(dst, 'Z', '"'), nil
+}
+
+// AppendDuration 追加 google.protobuf.Duration, 格式为秒数加后缀 s, 例如 "1.5s", "-0.000001s"
+func AppendDuration(dst []byte, d *durationpb.Duration) ([]byte, error) {
+	secs, nanos := d.GetSeconds(), d.GetNanos()
+	if secs < -maxDurationSeconds || secs > maxDurationSeconds {
+		return dst, fmt.Errorf("google.protobuf.Duration: seconds out of range %v", secs)
+	}
+	if nanos <= -1e9 || nanos >= 1e9 {
+		return dst, fmt.Errorf("google.protobuf.Duration: nanos out of range %v", nanos)
+	}
+	if secs > 0 && nanos < 0 || secs < 0 && nanos > 0 {
+		return dst, fmt.Errorf("google.protobuf.Duration: signs of seconds and nanos do not match")
+	}
+	dst = append(dst, '"')
+	if secs < 0 || nanos < 0 {
+		dst = append(dst, '-')
+		secs, nanos = -secs, -nanos
+	}
+	dst = strconv.AppendInt(dst, secs, 10)
+	dst = appendNanos(dst, nanos)
+	return append(dst, 's', '"'), nil
+}
+
+// appendNanos 追加小数部分, 去掉末尾成组的 0, 结果为 0, 3, 6 或 9 位
+func appendNanos(dst []byte, nanos int32) []byte {
+	if nanos == 0 {
+		return dst
+	}
+	var frac [10]byte
+	frac[0] = '.'
+	for i, n := 9, nanos; i > 0; i-- {
+		frac[i] = byte('0' + n%10)
+		n /= 10
+	}
+	n := len(frac)
+	for n > 4 && string(frac[n-3:n]) == "000" {
+		n -= 3
+	}
+	return append(dst, frac[:n]...)
+}
+
+// ReadTimestamp 读取 RFC 3339 格式的 google.protobuf.Timestamp, 时区偏移会转换为 UTC
+func (d *Decoder) ReadTimestamp() (*timestamppb.Timestamp, error) {
+	start := d.pos
+	b, err := d.readString()
+	if err != nil {
+		return nil, err
+	}
+	t, err := time.Parse(time.RFC3339Nano, string(b))
+	if err != nil || !validFraction(b) {
+		d.pos = start
+		return nil, d.errorf("invalid google.protobuf.Timestamp value %q", b)
+	}
+	secs := t.Unix()
+	if secs < minTimestampSeconds || secs > maxTimestampSeconds {
+		d.pos = start
+		return nil, d.errorf("google.protobuf.Timestamp value out of range %q", b)
+	}
+	return &timestamppb.Timestamp{Seconds: secs, Nanos: int32(t.Nanosecond())}, nil
+}
+
+// validFraction 小数部分最多 9 位
+func validFraction(b []byte) bool {
+	dot, zone := -1, -1
+	for i, c := range b {
+		switch c {
+		case '.':
+			dot = i
+		case 'Z', 'z', '+', '-':
+			zone = i
+		}
+	}
+	return dot < 0 || zone < dot || zone-dot <= len(".999999999")
+}
+
+// ReadDuration 读取 google.protobuf.Duration, 例如 "1.5s", "-0.000001s", 小数部分最多 9 位
+func (d *Decoder) ReadDuration() (*durationpb.Duration, error) {
+	start := d.pos
+	b, err := d.readString()
+	if err != nil {
+		return nil, err
+	}
+	secs, nanos, ok := parseDuration(b)
+	if !ok {
+		d.pos = start
+		return nil, d.errorf("invalid google.protobuf.Duration value %q", b)
+	}
+	if secs < -maxDurationSeconds || secs > maxDurationSeconds {
+		d.pos = start
+		return nil, d.errorf("google.protobuf.Duration value out of range %q", b)
+	}
+	return &durationpb.Duration{Seconds: secs, Nanos: nanos}, nil
+}
+
+// parseDuration 解析 [+-][秒][.小数]s, 与 protojson 一致, 秒数与小数部分可以省略其一, 秒数不能有多余的前导 0
+func parseDuration(b []byte) (int64, int32, bool) {
+	if len(b) < 2 || b[len(b)-1] != 's' {
+		return 0, 0, false
+	}
+	b = b[:len(b)-1]
+	neg := b[0] == '-'
+	if neg || b[0] == '+' {
+		b = b[1:]
+	}
+	i := skipDigits(b, 0)
+	if i == 0 && (len(b) == 0 || b[0] != '.') || i > 1 && b[0] == '0' {
+		return 0, 0, false
+	}
+	var secs uint64
+	if i > 0 {
+		var ok bool
+		if secs, ok = parseUint(b[:i]); !ok || secs > math.MaxInt64 {
+			return 0, 0, false
+		}
+	}
+	var nanos int32
+	if i < len(b) {
+		if b[i] != '.' {
+			return 0, 0, false
+		}
+		frac := b[i+1:]
+		if len(frac) > 9 || skipDigits(frac, 0) != len(frac) {
+			return 0, 0, false
+		}
+		for j := 0; j < 9; j++ {
+			nanos *= 10
+			if j < len(frac) {
+				nanos += int32(frac[j] - '0')
+			}
+		}
+	}
+	if neg {
+		return -int64(secs), -nanos, true
+	}
+	return int64(secs), nanos, true
+}
+
+// AppendFieldMask 把 google.protobuf.FieldMask 写成逗号分隔的 lowerCamelCase 路径字符串, 例如 "user.displayName,photo",
+// 与 protojson 一致, 转换后无法还原的路径是错误
+func AppendFieldMask(dst []byte, m *fieldmaskpb.FieldMask) ([]byte, error) {
+	var paths []byte
+	for i, path := range m.GetPaths() {
+		if i > 0 {
+			paths = append(paths, ',')
+		}
+		start := len(paths)
+		paths = appendCamelCase(paths, path)
+		if !snakeCaseEqual(paths[start:], path) {
+			return dst, fmt.Errorf("google.protobuf.FieldMask.paths contains irreversible value %q", path)
+		}
+	}
+	return appendString(dst, string(paths), false)
+}
+
+// appendCamelCase 去掉下划线, 下划线后的小写字母转为大写
+func appendCamelCase(dst []byte, s string) []byte {
+	underscore := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '_' {
+			if underscore && c >= 'a' && c <= 'z' {
+				c -= 'a' - 'A'
+			}
+			dst = append(dst, c)
+		}
+		underscore = c == '_'
+	}
+	return dst
+}
+
+// appendSnakeCase 大写字母转为下划线加小写字母, appendCamelCase 的逆操作
+func appendSnakeCase(dst []byte, s []byte) []byte {
+	for _, c := range s {
+		if c >= 'A' && c <= 'Z' {
+			dst = append(dst, '_')
+			c += 'a' - 'A'
+		}
+		dst = append(dst, c)
+	}
+	return dst
+}
+
+// snakeCaseEqual camel 转回 snake case 后是否等于 s
+func snakeCaseEqual(camel []byte, s string) bool {
+	var scratch [64]byte
+	return string(appendSnakeCase(scratch[:0], camel)) == s
+}
+
+// ReadFieldMask 读取逗号分隔的 lowerCamelCase 路径字符串为 google.protobuf.FieldMask, 路径转换为 snake case
+func (d *Decoder) ReadFieldMask() (*fieldmaskpb.FieldMask, error) {
+	start := d.pos
+	b, err := d.readString()
+	if err != nil {
+		return nil, err
+	}
+	m := &fieldmaskpb.FieldMask{}
+	s := bytes.TrimSpace(b)
+	if len(s) == 0 {
+		return m, nil
+	}
+	for _, camel := range bytes.Split(s, []byte{','}) {
+		path := string(appendSnakeCase(nil, camel))
+		if bytes.IndexByte(camel, '_') >= 0 || !protoreflect.FullName(path).IsValid() {
+			d.pos = start
+			return nil, d.errorf("google.protobuf.FieldMask.paths contains invalid path %q", b)
+		}
+		m.Paths = append(m.Paths, path)
+	}
+	return m, nil
+}
+
+// AppendEmpty 把 google.protobuf.Empty 写成 {}
+func AppendEmpty(dst []byte, _ *emptypb.Empty) ([]byte, error) {
+	return append(dst, '{', '}'), nil
+}
+
+// ReadEmpty 读取 google.protobuf.Empty, 对象中的字段都是未定义的 key, 按 DiscardUnknown 跳过或返回错误
+func (d *Decoder) ReadEmpty() (*emptypb.Empty, error) {
+	if err := d.ObjectStart(); err != nil {
+		return nil, err
+	}
+	for {
+		key, ok, err := d.ObjectNext()
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return &emptypb.Empty{}, nil
+		}
+		if err = d.Unknown("google.protobuf.Empty", key); err != nil {
+			return nil, err
+		}
+	}
+}
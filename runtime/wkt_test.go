@@ -0,0 +1,228 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestAppendTimestamp(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    *timestamppb.Timestamp
+		want    string
+		wantErr bool
+	}{
+		{name: "nil", args: nil, want: `"1970-01-01T00:00:00Z"`},
+		{name: "epoch", args: &timestamppb.Timestamp{}, want: `"1970-01-01T00:00:00Z"`},
+		{name: "millis", args: &timestamppb.Timestamp{Seconds: 1700000000, Nanos: 120000000}, want: `"2023-11-14T22:13:20.120Z"`},
+		{name: "micros", args: &timestamppb.Timestamp{Seconds: 1700000000, Nanos: 123456000}, want: `"2023-11-14T22:13:20.123456Z"`},
+		{name: "nanos", args: &timestamppb.Timestamp{Seconds: -1, Nanos: 1}, want: `"1969-12-31T23:59:59.000000001Z"`},
+		{name: "min", args: &timestamppb.Timestamp{Seconds: minTimestampSeconds}, want: `"0001-01-01T00:00:00Z"`},
+		{name: "max", args: &timestamppb.Timestamp{Seconds: maxTimestampSeconds, Nanos: 999999999}, want: `"9999-12-31T23:59:59.999999999Z"`},
+		{name: "seconds too small", args: &timestamppb.Timestamp{Seconds: minTimestampSeconds - 1}, wantErr: true},
+		{name: "seconds too large", args: &timestamppb.Timestamp{Seconds: maxTimestampSeconds + 1}, wantErr: true},
+		{name: "negative nanos", args: &timestamppb.Timestamp{Nanos: -1}, wantErr: true},
+		{name: "nanos too large", args: &timestamppb.Timestamp{Nanos: 1e9}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AppendTimestamp(nil, tt.args)
+			_, expectErr := protojson.Marshal(tt.args)
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Error(t, expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, string(got))
+			if tt.args != nil {
+				expect, err := protojson.Marshal(tt.args)
+				require.NoError(t, err)
+				require.Equal(t, string(expect), string(got))
+			}
+		})
+	}
+}
+
+func TestAppendDuration(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    *durationpb.Duration
+		want    string
+		wantErr bool
+	}{
+		{name: "zero", args: &durationpb.Duration{}, want: `"0s"`},
+		{name: "seconds", args: &durationpb.Duration{Seconds: 3}, want: `"3s"`},
+		{name: "millis", args: &durationpb.Duration{Seconds: 1, Nanos: 500000000}, want: `"1.500s"`},
+		{name: "negative micros", args: &durationpb.Duration{Nanos: -1000}, want: `"-0.000001s"`},
+		{name: "negative", args: &durationpb.Duration{Seconds: -1, Nanos: -1}, want: `"-1.000000001s"`},
+		{name: "max", args: &durationpb.Duration{Seconds: maxDurationSeconds, Nanos: 999999999}, want: `"315576000000.999999999s"`},
+		{name: "seconds out of range", args: &durationpb.Duration{Seconds: maxDurationSeconds + 1}, wantErr: true},
+		{name: "nanos out of range", args: &durationpb.Duration{Nanos: -1e9}, wantErr: true},
+		{name: "sign mismatch", args: &durationpb.Duration{Seconds: 1, Nanos: -1}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AppendDuration(nil, tt.args)
+			expect, expectErr := protojson.Marshal(tt.args)
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Error(t, expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, string(got))
+			require.Equal(t, string(expect), string(got))
+		})
+	}
+}
+
+func TestDecoder_ReadTimestamp(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    *timestamppb.Timestamp
+		wantErr bool
+	}{
+		{name: "utc", data: `"2023-11-14T22:13:20Z"`, want: &timestamppb.Timestamp{Seconds: 1700000000}},
+		{name: "nanos", data: `"2023-11-14T22:13:20.000000001Z"`, want: &timestamppb.Timestamp{Seconds: 1700000000, Nanos: 1}},
+		{name: "offset", data: `"2023-11-15T06:13:20.5+08:00"`, want: &timestamppb.Timestamp{Seconds: 1700000000, Nanos: 500000000}},
+		{name: "min", data: `"0001-01-01T00:00:00Z"`, want: &timestamppb.Timestamp{Seconds: minTimestampSeconds}},
+		{name: "too many digits", data: `"2023-11-14T22:13:20.0000000001Z"`, wantErr: true},
+		{name: "no zone", data: `"2023-11-14T22:13:20"`, wantErr: true},
+		{name: "out of range", data: `"0001-01-01T00:00:00+01:00"`, wantErr: true},
+		{name: "number", data: `1700000000`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewDecoder([]byte(tt.data)).ReadTimestamp()
+			expect := new(timestamppb.Timestamp)
+			expectErr := protojson.Unmarshal([]byte(tt.data), expect)
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Error(t, expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, proto.Equal(tt.want, got), "got %v", got)
+			require.True(t, proto.Equal(expect, got), "protojson %v", expect)
+		})
+	}
+}
+
+func TestDecoder_ReadDuration(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    *durationpb.Duration
+		wantErr bool
+	}{
+		{name: "zero", data: `"0s"`, want: &durationpb.Duration{}},
+		{name: "fraction", data: `"1.5s"`, want: &durationpb.Duration{Seconds: 1, Nanos: 500000000}},
+		{name: "negative", data: `"-0.000000001s"`, want: &durationpb.Duration{Nanos: -1}},
+		{name: "max", data: `"315576000000.999999999s"`, want: &durationpb.Duration{Seconds: maxDurationSeconds, Nanos: 999999999}},
+		{name: "out of range", data: `"315576000001s"`, wantErr: true},
+		{name: "no suffix", data: `"1"`, wantErr: true},
+		{name: "too many digits", data: `"1.0000000001s"`, wantErr: true},
+		{name: "leading zero", data: `"01s"`, wantErr: true},
+		{name: "empty fraction", data: `"1.s"`, want: &durationpb.Duration{Seconds: 1}},
+		{name: "no seconds", data: `"-.5s"`, want: &durationpb.Duration{Nanos: -500000000}},
+		{name: "plus", data: `"+2s"`, want: &durationpb.Duration{Seconds: 2}},
+		{name: "sign only", data: `"-s"`, wantErr: true},
+		{name: "exponent", data: `"1e3s"`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewDecoder([]byte(tt.data)).ReadDuration()
+			expect := new(durationpb.Duration)
+			expectErr := protojson.Unmarshal([]byte(tt.data), expect)
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Error(t, expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, proto.Equal(tt.want, got), "got %v", got)
+			require.True(t, proto.Equal(expect, got), "protojson %v", expect)
+		})
+	}
+}
+
+func TestAppendFieldMask(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    string
+		wantErr bool
+	}{
+		{name: "empty", args: nil, want: `""`},
+		{name: "paths", args: []string{"foo_bar", "user.display_name", "x"}, want: `"fooBar,user.displayName,x"`},
+		{name: "upper", args: []string{"fooBar"}, wantErr: true},
+		{name: "double underscore", args: []string{"foo__bar"}, wantErr: true},
+		{name: "underscore digit", args: []string{"foo_1"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &fieldmaskpb.FieldMask{Paths: tt.args}
+			got, err := AppendFieldMask(nil, m)
+			expect, expectErr := protojson.Marshal(m)
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Error(t, expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, string(got))
+			require.Equal(t, string(expect), string(got))
+		})
+	}
+}
+
+func TestDecoder_ReadFieldMask(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    []string
+		wantErr bool
+	}{
+		{name: "empty", data: `""`},
+		{name: "blank", data: `"  "`},
+		{name: "paths", data: `"fooBar,user.displayName,x"`, want: []string{"foo_bar", "user.display_name", "x"}},
+		{name: "underscore", data: `"foo_bar"`, wantErr: true},
+		{name: "empty path", data: `"a,,b"`, wantErr: true},
+		{name: "invalid", data: `"a-b"`, wantErr: true},
+		{name: "not string", data: `["a"]`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewDecoder([]byte(tt.data)).ReadFieldMask()
+			expect := new(fieldmaskpb.FieldMask)
+			expectErr := protojson.Unmarshal([]byte(tt.data), expect)
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Error(t, expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got.Paths)
+			require.True(t, proto.Equal(expect, got), "protojson %v", expect)
+		})
+	}
+}
+
+func TestDecoder_ReadEmpty(t *testing.T) {
+	_, err := NewDecoder([]byte(`{ }`)).ReadEmpty()
+	require.NoError(t, err)
+	for _, data := range []string{`[]`, `null`, `"{}"`, `{`, `{"a":1}`} {
+		_, err = NewDecoder([]byte(data)).ReadEmpty()
+		require.Error(t, err, data)
+	}
+	_, err = UnmarshalOptions{DiscardUnknown: true}.NewDecoder([]byte(`{"a":{"b":[]}}`)).ReadEmpty()
+	require.NoError(t, err)
+}
@@ -8,6 +8,18 @@ pluginConfigName="--go-json_opt"
 
 protoc -I proto proto/* --go_out=. \
  --plugin=$pluginName=../protoc-gen-go-json $pluginOutName=. \
-$pluginConfigName=config=FileNameSuffix=.json.go,config=EncodeMethodName=MarshalJSON
+$pluginConfigName=config=FileNameSuffix=.json.go,config=EncodeMethodName=MarshalJSON,config=Deterministic=true
 
 
+# pbopt: 同一份 proto 使用非默认选项生成, 用于测试各个选项.
+# 复制为 proto 包 pbopt 下的 pbopt/*.proto, 与 pb 注册到 protoregistry 的文件名与类型名不冲突, 两个包可以同时链接
+optDir=$(mktemp -d)
+trap 'rm -rf "$optDir"' EXIT
+mkdir "$optDir/pbopt"
+for f in proto/*; do
+  sed -e 's/^package pb;/package pbopt;/' -e 's|^option go_package = "./pb";|option go_package = "./pbopt";|' \
+    "$f" > "$optDir/pbopt/${f#proto/}"
+done
+protoc -I "$optDir" "$optDir"/pbopt/* --go_out=. \
+ --plugin=$pluginName=../protoc-gen-go-json $pluginOutName=. \
+$pluginConfigName="config=FileNameSuffix=.json.go,config=EncodeMethodName=MarshalJSON,config=Deterministic=true,config=EmitUnpopulated=true,config=UseProtoNames=true,config=UseEnumNumbers=true,config=Writer=pool,config=DiscardUnknown=true,config=AllowDuplicates=true"
@@ -0,0 +1,153 @@
+package pb_test
+
+import (
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"protoc-gen-go-json/testdata/pb"
+	"strconv"
+	"testing"
+)
+
+// benchArray 构造 depth 层嵌套的 Array, 每层包含 message, list 与下一层
+func benchArray(depth int) *pb.Array {
+	x := &pb.Array{
+		Numbers:  []*pb.Number{{U32: 1, I64: -2, F64: 3.5}, {S32: -4, Uf64: 5}},
+		Strings:  []*pb.String{{Str: "hello", Bytes: []byte("world")}},
+		Bools:    []*pb.Bool{{B: true}},
+		Messages: []*pb.Message{{Type: pb.Type_BOOL, Number: &pb.Number{U64: 6}, String_: &pb.String{Str: "msg"}}},
+		Types:    []pb.Type{pb.Type_BOOL, pb.Type_STRING},
+		U32S:     []uint32{1, 2, 3},
+		Strs:     []string{"a", "b", "c"},
+	}
+	if depth > 1 {
+		x.Arrays = []*pb.Array{benchArray(depth - 1), benchArray(depth - 1)}
+	}
+	return x
+}
+
+func benchMap() *pb.Map {
+	x := &pb.Map{
+		Numbers:  map[uint32]*pb.Number{},
+		Strings:  map[string]*pb.String{},
+		Messages: map[string]*pb.Message{},
+		Arrays:   map[string]*pb.Array{"nested": benchArray(3)},
+		U32S:     map[string]uint32{},
+	}
+	for i := 0; i < 16; i++ {
+		key := strconv.Itoa(i)
+		x.Numbers[uint32(i)] = &pb.Number{U32: uint32(i), I64: int64(-i)}
+		x.Strings[key] = &pb.String{Str: key}
+		x.Messages[key] = &pb.Message{Type: pb.Type_BOOL, Bool: &pb.Bool{B: true}}
+		x.U32S[key] = uint32(i)
+	}
+	return x
+}
+
+func benchmarkMarshal(b *testing.B, m interface {
+	proto.Message
+	MarshalJSON() ([]byte, error)
+	AppendJSON(dst []byte) ([]byte, error)
+	WriteJSONTo(w io.Writer) (int64, error)
+	JSONSizeHint() int
+}) {
+	b.Run("MarshalJSON", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := m.MarshalJSON(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("AppendJSON", func(b *testing.B) {
+		// 复用同一个 slice, 稳定后不再分配
+		var buf []byte
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var err error
+			if buf, err = m.AppendJSON(buf[:0]); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	// 从空 slice 开始反复增长, 与按 JSONSizeHint 一次分配对比
+	b.Run("AppendJSONNil", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := m.AppendJSON(nil); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("AppendJSONSized", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := m.AppendJSON(make([]byte, 0, m.JSONSizeHint())); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("JSONSizeHint", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = m.JSONSizeHint()
+		}
+	})
+	b.Run("WriteJSONTo", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := m.WriteJSONTo(io.Discard); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("protojson", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := protojson.Marshal(m); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkArray(b *testing.B) {
+	benchmarkMarshal(b, benchArray(4))
+}
+
+func BenchmarkMap(b *testing.B) {
+	benchmarkMarshal(b, benchMap())
+}
+
+// BenchmarkLargeArray 编码结果约 1MB, WriteJSONTo 的内存占用只有 Stream 的缓冲大小
+func BenchmarkLargeArray(b *testing.B) {
+	benchmarkMarshal(b, benchArray(12))
+}
+
+// BenchmarkDeterministicMap 只有 map 字段, pb 按 Deterministic 生成, 复用 dst 时 allocs/op 就是排序 key 的分配,
+// 不超过 16 个 key 的 map 用栈上的数组排序, 更多 key 时每个 map 分配一次
+func BenchmarkDeterministicMap(b *testing.B) {
+	for _, n := range []int{8, 64} {
+		x := &pb.Map{
+			Numbers: map[uint32]*pb.Number{},
+			Strings: map[string]*pb.String{},
+			U32S:    map[string]uint32{},
+		}
+		for i := 0; i < n; i++ {
+			key := strconv.Itoa(i)
+			x.Numbers[uint32(i)] = &pb.Number{U32: uint32(i)}
+			x.Strings[key] = &pb.String{Str: key}
+			x.U32S[key] = uint32(i)
+		}
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			var buf []byte
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				var err error
+				if buf, err = x.AppendJSON(buf[:0]); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -1,9 +1,24 @@
 package pb_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"io"
+	"math"
+	"protoc-gen-go-json/runtime"
 	"protoc-gen-go-json/testdata/pb"
+	"strings"
 	"testing"
 )
 
@@ -33,7 +48,7 @@ func TestOneof_MarshalJSON(t *testing.T) {
 		args *pb.Oneof
 		want string
 	}{
-		{name: "nil", args: nil, want: ""},
+		{name: "nil", args: nil, want: "null"},
 		{
 			name: "empty",
 			args: &pb.Oneof{},
@@ -48,14 +63,109 @@ func TestOneof_MarshalJSON(t *testing.T) {
 			},
 			want: `{"string":{"str":"123"},"numberX":{"u32":100},"stringX":{"str":"123"}}`,
 		},
+		{name: "zero scalar", args: &pb.Oneof{Oneof: &pb.Oneof_U32{}}, want: `{"u32":0}`},
+		{name: "empty string", args: &pb.Oneof{Oneof: &pb.Oneof_Str{}}, want: `{"str":""}`},
+		{name: "zero enum", args: &pb.Oneof{Oneof: &pb.Oneof_Type{}}, want: `{"type":"NUMBER"}`},
+		{name: "nil message", args: &pb.Oneof{Oneof: &pb.Oneof_String_{}}, want: `{"string":{}}`},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			Assert(t, tt.args, tt.want)
+			if tt.args != nil {
+				expect, err := protojson.Marshal(tt.args)
+				require.NoError(t, err)
+				require.JSONEq(t, string(expect), tt.want)
+			}
 		})
 	}
 }
 
+func TestNil_MarshalJSON(t *testing.T) {
+	Assert(t, (*pb.Empty)(nil), "null")
+	Assert(t, (*pb.Message)(nil), "null")
+	Assert(t, &pb.Array{Numbers: []*pb.Number{nil, {U32: 1}}}, `{"numbers":[{},{"u32":1}]}`)
+	Assert(t, &pb.Map{Numbers: map[uint32]*pb.Number{1: nil}}, `{"numbers":{"1":{}}}`)
+
+	type wrapper struct {
+		Message json.Marshaler `json:"message"`
+		Empty   json.Marshaler `json:"empty"`
+	}
+	raw, err := json.Marshal(wrapper{Message: (*pb.Message)(nil), Empty: (*pb.Empty)(nil)})
+	require.NoError(t, err)
+	require.Equal(t, `{"message":null,"empty":null}`, string(raw))
+
+	got := &pb.Array{}
+	require.Error(t, got.UnmarshalJSON([]byte(`{"numbers":[null]}`)))
+}
+
+func TestAppendJSON(t *testing.T) {
+	args := []interface {
+		json.Marshaler
+		AppendJSON(dst []byte) ([]byte, error)
+	}{
+		(*pb.Message)(nil),
+		&pb.Empty{},
+		&pb.Message{Number: &pb.Number{U32: 1}, String_: &pb.String{Str: "s"}},
+		&pb.Array{Arrays: []*pb.Array{{U32S: []uint32{1}}, nil}},
+		&pb.Map{Strings: map[string]*pb.String{"a": {Str: "b"}}, Empties: map[string]*pb.Empty{"e": {}}},
+	}
+	for _, m := range args {
+		want, err := m.MarshalJSON()
+		require.NoError(t, err)
+		got, err := m.AppendJSON([]byte("prefix:"))
+		require.NoError(t, err)
+		require.Equal(t, "prefix:"+string(want), string(got))
+	}
+}
+
+func TestWriteJSONTo(t *testing.T) {
+	// 超过 Stream 缓冲大小, 会在元素之间分段写出
+	args := []interface {
+		json.Marshaler
+		WriteJSONTo(w io.Writer) (int64, error)
+	}{
+		(*pb.Array)(nil),
+		&pb.Empty{},
+		benchArray(10),
+		benchMap(),
+	}
+	for _, m := range args {
+		want, err := m.MarshalJSON()
+		require.NoError(t, err)
+		var out bytes.Buffer
+		n, err := m.WriteJSONTo(&out)
+		require.NoError(t, err)
+		require.EqualValues(t, len(want), n)
+		require.Equal(t, string(want), out.String())
+	}
+
+	_, err := (&pb.Array{Strs: []string{"\xff"}}).WriteJSONTo(io.Discard)
+	require.ErrorIs(t, err, runtime.ErrInvalidUTF8)
+}
+
+func TestJSONSizeHint(t *testing.T) {
+	// 没有需要转义的字符时估算不小于实际大小
+	args := []interface {
+		json.Marshaler
+		JSONSizeHint() int
+	}{
+		(*pb.Array)(nil),
+		&pb.Empty{},
+		&pb.Number{U64: math.MaxUint64, I64: math.MinInt64, F64: -math.MaxFloat64, F32: math.SmallestNonzeroFloat32},
+		&pb.Oneof{Oneof: &pb.Oneof_Str{Str: "oneof"}, Number: &pb.Number{I32: -1}},
+		&pb.Oneof{Oneof: &pb.Oneof_U32{}},
+		&pb.Oneof{Oneof: &pb.Oneof_String_{}},
+		&pb.Wrappers{Str: wrapperspb.String("str"), Bytes: wrapperspb.Bytes([]byte("bytes")), I64S: []*wrapperspb.Int64Value{nil, wrapperspb.Int64(1)}},
+		benchArray(3),
+		benchMap(),
+	}
+	for _, m := range args {
+		raw, err := m.MarshalJSON()
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, m.JSONSizeHint(), len(raw), string(raw))
+	}
+}
+
 func TestMessage_MarshalJSON(t *testing.T) {
 	tests := []struct {
 		name string
@@ -84,7 +194,7 @@ func TestMap_MarshalJSON(t *testing.T) {
 	tests := []struct {
 		name string
 		args *pb.Map
-		want []string
+		want string
 	}{
 		{
 			name: "not empty",
@@ -165,28 +275,1186 @@ func TestMap_MarshalJSON(t *testing.T) {
 					"optional2": {Number: &pb.Number{U32: 2}, String_: &pb.String{Str: "str2"}},
 				},
 			},
-			want: []string{
-				`{"numbers":{"1":{},"2":{"u32":2,"u64":2,"s32":2},"3":{"u32":3,"u64":3,"s32":2}},"strings":{"sk1":{"str":"sk1","bytes":"MDs="},"nil":{"str":"nil"}},"bools":{"true":{"b":true},"false":{"b":false}},"messages":{"msg1":{"type":"BOOL","number":{},"string":{"str":"msg1","bytes":"MDs="},"bool":{"b":true}}},"arrays":{"arr1":{"numbers":[{},{}],"strings":[{"str":"arr1","bytes":"MDs="},{"str":"arr2","bytes":"MDs="}],"bools":[{"b":true},{"b":false}],"messages":[{"type":"BOOL","number":{},"string":{"str":"arr1_msg1","bytes":"MDs="},"bool":{"b":true}},{"type":"BOOL","number":{},"string":{"str":"arr1_msg2","bytes":"MDs="},"bool":{"b":true}}],"arrays":[{"numbers":[{},{}],"strings":[{"str":"arr1","bytes":"MDs="},{"str":"arr2","bytes":"MDs="}],"bools":[{"b":true},{"b":false}],"messages":[{"type":"BOOL","number":{},"string":{"str":"arr1_msg1","bytes":"MDs="},"bool":{"b":true}},{"type":"BOOL","number":{},"string":{"str":"arr1_msg2","bytes":"MDs="},"bool":{"b":true}}],"types":["BOOL","NUMBER","STRING"],"u32s":[0,1,2,3],"strs":["str1","str2","str3"]}],"types":["BOOL","NUMBER","STRING"],"u32s":[0,1,2,3],"strs":["str1","str2","str3"]}},"types":{"1":"NUMBER","2":"STRING","0":"BOOL"},"u32s":{"u32_1":1,"u32_2":2,"u32_3":3},"strs":{"str1":"str1","str2":"str2"},"empties":{"empty1":{},"empty2":{},"empty3":{}},"optionals":{"optional1":{"number":{"u32":1},"string":{"str":"str1"}},"optional2":{"number":{"u32":2},"string":{"str":"str2"}}}}`,
-				`{"numbers":{"1":{},"2":{"u32":2,"u64":2,"s32":2},"3":{"u32":3,"u64":3,"s32":2}},"strings":{"sk1":{"str":"sk1","bytes":"MDs="},"nil":{"str":"nil"}},"bools":{"true":{"b":true},"false":{"b":false}},"messages":{"msg1":{"type":"BOOL","number":{},"string":{"str":"msg1","bytes":"MDs="},"bool":{"b":true}}},"arrays":{"arr1":{"numbers":[{},{}],"strings":[{"str":"arr1","bytes":"MDs="},{"str":"arr2","bytes":"MDs="}],"bools":[{"b":true},{"b":false}],"messages":[{"type":"BOOL","number":{},"string":{"str":"arr1_msg1","bytes":"MDs="},"bool":{"b":true}},{"type":"BOOL","number":{},"string":{"str":"arr1_msg2","bytes":"MDs="},"bool":{"b":true}}],"arrays":[{"numbers":[{},{}],"strings":[{"str":"arr1","bytes":"MDs="},{"str":"arr2","bytes":"MDs="}],"bools":[{"b":true},{"b":false}],"messages":[{"type":"BOOL","number":{},"string":{"str":"arr1_msg1","bytes":"MDs="},"bool":{"b":true}},{"type":"BOOL","number":{},"string":{"str":"arr1_msg2","bytes":"MDs="},"bool":{"b":true}}],"types":["BOOL","NUMBER","STRING"],"u32s":[0,1,2,3],"strs":["str1","str2","str3"]}],"types":["BOOL","NUMBER","STRING"],"u32s":[0,1,2,3],"strs":["str1","str2","str3"]}},"types":{"0":"BOOL","1":"NUMBER","2":"STRING"},"u32s":{"u32_2":2,"u32_3":3,"u32_1":1},"strs":{"str1":"str1","str2":"str2"},"empties":{"empty2":{},"empty3":{},"empty1":{}},"optionals":{"optional1":{"number":{"u32":1},"string":{"str":"str1"}},"optional2":{"number":{"u32":2},"string":{"str":"str2"}}}}`,
-				`{"numbers":{"1":{},"2":{"u32":2,"u64":2,"s32":2},"3":{"u32":3,"u64":3,"s32":2}},"strings":{"sk1":{"str":"sk1","bytes":"MDs="},"nil":{"str":"nil"}},"bools":{"true":{"b":true},"false":{"b":false}},"messages":{"msg1":{"type":"BOOL","number":{},"string":{"str":"msg1","bytes":"MDs="},"bool":{"b":true}}},"arrays":{"arr1":{"numbers":[{},{}],"strings":[{"str":"arr1","bytes":"MDs="},{"str":"arr2","bytes":"MDs="}],"bools":[{"b":true},{"b":false}],"messages":[{"type":"BOOL","number":{},"string":{"str":"arr1_msg1","bytes":"MDs="},"bool":{"b":true}},{"type":"BOOL","number":{},"string":{"str":"arr1_msg2","bytes":"MDs="},"bool":{"b":true}}],"arrays":[{"numbers":[{},{}],"strings":[{"str":"arr1","bytes":"MDs="},{"str":"arr2","bytes":"MDs="}],"bools":[{"b":true},{"b":false}],"messages":[{"type":"BOOL","number":{},"string":{"str":"arr1_msg1","bytes":"MDs="},"bool":{"b":true}},{"type":"BOOL","number":{},"string":{"str":"arr1_msg2","bytes":"MDs="},"bool":{"b":true}}],"types":["BOOL","NUMBER","STRING"],"u32s":[0,1,2,3],"strs":["str1","str2","str3"]}],"types":["BOOL","NUMBER","STRING"],"u32s":[0,1,2,3],"strs":["str1","str2","str3"]}},"types":{"0":"BOOL","1":"NUMBER","2":"STRING"},"u32s":{"u32_1":1,"u32_2":2,"u32_3":3},"strs":{"str1":"str1","str2":"str2"},"empties":{"empty1":{},"empty2":{},"empty3":{}},"optionals":{"optional1":{"number":{"u32":1},"string":{"str":"str1"}},"optional2":{"number":{"u32":2},"string":{"str":"str2"}}}}`,
-				`{"numbers":{"1":{},"2":{"u32":2,"u64":2,"s32":2},"3":{"u32":3,"u64":3,"s32":2}},"strings":{"sk1":{"str":"sk1","bytes":"MDs="},"nil":{"str":"nil"}},"bools":{"true":{"b":true},"false":{"b":false}},"messages":{"msg1":{"type":"BOOL","number":{},"string":{"str":"msg1","bytes":"MDs="},"bool":{"b":true}}},"arrays":{"arr1":{"numbers":[{},{}],"strings":[{"str":"arr1","bytes":"MDs="},{"str":"arr2","bytes":"MDs="}],"bools":[{"b":true},{"b":false}],"messages":[{"type":"BOOL","number":{},"string":{"str":"arr1_msg1","bytes":"MDs="},"bool":{"b":true}},{"type":"BOOL","number":{},"string":{"str":"arr1_msg2","bytes":"MDs="},"bool":{"b":true}}],"arrays":[{"numbers":[{},{}],"strings":[{"str":"arr1","bytes":"MDs="},{"str":"arr2","bytes":"MDs="}],"bools":[{"b":true},{"b":false}],"messages":[{"type":"BOOL","number":{},"string":{"str":"arr1_msg1","bytes":"MDs="},"bool":{"b":true}},{"type":"BOOL","number":{},"string":{"str":"arr1_msg2","bytes":"MDs="},"bool":{"b":true}}],"types":["BOOL","NUMBER","STRING"],"u32s":[0,1,2,3],"strs":["str1","str2","str3"]}],"types":["BOOL","NUMBER","STRING"],"u32s":[0,1,2,3],"strs":["str1","str2","str3"]}},"types":{"0":"BOOL","1":"NUMBER","2":"STRING"},"u32s":{"u32_3":3,"u32_1":1,"u32_2":2},"strs":{"str1":"str1","str2":"str2"},"empties":{"empty3":{},"empty1":{},"empty2":{}},"optionals":{"optional1":{"number":{"u32":1},"string":{"str":"str1"}},"optional2":{"number":{"u32":2},"string":{"str":"str2"}}}}`,
-				`{"numbers":{"1":{},"2":{"u32":2,"u64":2,"s32":2},"3":{"u32":3,"u64":3,"s32":2}},"strings":{"sk1":{"str":"sk1","bytes":"MDs="},"nil":{"str":"nil"}},"bools":{"true":{"b":true},"false":{"b":false}},"messages":{"msg1":{"type":"BOOL","number":{},"string":{"str":"msg1","bytes":"MDs="},"bool":{"b":true}}},"arrays":{"arr1":{"numbers":[{},{}],"strings":[{"str":"arr1","bytes":"MDs="},{"str":"arr2","bytes":"MDs="}],"bools":[{"b":true},{"b":false}],"messages":[{"type":"BOOL","number":{},"string":{"str":"arr1_msg1","bytes":"MDs="},"bool":{"b":true}},{"type":"BOOL","number":{},"string":{"str":"arr1_msg2","bytes":"MDs="},"bool":{"b":true}}],"arrays":[{"numbers":[{},{}],"strings":[{"str":"arr1","bytes":"MDs="},{"str":"arr2","bytes":"MDs="}],"bools":[{"b":true},{"b":false}],"messages":[{"type":"BOOL","number":{},"string":{"str":"arr1_msg1","bytes":"MDs="},"bool":{"b":true}},{"type":"BOOL","number":{},"string":{"str":"arr1_msg2","bytes":"MDs="},"bool":{"b":true}}],"types":["BOOL","NUMBER","STRING"],"u32s":[0,1,2,3],"strs":["str1","str2","str3"]}],"types":["BOOL","NUMBER","STRING"],"u32s":[0,1,2,3],"strs":["str1","str2","str3"]}},"types":{"0":"BOOL","1":"NUMBER","2":"STRING"},"u32s":{"u32_3":3,"u32_1":1,"u32_2":2},"strs":{"str1":"str1","str2":"str2"},"empties":{"empty1":{},"empty2":{},"empty3":{}},"optionals":{"optional1":{"number":{"u32":1},"string":{"str":"str1"}},"optional2":{"number":{"u32":2},"string":{"str":"str2"}}}}`,
-				`{"numbers":{"1":{},"2":{"u32":2,"u64":2,"s32":2},"3":{"u32":3,"u64":3,"s32":2}},"strings":{"sk1":{"str":"sk1","bytes":"MDs="},"nil":{"str":"nil"}},"bools":{"false":{"b":false},"true":{"b":true}},"messages":{"msg1":{"type":"BOOL","number":{},"string":{"str":"msg1","bytes":"MDs="},"bool":{"b":true}}},"arrays":{"arr1":{"numbers":[{},{}],"strings":[{"str":"arr1","bytes":"MDs="},{"str":"arr2","bytes":"MDs="}],"bools":[{"b":true},{"b":false}],"messages":[{"type":"BOOL","number":{},"string":{"str":"arr1_msg1","bytes":"MDs="},"bool":{"b":true}},{"type":"BOOL","number":{},"string":{"str":"arr1_msg2","bytes":"MDs="},"bool":{"b":true}}],"arrays":[{"numbers":[{},{}],"strings":[{"str":"arr1","bytes":"MDs="},{"str":"arr2","bytes":"MDs="}],"bools":[{"b":true},{"b":false}],"messages":[{"type":"BOOL","number":{},"string":{"str":"arr1_msg1","bytes":"MDs="},"bool":{"b":true}},{"type":"BOOL","number":{},"string":{"str":"arr1_msg2","bytes":"MDs="},"bool":{"b":true}}],"types":["BOOL","NUMBER","STRING"],"u32s":[0,1,2,3],"strs":["str1","str2","str3"]}],"types":["BOOL","NUMBER","STRING"],"u32s":[0,1,2,3],"strs":["str1","str2","str3"]}},"types":{"0":"BOOL","1":"NUMBER","2":"STRING"},"u32s":{"u32_1":1,"u32_2":2,"u32_3":3},"strs":{"str1":"str1","str2":"str2"},"empties":{"empty1":{},"empty2":{},"empty3":{}},"optionals":{"optional1":{"number":{"u32":1},"string":{"str":"str1"}},"optional2":{"number":{"u32":2},"string":{"str":"str2"}}}}`,
-				`{"numbers":{"1":{},"2":{"u32":2,"u64":2,"s32":2},"3":{"u32":3,"u64":3,"s32":2}},"strings":{"sk1":{"str":"sk1","bytes":"MDs="},"nil":{"str":"nil"}},"bools":{"true":{"b":true},"false":{"b":false}},"messages":{"msg1":{"type":"BOOL","number":{},"string":{"str":"msg1","bytes":"MDs="},"bool":{"b":true}}},"arrays":{"arr1":{"numbers":[{},{}],"strings":[{"str":"arr1","bytes":"MDs="},{"str":"arr2","bytes":"MDs="}],"bools":[{"b":true},{"b":false}],"messages":[{"type":"BOOL","number":{},"string":{"str":"arr1_msg1","bytes":"MDs="},"bool":{"b":true}},{"type":"BOOL","number":{},"string":{"str":"arr1_msg2","bytes":"MDs="},"bool":{"b":true}}],"arrays":[{"numbers":[{},{}],"strings":[{"str":"arr1","bytes":"MDs="},{"str":"arr2","bytes":"MDs="}],"bools":[{"b":true},{"b":false}],"messages":[{"type":"BOOL","number":{},"string":{"str":"arr1_msg1","bytes":"MDs="},"bool":{"b":true}},{"type":"BOOL","number":{},"string":{"str":"arr1_msg2","bytes":"MDs="},"bool":{"b":true}}],"types":["BOOL","NUMBER","STRING"],"u32s":[0,1,2,3],"strs":["str1","str2","str3"]}],"types":["BOOL","NUMBER","STRING"],"u32s":[0,1,2,3],"strs":["str1","str2","str3"]}},"types":{"2":"STRING","0":"BOOL","1":"NUMBER"},"u32s":{"u32_1":1,"u32_2":2,"u32_3":3},"strs":{"str1":"str1","str2":"str2"},"empties":{"empty1":{},"empty2":{},"empty3":{}},"optionals":{"optional1":{"number":{"u32":1},"string":{"str":"str1"}},"optional2":{"number":{"u32":2},"string":{"str":"str2"}}}}`,
-				`{"numbers":{"1":{},"2":{"u32":2,"u64":2,"s32":2},"3":{"u32":3,"u64":3,"s32":2}},"strings":{"nil":{"str":"nil"},"sk1":{"str":"sk1","bytes":"MDs="}},"bools":{"true":{"b":true},"false":{"b":false}},"messages":{"msg1":{"type":"BOOL","number":{},"string":{"str":"msg1","bytes":"MDs="},"bool":{"b":true}}},"arrays":{"arr1":{"numbers":[{},{}],"strings":[{"str":"arr1","bytes":"MDs="},{"str":"arr2","bytes":"MDs="}],"bools":[{"b":true},{"b":false}],"messages":[{"type":"BOOL","number":{},"string":{"str":"arr1_msg1","bytes":"MDs="},"bool":{"b":true}},{"type":"BOOL","number":{},"string":{"str":"arr1_msg2","bytes":"MDs="},"bool":{"b":true}}],"arrays":[{"numbers":[{},{}],"strings":[{"str":"arr1","bytes":"MDs="},{"str":"arr2","bytes":"MDs="}],"bools":[{"b":true},{"b":false}],"messages":[{"type":"BOOL","number":{},"string":{"str":"arr1_msg1","bytes":"MDs="},"bool":{"b":true}},{"type":"BOOL","number":{},"string":{"str":"arr1_msg2","bytes":"MDs="},"bool":{"b":true}}],"types":["BOOL","NUMBER","STRING"],"u32s":[0,1,2,3],"strs":["str1","str2","str3"]}],"types":["BOOL","NUMBER","STRING"],"u32s":[0,1,2,3],"strs":["str1","str2","str3"]}},"types":{"0":"BOOL","1":"NUMBER","2":"STRING"},"u32s":{"u32_1":1,"u32_2":2,"u32_3":3},"strs":{"str1":"str1","str2":"str2"},"empties":{"empty3":{},"empty1":{},"empty2":{}},"optionals":{"optional1":{"number":{"u32":1},"string":{"str":"str1"}},"optional2":{"number":{"u32":2},"string":{"str":"str2"}}}}`,
-				`{"numbers":{"1":{},"2":{"u32":2,"u64":2,"s32":2},"3":{"u32":3,"u64":3,"s32":2}},"strings":{"sk1":{"str":"sk1","bytes":"MDs="},"nil":{"str":"nil"}},"bools":{"true":{"b":true},"false":{"b":false}},"messages":{"msg1":{"type":"BOOL","number":{},"string":{"str":"msg1","bytes":"MDs="},"bool":{"b":true}}},"arrays":{"arr1":{"numbers":[{},{}],"strings":[{"str":"arr1","bytes":"MDs="},{"str":"arr2","bytes":"MDs="}],"bools":[{"b":true},{"b":false}],"messages":[{"type":"BOOL","number":{},"string":{"str":"arr1_msg1","bytes":"MDs="},"bool":{"b":true}},{"type":"BOOL","number":{},"string":{"str":"arr1_msg2","bytes":"MDs="},"bool":{"b":true}}],"arrays":[{"numbers":[{},{}],"strings":[{"str":"arr1","bytes":"MDs="},{"str":"arr2","bytes":"MDs="}],"bools":[{"b":true},{"b":false}],"messages":[{"type":"BOOL","number":{},"string":{"str":"arr1_msg1","bytes":"MDs="},"bool":{"b":true}},{"type":"BOOL","number":{},"string":{"str":"arr1_msg2","bytes":"MDs="},"bool":{"b":true}}],"types":["BOOL","NUMBER","STRING"],"u32s":[0,1,2,3],"strs":["str1","str2","str3"]}],"types":["BOOL","NUMBER","STRING"],"u32s":[0,1,2,3],"strs":["str1","str2","str3"]}},"types":{"0":"BOOL","1":"NUMBER","2":"STRING"},"u32s":{"u32_1":1,"u32_2":2,"u32_3":3},"strs":{"str1":"str1","str2":"str2"},"empties":{"empty2":{},"empty3":{},"empty1":{}},"optionals":{"optional1":{"number":{"u32":1},"string":{"str":"str1"}},"optional2":{"number":{"u32":2},"string":{"str":"str2"}}}}`,
-				`{"numbers":{"2":{"u32":2,"u64":2,"s32":2},"3":{"u32":3,"u64":3,"s32":2},"1":{}},"strings":{"sk1":{"str":"sk1","bytes":"MDs="},"nil":{"str":"nil"}},"bools":{"true":{"b":true},"false":{"b":false}},"messages":{"msg1":{"type":"BOOL","number":{},"string":{"str":"msg1","bytes":"MDs="},"bool":{"b":true}}},"arrays":{"arr1":{"numbers":[{},{}],"strings":[{"str":"arr1","bytes":"MDs="},{"str":"arr2","bytes":"MDs="}],"bools":[{"b":true},{"b":false}],"messages":[{"type":"BOOL","number":{},"string":{"str":"arr1_msg1","bytes":"MDs="},"bool":{"b":true}},{"type":"BOOL","number":{},"string":{"str":"arr1_msg2","bytes":"MDs="},"bool":{"b":true}}],"arrays":[{"numbers":[{},{}],"strings":[{"str":"arr1","bytes":"MDs="},{"str":"arr2","bytes":"MDs="}],"bools":[{"b":true},{"b":false}],"messages":[{"type":"BOOL","number":{},"string":{"str":"arr1_msg1","bytes":"MDs="},"bool":{"b":true}},{"type":"BOOL","number":{},"string":{"str":"arr1_msg2","bytes":"MDs="},"bool":{"b":true}}],"types":["BOOL","NUMBER","STRING"],"u32s":[0,1,2,3],"strs":["str1","str2","str3"]}],"types":["BOOL","NUMBER","STRING"],"u32s":[0,1,2,3],"strs":["str1","str2","str3"]}},"types":{"0":"BOOL","1":"NUMBER","2":"STRING"},"u32s":{"u32_1":1,"u32_2":2,"u32_3":3},"strs":{"str1":"str1","str2":"str2"},"empties":{"empty1":{},"empty2":{},"empty3":{}},"optionals":{"optional1":{"number":{"u32":1},"string":{"str":"str1"}},"optional2":{"number":{"u32":2},"string":{"str":"str2"}}}}`,
-				`{"numbers":{"3":{"u32":3,"u64":3,"s32":2},"1":{},"2":{"u32":2,"u64":2,"s32":2}},"strings":{"sk1":{"str":"sk1","bytes":"MDs="},"nil":{"str":"nil"}},"bools":{"true":{"b":true},"false":{"b":false}},"messages":{"msg1":{"type":"BOOL","number":{},"string":{"str":"msg1","bytes":"MDs="},"bool":{"b":true}}},"arrays":{"arr1":{"numbers":[{},{}],"strings":[{"str":"arr1","bytes":"MDs="},{"str":"arr2","bytes":"MDs="}],"bools":[{"b":true},{"b":false}],"messages":[{"type":"BOOL","number":{},"string":{"str":"arr1_msg1","bytes":"MDs="},"bool":{"b":true}},{"type":"BOOL","number":{},"string":{"str":"arr1_msg2","bytes":"MDs="},"bool":{"b":true}}],"arrays":[{"numbers":[{},{}],"strings":[{"str":"arr1","bytes":"MDs="},{"str":"arr2","bytes":"MDs="}],"bools":[{"b":true},{"b":false}],"messages":[{"type":"BOOL","number":{},"string":{"str":"arr1_msg1","bytes":"MDs="},"bool":{"b":true}},{"type":"BOOL","number":{},"string":{"str":"arr1_msg2","bytes":"MDs="},"bool":{"b":true}}],"types":["BOOL","NUMBER","STRING"],"u32s":[0,1,2,3],"strs":["str1","str2","str3"]}],"types":["BOOL","NUMBER","STRING"],"u32s":[0,1,2,3],"strs":["str1","str2","str3"]}},"types":{"0":"BOOL","1":"NUMBER","2":"STRING"},"u32s":{"u32_1":1,"u32_2":2,"u32_3":3},"strs":{"str1":"str1","str2":"str2"},"empties":{"empty1":{},"empty2":{},"empty3":{}},"optionals":{"optional1":{"number":{"u32":1},"string":{"str":"str1"}},"optional2":{"number":{"u32":2},"string":{"str":"str2"}}}}`,
-				`{"numbers":{"1":{},"2":{"u32":2,"u64":2,"s32":2},"3":{"u32":3,"u64":3,"s32":2}},"strings":{"nil":{"str":"nil"},"sk1":{"str":"sk1","bytes":"MDs="}},"bools":{"true":{"b":true},"false":{"b":false}},"messages":{"msg1":{"type":"BOOL","number":{},"string":{"str":"msg1","bytes":"MDs="},"bool":{"b":true}}},"arrays":{"arr1":{"numbers":[{},{}],"strings":[{"str":"arr1","bytes":"MDs="},{"str":"arr2","bytes":"MDs="}],"bools":[{"b":true},{"b":false}],"messages":[{"type":"BOOL","number":{},"string":{"str":"arr1_msg1","bytes":"MDs="},"bool":{"b":true}},{"type":"BOOL","number":{},"string":{"str":"arr1_msg2","bytes":"MDs="},"bool":{"b":true}}],"arrays":[{"numbers":[{},{}],"strings":[{"str":"arr1","bytes":"MDs="},{"str":"arr2","bytes":"MDs="}],"bools":[{"b":true},{"b":false}],"messages":[{"type":"BOOL","number":{},"string":{"str":"arr1_msg1","bytes":"MDs="},"bool":{"b":true}},{"type":"BOOL","number":{},"string":{"str":"arr1_msg2","bytes":"MDs="},"bool":{"b":true}}],"types":["BOOL","NUMBER","STRING"],"u32s":[0,1,2,3],"strs":["str1","str2","str3"]}],"types":["BOOL","NUMBER","STRING"],"u32s":[0,1,2,3],"strs":["str1","str2","str3"]}},"types":{"0":"BOOL","1":"NUMBER","2":"STRING"},"u32s":{"u32_1":1,"u32_2":2,"u32_3":3},"strs":{"str1":"str1","str2":"str2"},"empties":{"empty1":{},"empty2":{},"empty3":{}},"optionals":{"optional1":{"number":{"u32":1},"string":{"str":"str1"}},"optional2":{"number":{"u32":2},"string":{"str":"str2"}}}}`,
-				`{"numbers":{"3":{"u32":3,"u64":3,"s32":2},"1":{},"2":{"u32":2,"u64":2,"s32":2}},"strings":{"sk1":{"str":"sk1","bytes":"MDs="},"nil":{"str":"nil"}},"bools":{"true":{"b":true},"false":{"b":false}},"messages":{"msg1":{"type":"BOOL","number":{},"string":{"str":"msg1","bytes":"MDs="},"bool":{"b":true}}},"arrays":{"arr1":{"numbers":[{},{}],"strings":[{"str":"arr1","bytes":"MDs="},{"str":"arr2","bytes":"MDs="}],"bools":[{"b":true},{"b":false}],"messages":[{"type":"BOOL","number":{},"string":{"str":"arr1_msg1","bytes":"MDs="},"bool":{"b":true}},{"type":"BOOL","number":{},"string":{"str":"arr1_msg2","bytes":"MDs="},"bool":{"b":true}}],"arrays":[{"numbers":[{},{}],"strings":[{"str":"arr1","bytes":"MDs="},{"str":"arr2","bytes":"MDs="}],"bools":[{"b":true},{"b":false}],"messages":[{"type":"BOOL","number":{},"string":{"str":"arr1_msg1","bytes":"MDs="},"bool":{"b":true}},{"type":"BOOL","number":{},"string":{"str":"arr1_msg2","bytes":"MDs="},"bool":{"b":true}}],"types":["BOOL","NUMBER","STRING"],"u32s":[0,1,2,3],"strs":["str1","str2","str3"]}],"types":["BOOL","NUMBER","STRING"],"u32s":[0,1,2,3],"strs":["str1","str2","str3"]}},"types":{"0":"BOOL","1":"NUMBER","2":"STRING"},"u32s":{"u32_3":3,"u32_1":1,"u32_2":2},"strs":{"str1":"str1","str2":"str2"},"empties":{"empty1":{},"empty2":{},"empty3":{}},"optionals":{"optional1":{"number":{"u32":1},"string":{"str":"str1"}},"optional2":{"number":{"u32":2},"string":{"str":"str2"}}}}`,
-				`{"numbers":{"1":{},"2":{"u32":2,"u64":2,"s32":2},"3":{"u32":3,"u64":3,"s32":2}},"strings":{"sk1":{"str":"sk1","bytes":"MDs="},"nil":{"str":"nil"}},"bools":{"true":{"b":true},"false":{"b":false}},"messages":{"msg1":{"type":"BOOL","number":{},"string":{"str":"msg1","bytes":"MDs="},"bool":{"b":true}}},"arrays":{"arr1":{"numbers":[{},{}],"strings":[{"str":"arr1","bytes":"MDs="},{"str":"arr2","bytes":"MDs="}],"bools":[{"b":true},{"b":false}],"messages":[{"type":"BOOL","number":{},"string":{"str":"arr1_msg1","bytes":"MDs="},"bool":{"b":true}},{"type":"BOOL","number":{},"string":{"str":"arr1_msg2","bytes":"MDs="},"bool":{"b":true}}],"arrays":[{"numbers":[{},{}],"strings":[{"str":"arr1","bytes":"MDs="},{"str":"arr2","bytes":"MDs="}],"bools":[{"b":true},{"b":false}],"messages":[{"type":"BOOL","number":{},"string":{"str":"arr1_msg1","bytes":"MDs="},"bool":{"b":true}},{"type":"BOOL","number":{},"string":{"str":"arr1_msg2","bytes":"MDs="},"bool":{"b":true}}],"types":["BOOL","NUMBER","STRING"],"u32s":[0,1,2,3],"strs":["str1","str2","str3"]}],"types":["BOOL","NUMBER","STRING"],"u32s":[0,1,2,3],"strs":["str1","str2","str3"]}},"types":{"0":"BOOL","1":"NUMBER","2":"STRING"},"u32s":{"u32_1":1,"u32_2":2,"u32_3":3},"strs":{"str1":"str1","str2":"str2"},"empties":{"empty2":{},"empty3":{},"empty1":{}},"optionals":{"optional2":{"number":{"u32":2},"string":{"str":"str2"}},"optional1":{"number":{"u32":1},"string":{"str":"str1"}}}}`,
-				`{"numbers":{"1":{},"2":{"u32":2,"u64":2,"s32":2},"3":{"u32":3,"u64":3,"s32":2}},"strings":{"sk1":{"str":"sk1","bytes":"MDs="},"nil":{"str":"nil"}},"bools":{"true":{"b":true},"false":{"b":false}},"messages":{"msg1":{"type":"BOOL","number":{},"string":{"str":"msg1","bytes":"MDs="},"bool":{"b":true}}},"arrays":{"arr1":{"numbers":[{},{}],"strings":[{"str":"arr1","bytes":"MDs="},{"str":"arr2","bytes":"MDs="}],"bools":[{"b":true},{"b":false}],"messages":[{"type":"BOOL","number":{},"string":{"str":"arr1_msg1","bytes":"MDs="},"bool":{"b":true}},{"type":"BOOL","number":{},"string":{"str":"arr1_msg2","bytes":"MDs="},"bool":{"b":true}}],"arrays":[{"numbers":[{},{}],"strings":[{"str":"arr1","bytes":"MDs="},{"str":"arr2","bytes":"MDs="}],"bools":[{"b":true},{"b":false}],"messages":[{"type":"BOOL","number":{},"string":{"str":"arr1_msg1","bytes":"MDs="},"bool":{"b":true}},{"type":"BOOL","number":{},"string":{"str":"arr1_msg2","bytes":"MDs="},"bool":{"b":true}}],"types":["BOOL","NUMBER","STRING"],"u32s":[0,1,2,3],"strs":["str1","str2","str3"]}],"types":["BOOL","NUMBER","STRING"],"u32s":[0,1,2,3],"strs":["str1","str2","str3"]}},"types":{"0":"BOOL","1":"NUMBER","2":"STRING"},"u32s":{"u32_1":1,"u32_2":2,"u32_3":3},"strs":{"str1":"str1","str2":"str2"},"empties":{"empty3":{},"empty1":{},"empty2":{}},"optionals":{"optional1":{"number":{"u32":1},"string":{"str":"str1"}},"optional2":{"number":{"u32":2},"string":{"str":"str2"}}}}`,
+			want: `{"numbers":{"1":{},"2":{"u32":2,"u64":"2","s32":2},"3":{"u32":3,"u64":"3","s32":2}},"strings":{"nil":{"str":"nil"},"sk1":{"str":"sk1","bytes":"MDs="}},"bools":{"false":{"b":false},"true":{"b":true}},"messages":{"msg1":{"type":"BOOL","number":{},"string":{"str":"msg1","bytes":"MDs="},"bool":{"b":true}}},"arrays":{"arr1":{"numbers":[{},{}],"strings":[{"str":"arr1","bytes":"MDs="},{"str":"arr2","bytes":"MDs="}],"bools":[{"b":true},{"b":false}],"messages":[{"type":"BOOL","number":{},"string":{"str":"arr1_msg1","bytes":"MDs="},"bool":{"b":true}},{"type":"BOOL","number":{},"string":{"str":"arr1_msg2","bytes":"MDs="},"bool":{"b":true}}],"arrays":[{"numbers":[{},{}],"strings":[{"str":"arr1","bytes":"MDs="},{"str":"arr2","bytes":"MDs="}],"bools":[{"b":true},{"b":false}],"messages":[{"type":"BOOL","number":{},"string":{"str":"arr1_msg1","bytes":"MDs="},"bool":{"b":true}},{"type":"BOOL","number":{},"string":{"str":"arr1_msg2","bytes":"MDs="},"bool":{"b":true}}],"types":["BOOL","NUMBER","STRING"],"u32s":[0,1,2,3],"strs":["str1","str2","str3"]}],"types":["BOOL","NUMBER","STRING"],"u32s":[0,1,2,3],"strs":["str1","str2","str3"]}},"types":{"0":"BOOL","1":"NUMBER","2":"STRING"},"u32s":{"u32_1":1,"u32_2":2,"u32_3":3},"strs":{"str1":"str1","str2":"str2"},"empties":{"empty1":{},"empty2":{},"empty3":{}},"optionals":{"optional1":{"number":{"u32":1},"string":{"str":"str1"}},"optional2":{"number":{"u32":2},"string":{"str":"str2"}}}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Assert(t, tt.args, tt.want)
+		})
+	}
+}
+
+func AssertRoundTrip(t *testing.T, args proto.Message) {
+	t.Helper()
+	raw, err := args.(json.Marshaler).MarshalJSON()
+	require.NoError(t, err)
+	got := args.ProtoReflect().New().Interface()
+	require.NoError(t, got.(json.Unmarshaler).UnmarshalJSON(raw))
+	require.True(t, proto.Equal(args, got), "want %v, got %v", args, got)
+}
+
+func TestRoundTrip_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		args proto.Message
+	}{
+		{name: "number", args: &pb.Number{U32: 1, U64: 2, S32: 3, S64: 4, Uf32: 5, Uf64: 6, Sf32: 7, Sf64: 8, I32: 9, I64: 10, F64: 1.5, F32: 2.5}},
+		{name: "string", args: &pb.String{Str: "str", Bytes: []byte{48, 59, 255}}},
+		{name: "message", args: &pb.Message{
+			Type:    pb.Type_BOOL,
+			Number:  &pb.Number{},
+			String_: &pb.String{Str: "msg1", Bytes: []byte{48, 59}},
+			Bool:    &pb.Bool{B: true},
+		}},
+		{name: "array", args: &pb.Array{
+			Numbers: []*pb.Number{{U32: 1}, {}},
+			Strings: []*pb.String{{Str: "arr1"}},
+			Bools:   []*pb.Bool{{B: true}, {B: false}},
+			Arrays:  []*pb.Array{{U32S: []uint32{1}}},
+			Types:   []pb.Type{pb.Type_BOOL, pb.Type_NUMBER},
+			U32S:    []uint32{0, 1, 2, 3},
+			Strs:    []string{"str1", "str2"},
+		}},
+		{name: "map", args: &pb.Map{
+			Numbers:   map[uint32]*pb.Number{1: {}, 2: {U32: 2}},
+			Bools:     map[bool]*pb.Bool{true: {B: true}, false: {}},
+			Types:     map[int32]pb.Type{0: pb.Type_BOOL, 1: pb.Type_STRING},
+			U32S:      map[string]uint32{"u32_1": 1},
+			Strs:      map[string]string{"str1": "str1"},
+			Empties:   map[string]*pb.Empty{"empty": {}},
+			Optionals: map[string]*pb.Optional{"optional": {U32: proto.Uint32(0)}},
+			Oneofs:    map[string]*pb.Oneof{"oneof": {Oneof: &pb.Oneof_Str{Str: "str"}}},
+		}},
+		{name: "optional", args: &pb.Optional{
+			Number: &pb.Number{},
+			Type:   pb.Type_NUMBER.Enum(),
+			U32:    proto.Uint32(0),
+			Str:    proto.String(""),
+		}},
+		{name: "oneof", args: &pb.Oneof{
+			Oneof:   &pb.Oneof_Message{Message: &pb.Message{Type: pb.Type_STRING}},
+			NumberX: &pb.Number{U32: 100},
+		}},
+		{name: "oneof scalar", args: &pb.Oneof{Oneof: &pb.Oneof_U32{U32: 7}}},
+		{name: "oneof zero scalar", args: &pb.Oneof{Oneof: &pb.Oneof_U32{}}},
+		{name: "oneof nil message", args: &pb.Oneof{Oneof: &pb.Oneof_String_{}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			AssertRoundTrip(t, tt.args)
+		})
+	}
+}
+
+func TestMessage_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    *pb.Message
+		wantErr bool
+	}{
+		{name: "empty", data: `{}`, want: &pb.Message{}},
+		{name: "null", data: `null`, want: &pb.Message{}},
+		{name: "null fields", data: `{"type":null,"number":null}`, want: &pb.Message{}},
+		{
+			name: "proto name and enum number",
+			data: ` { "type" : 2 , "string" : {"str":"a\"é\n","bytes":"MDs"} } `,
+			want: &pb.Message{Type: pb.Type_BOOL, String_: &pb.String{Str: "a\"é\n", Bytes: []byte{48, 59}}},
+		},
+		{name: "unknown keys", data: `{"unknown":[1,{"a":[true,null]},"x"],"bool":{"b":true}}`, wantErr: true},
+		{name: "invalid enum", data: `{"type":"UNKNOWN"}`, wantErr: true},
+		{name: "trailing comma", data: `{"type":"BOOL",}`, wantErr: true},
+		{name: "missing comma", data: `{"type":"BOOL" "bool":{}}`, wantErr: true},
+		{name: "trailing data", data: `{} {}`, wantErr: true},
+		{name: "not object", data: `[]`, wantErr: true},
+		{
+			name: "surrogate pair",
+			data: `{"string":{"str":"\ud83d\ude00"}}`,
+			want: &pb.Message{String_: &pb.String{Str: "😀"}},
+		},
+		{name: "lone surrogate", data: `{"string":{"str":"\ud800"}}`, wantErr: true},
+		{name: "unpaired surrogate", data: `{"string":{"str":"\ud83d\u0041"}}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := &pb.Message{}
+			err := got.UnmarshalJSON([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Error(t, protojson.Unmarshal([]byte(tt.data), &pb.Message{}))
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, proto.Equal(tt.want, got), "want %v, got %v", tt.want, got)
+		})
+	}
+}
+
+func TestUnmarshalJSON_Unknown(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		msg     runtime.Unmarshaler
+		want    proto.Message
+		wantErr string
+	}{
+		{
+			name:    "message",
+			data:    `{"unknown":1}`,
+			msg:     &pb.Message{},
+			want:    &pb.Message{},
+			wantErr: `unknown field "unknown" in pb.Message`,
+		},
+		{
+			name:    "nested",
+			data:    `{"bool":{"b":true,"c\u0041":[{"x":"\"}"}]}}`,
+			msg:     &pb.Message{},
+			want:    &pb.Message{Bool: &pb.Bool{B: true}},
+			wantErr: `unknown field "cA" in pb.Bool`,
+		},
+		{
+			name:    "map value",
+			data:    `{"numbers":{"1":{"u32":1,"u33":{"a":[1,2,{}]}}}}`,
+			msg:     &pb.Map{},
+			want:    &pb.Map{Numbers: map[uint32]*pb.Number{1: {U32: 1}}},
+			wantErr: `unknown field "u33" in pb.Number`,
+		},
+		{
+			name:    "empty",
+			data:    `{"empty":{"a":null}}`,
+			msg:     &pb.WellKnown{},
+			want:    &pb.WellKnown{Empty: &emptypb.Empty{}},
+			wantErr: `unknown field "a" in google.protobuf.Empty`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.(json.Unmarshaler).UnmarshalJSON([]byte(tt.data))
+			require.ErrorContains(t, err, tt.wantErr)
+			expectErr := protojson.Unmarshal([]byte(tt.data), tt.msg.(proto.Message))
+			require.Error(t, expectErr)
+
+			require.NoError(t, runtime.UnmarshalOptions{DiscardUnknown: true}.Unmarshal([]byte(tt.data), tt.msg))
+			require.True(t, proto.Equal(tt.want, tt.msg.(proto.Message)), "want %v, got %v", tt.want, tt.msg)
+		})
+	}
+	for _, data := range []string{`{"x":[}`, `{"x":"\q"}`, `{"x":{"a" 1}}`, `{"x":tru}`, `{"x":[1 2]}`} {
+		err := runtime.UnmarshalOptions{DiscardUnknown: true}.Unmarshal([]byte(data), &pb.Message{})
+		require.Error(t, err, data)
+	}
+}
+
+func TestUnmarshalJSON_Error(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		msg  json.Unmarshaler
+		want string
+		path string
+	}{
+		{
+			name: "map",
+			data: "{\n \"numbers\": {\n  \"12\": {\"u32\": \"x\"}\n }\n}",
+			msg:  &pb.Map{},
+			want: `pb.Map.numbers["12"].u32: expected number, got string at line 3 column 17`,
+			path: `pb.Map.numbers["12"].u32`,
+		},
+		{
+			name: "map string key",
+			data: `{"strings":{"a\"b":{"bytes":"!"}}}`,
+			msg:  &pb.Map{},
+			want: `pb.Map.strings["a\"b"].bytes: invalid base64 value at line 1 column 29`,
+			path: `pb.Map.strings["a\"b"].bytes`,
+		},
+		{
+			name: "map key",
+			data: `{"numbers":{"x":{}}}`,
+			msg:  &pb.Map{},
+			want: `pb.Map.numbers: invalid map key "x" at line 1 column 17`,
+			path: `pb.Map.numbers`,
+		},
+		{
+			name: "list",
+			data: `{"arrays":[{},{"numbers":[{},{"i32":1.5}]}]}`,
+			msg:  &pb.Array{},
+			want: `pb.Array.arrays[1].numbers[1].i32: invalid int32 value 1.5 at line 1 column 37`,
+			path: `pb.Array.arrays[1].numbers[1].i32`,
+		},
+		{
+			name: "list syntax",
+			data: `{"u32s":[1 2]}`,
+			msg:  &pb.Array{},
+			want: `pb.Array.u32s: expected ',' or ']', got number at line 1 column 12`,
+			path: `pb.Array.u32s`,
+		},
+		{
+			name: "unknown",
+			data: `{"number":{"x":1}}`,
+			msg:  &pb.Message{},
+			want: `pb.Message.number: unknown field "x" in pb.Number at line 1 column 16`,
+			path: `pb.Message.number`,
+		},
+		{
+			name: "message",
+			data: `{"type":"BOOL" "bool":{}}`,
+			msg:  &pb.Message{},
+			want: `pb.Message: expected ',' or '}', got string at line 1 column 16`,
+			path: `pb.Message`,
+		},
+		{
+			name: "any",
+			data: "{\"any\":{\"@type\":\"type.googleapis.com/pb.Number\",\n \"u32\":\"x\"}}",
+			msg:  &pb.Anys{},
+			want: `pb.Anys.any.u32: expected number, got string at line 2 column 8`,
+			path: `pb.Anys.any.u32`,
+		},
+		{
+			name: "any unknown",
+			data: "{\"any\":{\"u32\":1,\n \"@type\":\"type.googleapis.com/pb.Number\", \"x\" : 1}}",
+			msg:  &pb.Anys{},
+			want: `pb.Anys.any: unknown field "x" in pb.Number at line 2 column 49`,
+			path: `pb.Anys.any`,
+		},
+		{
+			name: "any value",
+			data: "{\"any\":{\"@type\":\"type.googleapis.com/google.protobuf.Duration\",\n \"value\":\"1\"}}",
+			msg:  &pb.Anys{},
+			want: `pb.Anys.any.value: invalid google.protobuf.Duration value "1" at line 2 column 10`,
+			path: `pb.Anys.any.value`,
+		},
+		{
+			name: "nested any",
+			data: `{"anys":[{"@type":"type.googleapis.com/google.protobuf.Any",` +
+				`"value":{"@type":"type.googleapis.com/pb.Number","u32":true}}]}`,
+			msg:  &pb.Anys{},
+			want: `pb.Anys.anys[0].value.u32: expected number, got bool at line 1 column 116`,
+			path: `pb.Anys.anys[0].value.u32`,
+		},
+		{
+			name: "any type",
+			data: `{"any":{"u32":1}}`,
+			msg:  &pb.Anys{},
+			want: `pb.Anys.any: google.protobuf.Any: missing @type field at line 1 column 8`,
+			path: `pb.Anys.any`,
+		},
+		{
+			name: "invalid literal",
+			data: `{"struct":{"a":[1,tru]}}`,
+			msg:  &pb.Structs{},
+			want: `pb.Structs.struct: expected bool, got invalid token "tru" at line 1 column 19`,
+			path: `pb.Structs.struct`,
+		},
+		{
+			name: "trailing data",
+			data: `{} {}`,
+			msg:  &pb.Message{},
+			want: `json: expected end of input, got '{' at line 1 column 4`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.UnmarshalJSON([]byte(tt.data))
+			require.EqualError(t, err, tt.want)
+			var e *runtime.Error
+			require.ErrorAs(t, err, &e)
+			require.Equal(t, tt.path, e.Path)
+
+			err = runtime.UnmarshalOptions{}.Unmarshal([]byte(tt.data), tt.msg.(runtime.Unmarshaler))
+			require.EqualError(t, err, tt.want)
+		})
+	}
+}
+
+// TestUnmarshalJSON_Depth 嵌套过深的输入返回错误, 不会耗尽栈空间
+func TestUnmarshalJSON_Depth(t *testing.T) {
+	nested := func(n int) []byte {
+		return []byte(strings.Repeat(`{"arrays":[`, n) + "{}" + strings.Repeat("]}", n))
+	}
+	// 每层 Array 是一个对象加一个数组, 4999 层加最内层的 {} 共 9999 层
+	got := &pb.Array{}
+	require.NoError(t, got.UnmarshalJSON(nested(4999)))
+	require.Len(t, got.Arrays, 1)
+
+	for _, n := range []int{5000, 2e6} {
+		err := new(pb.Array).UnmarshalJSON(nested(n))
+		var e *runtime.Error
+		require.ErrorAs(t, err, &e)
+		require.Equal(t, "exceeded max nesting depth 10000", e.Msg)
+		require.Equal(t, 5000*len(`{"arrays":[`), e.Offset)
+		require.True(t, strings.HasPrefix(e.Path, "pb.Array.arrays[0].arrays[0]."), e.Path)
+	}
+
+	// Struct, Value, ListValue 每层只需一个字节, Any 中的值与外层共用同一个限制
+	for _, tt := range []struct {
+		data string
+		msg  json.Unmarshaler
+	}{
+		{data: `{"value":` + strings.Repeat("[", 20e6), msg: &pb.Structs{}},
+		{data: `{"struct":{"a":` + strings.Repeat(`{"a":`, 20e6), msg: &pb.Structs{}},
+		{data: `{"list":` + strings.Repeat("[", 20e6), msg: &pb.Structs{}},
+		{data: `{"any":{"@type":"type.googleapis.com/google.protobuf.ListValue","value":` + strings.Repeat("[", 20e6), msg: &pb.Anys{}},
+		{data: `{"anys":[` + strings.Repeat(`{"@type":"type.googleapis.com/google.protobuf.Any","value":`, 1e6), msg: &pb.Anys{}},
+	} {
+		err := tt.msg.UnmarshalJSON([]byte(tt.data))
+		var e *runtime.Error
+		require.ErrorAs(t, err, &e)
+		require.Contains(t, e.Msg, "exceeded max nesting depth 10000")
+	}
+}
+
+func TestUnmarshalJSON_Duplicate(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		msg     runtime.Unmarshaler
+		want    proto.Message
+		wantErr string
+	}{
+		{
+			name:    "field",
+			data:    `{"u32":1,"u32":2}`,
+			msg:     &pb.Number{},
+			want:    &pb.Number{U32: 2},
+			wantErr: `pb.Number: duplicate field "u32" in pb.Number at line 1 column 16`,
+		},
+		{
+			name:    "json name and proto name",
+			data:    `{"numberX":{"u32":1},"number_x":null}`,
+			msg:     &pb.Oneof{},
+			want:    &pb.Oneof{NumberX: &pb.Number{U32: 1}},
+			wantErr: `pb.Oneof: duplicate field "number_x" in pb.Oneof at line 1 column 33`,
+		},
+		{
+			name:    "nested",
+			data:    `{"number":{"f64":1,"f64":"NaN"}}`,
+			msg:     &pb.Message{},
+			want:    &pb.Message{Number: &pb.Number{F64: math.NaN()}},
+			wantErr: `pb.Message.number: duplicate field "f64" in pb.Number`,
+		},
+		{
+			name:    "list replaced",
+			data:    `{"u32s":[1,2],"strs":["a"],"u32s":[3]}`,
+			msg:     &pb.Array{},
+			want:    &pb.Array{U32S: []uint32{3}, Strs: []string{"a"}},
+			wantErr: `duplicate field "u32s" in pb.Array`,
+		},
+		{
+			name:    "map replaced",
+			data:    `{"strings":{"a":{}},"strings":{"b":{"str":"b"}}}`,
+			msg:     &pb.Map{},
+			want:    &pb.Map{Strings: map[string]*pb.String{"b": {Str: "b"}}},
+			wantErr: `duplicate field "strings" in pb.Map`,
+		},
+		{
+			name:    "map key",
+			data:    `{"u32":{"1":1,"01":2}}`,
+			msg:     &pb.NumberMap{},
+			want:    &pb.NumberMap{U32: map[uint32]uint32{1: 2}},
+			wantErr: `pb.NumberMap.u32["1"]: duplicate map key "01" at line 1 column 20`,
+		},
+		{
+			name:    "enum map key",
+			data:    `{"map":{"a":"BOOL","a":"STRING"}}`,
+			msg:     &pb.Enums{},
+			want:    &pb.Enums{Map: map[string]pb.Type{"a": pb.Type_STRING}},
+			wantErr: `pb.Enums.map["a"]: duplicate map key "a" at line 1 column 24`,
+		},
+		{
+			name:    "struct key",
+			data:    `{"struct":{"a":1,"a":2}}`,
+			msg:     &pb.Structs{},
+			want:    &pb.Structs{Struct: &structpb.Struct{Fields: map[string]*structpb.Value{"a": structpb.NewNumberValue(2)}}},
+			wantErr: `pb.Structs.struct: duplicate map key "a" at line 1 column 22`,
+		},
+		{
+			name:    "oneof",
+			data:    `{"str":"a","number":{},"u32":1}`,
+			msg:     &pb.Oneof{},
+			want:    &pb.Oneof{Number: &pb.Number{}, Oneof: &pb.Oneof_U32{U32: 1}},
+			wantErr: `pb.Oneof: oneof pb.Oneof.oneof is already set, got field "u32" at line 1 column 30`,
+		},
+		{
+			name:    "second oneof",
+			data:    `{"s":"a","t":"b","b":{}}`,
+			msg:     &pb.OneofFirst{},
+			want:    &pb.OneofFirst{First: &pb.OneofFirst_S{S: "a"}, Second: &pb.OneofFirst_B{B: &pb.Bool{}}},
+			wantErr: `oneof pb.OneofFirst.second is already set, got field "b"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.(json.Unmarshaler).UnmarshalJSON([]byte(tt.data))
+			require.ErrorContains(t, err, tt.wantErr)
+			require.Error(t, protojson.Unmarshal([]byte(tt.data), tt.msg.(proto.Message)))
+
+			require.NoError(t, runtime.UnmarshalOptions{AllowDuplicates: true}.Unmarshal([]byte(tt.data), tt.msg))
+			require.True(t, proto.Equal(tt.want, tt.msg.(proto.Message)), "want %v, got %v", tt.want, tt.msg)
+		})
+	}
+
+	// null 的 oneof 成员不算设置, 与 protojson 一致
+	for _, data := range []string{`{"str":null,"u32":1}`, `{"u32":1,"message":null}`} {
+		got := &pb.Oneof{}
+		require.NoError(t, got.UnmarshalJSON([]byte(data)), data)
+		require.True(t, proto.Equal(&pb.Oneof{Oneof: &pb.Oneof_U32{U32: 1}}, got), data)
+		require.NoError(t, protojson.Unmarshal([]byte(data), got), data)
+	}
+}
+
+func TestOneof_UnmarshalJSON(t *testing.T) {
+	got := &pb.Oneof{}
+	require.NoError(t, got.UnmarshalJSON([]byte(`{"number_x":{"u32":1},"str":"s"}`)))
+	require.True(t, proto.Equal(&pb.Oneof{NumberX: &pb.Number{U32: 1}, Oneof: &pb.Oneof_Str{Str: "s"}}, got))
+}
+
+func TestUnsafeTest_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    *pb.UnsafeTest
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "string",
+			args: &pb.UnsafeTest{Sub: &pb.UnsafeTest_Sub1_{Sub1: &pb.UnsafeTest_Sub1{S: "a\"b\\c\n\t\x01</>"}}},
+			want: `{"sub1":{"s":"a\"b\\c\n\t\u0001</>"}}`,
+		},
+		{
+			name: "list",
+			args: &pb.UnsafeTest{Sub: &pb.UnsafeTest_Sub2_{Sub2: &pb.UnsafeTest_Sub2{S: []string{`"`, "\r"}}}},
+			want: `{"sub2":{"s":["\"","\r"]}}`,
+		},
+		{
+			name: "map key",
+			args: &pb.UnsafeTest{Sub: &pb.UnsafeTest_Sub3_{Sub3: &pb.UnsafeTest_Sub3{Foo: map[string]*pb.UnsafeTest_Sub2{
+				"k\"\\\n": {S: []string{"v\""}},
+			}}}},
+			want: `{"sub3":{"foo":{"k\"\\\n":{"s":["v\""]}}}}`,
+		},
+		{
+			name: "oneof",
+			args: &pb.UnsafeTest{Sub: &pb.UnsafeTest_Sub4_{Sub4: &pb.UnsafeTest_Sub4{Foo: &pb.UnsafeTest_Sub4_S{S: " \x7f"}}}},
+			want: "{\"sub4\":{\"s\":\" \x7f\"}}",
+		},
+		{
+			name:    "invalid utf8",
+			args:    &pb.UnsafeTest{Sub: &pb.UnsafeTest_Sub1_{Sub1: &pb.UnsafeTest_Sub1{S: "\xff"}}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid utf8 map key",
+			args:    &pb.UnsafeTest{Sub: &pb.UnsafeTest_Sub3_{Sub3: &pb.UnsafeTest_Sub3{Foo: map[string]*pb.UnsafeTest_Sub2{"\xff": {}}}}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr {
+				_, err := tt.args.MarshalJSON()
+				require.Error(t, err)
+				return
+			}
+			Assert(t, tt.args, tt.want)
+			require.True(t, json.Valid([]byte(tt.want)))
+			AssertRoundTrip(t, tt.args)
+		})
+	}
+}
+
+func TestNumber_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		args *pb.Number
+		want string
+	}{
+		{
+			name: "max",
+			args: &pb.Number{
+				U32: math.MaxUint32, U64: math.MaxUint64, S32: math.MaxInt32, S64: math.MaxInt64,
+				Uf32: math.MaxUint32, Uf64: math.MaxUint64, Sf32: math.MaxInt32, Sf64: math.MaxInt64,
+				I32: math.MaxInt32, I64: math.MaxInt64,
+			},
+			want: `{"u32":4294967295,"u64":"18446744073709551615","s32":2147483647,"s64":"9223372036854775807",` +
+				`"uf32":4294967295,"uf64":"18446744073709551615","sf32":2147483647,"sf64":"9223372036854775807",` +
+				`"i32":2147483647,"i64":"9223372036854775807"}`,
+		},
+		{
+			name: "min",
+			args: &pb.Number{
+				S32: math.MinInt32, S64: math.MinInt64, Sf32: math.MinInt32, Sf64: math.MinInt64,
+				I32: math.MinInt32, I64: math.MinInt64,
 			},
+			want: `{"s32":-2147483648,"s64":"-9223372036854775808","sf32":-2147483648,"sf64":"-9223372036854775808",` +
+				`"i32":-2147483648,"i64":"-9223372036854775808"}`,
+		},
+		{
+			name: "negative one",
+			args: &pb.Number{S32: -1, S64: -1, Sf32: -1, Sf64: -1, I32: -1, I64: -1},
+			want: `{"s32":-1,"s64":"-1","sf32":-1,"sf64":"-1","i32":-1,"i64":"-1"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Assert(t, tt.args, tt.want)
+			AssertRoundTrip(t, tt.args)
+		})
+	}
+}
+
+func TestNumber_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    *pb.Number
+		wantErr string
+	}{
+		{
+			name: "quoted",
+			data: `{"u64":"18446744073709551615","s64":"-9223372036854775808","uf64":"1","sf64":"-1","i64":"0"}`,
+			want: &pb.Number{U64: math.MaxUint64, S64: math.MinInt64, Uf64: 1, Sf64: -1},
+		},
+		{
+			name: "bare",
+			data: `{"u64":18446744073709551615,"s64":-9223372036854775808,"uf64":1,"sf64":-1,"i64":0}`,
+			want: &pb.Number{U64: math.MaxUint64, S64: math.MinInt64, Uf64: 1, Sf64: -1},
+		},
+		{name: "overflow", data: `{"u64":"18446744073709551616"}`, wantErr: `pb.Number.u64: uint64 value 18446744073709551616 out of range`},
+		{name: "negative unsigned", data: `{"uf64":"-1"}`, wantErr: `pb.Number.uf64: uint64 value -1 out of range`},
+		{name: "not a number", data: `{"i64":"1a"}`, wantErr: `pb.Number.i64: expected number, got string`},
+		{
+			name: "lenient",
+			data: `{"u32":"1","u64":1e2,"s32":-1.0,"s64":"-2e3","uf32":4.294967295e9,"uf64":"0.5e1",` +
+				`"sf32":"-2147483648","sf64":1E0,"i32":10e-1,"i64":"9.223372036854775807e18","f64":"Infinity","f32":"NaN"}`,
+			want: &pb.Number{U32: 1, U64: 100, S32: -1, S64: -2000, Uf32: math.MaxUint32, Uf64: 5,
+				Sf32: math.MinInt32, Sf64: 1, I32: 1, I64: math.MaxInt64, F64: math.Inf(1), F32: float32(math.NaN())},
 		},
+		{name: "fraction", data: `{"i32":1.5}`, wantErr: `pb.Number.i32: invalid int32 value 1.5`},
+		{name: "int32 range", data: `{"s32":"2147483648"}`, wantErr: `pb.Number.s32: int32 value 2147483648 out of range`},
+		{name: "uint32 range", data: `{"uf32":1e10}`, wantErr: `pb.Number.uf32: uint32 value 1e10 out of range`},
+		{name: "negative uint", data: `{"u64":"-1"}`, wantErr: `pb.Number.u64: uint64 value -1 out of range`},
+		{name: "float range", data: `{"f32":1e39}`, wantErr: `pb.Number.f32: float32 value 1e39 out of range`},
+		{name: "not number", data: `{"f64":"1 "}`, wantErr: `pb.Number.f64: expected number, got string`},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			Asserts(t, tt.args, tt.want)
+			got := &pb.Number{}
+			err := got.UnmarshalJSON([]byte(tt.data))
+			expect := &pb.Number{}
+			expectErr := protojson.Unmarshal([]byte(tt.data), expect)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				require.Error(t, expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, proto.Equal(tt.want, got), "want %v, got %v", tt.want, got)
+			require.NoError(t, expectErr)
+			require.True(t, proto.Equal(expect, got), "protojson %v", expect)
 		})
 	}
 }
+
+func TestNumberList_MarshalJSON(t *testing.T) {
+	args := &pb.NumberList{
+		U32:  []uint32{0, math.MaxUint32},
+		U64:  []uint64{0, math.MaxUint64},
+		S32:  []int32{math.MinInt32, math.MaxInt32},
+		S64:  []int64{math.MinInt64, math.MaxInt64},
+		Uf32: []uint32{0, math.MaxUint32},
+		Uf64: []uint64{0, math.MaxUint64},
+		Sf32: []int32{math.MinInt32, math.MaxInt32},
+		Sf64: []int64{math.MinInt64, math.MaxInt64},
+		I32:  []int32{math.MinInt32, math.MaxInt32},
+		I64:  []int64{math.MinInt64, math.MaxInt64},
+	}
+	want := `{"u32":[0,4294967295],"u64":["0","18446744073709551615"],` +
+		`"s32":[-2147483648,2147483647],"s64":["-9223372036854775808","9223372036854775807"],` +
+		`"uf32":[0,4294967295],"uf64":["0","18446744073709551615"],` +
+		`"sf32":[-2147483648,2147483647],"sf64":["-9223372036854775808","9223372036854775807"],` +
+		`"i32":[-2147483648,2147483647],"i64":["-9223372036854775808","9223372036854775807"]}`
+	Assert(t, args, want)
+	AssertRoundTrip(t, args)
+}
+
+func TestNumber_MarshalJSON_Float(t *testing.T) {
+	tests := []struct {
+		name string
+		args *pb.Number
+		want string
+	}{
+		{name: "fraction", args: &pb.Number{F64: 0.1, F32: 0.1}, want: `{"f64":0.1,"f32":0.1}`},
+		{name: "large", args: &pb.Number{F64: 1e300, F32: -1e30}, want: `{"f64":1e+300,"f32":-1e+30}`},
+		{name: "small", args: &pb.Number{F64: 1e-7, F32: 1.5e-10}, want: `{"f64":1e-7,"f32":1.5e-10}`},
+		{name: "infinity", args: &pb.Number{F64: math.Inf(1), F32: float32(math.Inf(-1))}, want: `{"f64":"Infinity","f32":"-Infinity"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Assert(t, tt.args, tt.want)
+			AssertRoundTrip(t, tt.args)
+		})
+	}
+}
+
+func TestNumberList_MarshalJSON_NaN(t *testing.T) {
+	args := &pb.NumberList{
+		F64: []float64{math.NaN(), math.Inf(1), math.Inf(-1), 1e21},
+		F32: []float32{float32(math.NaN()), 1e21},
+	}
+	Assert(t, args, `{"f64":["NaN","Infinity","-Infinity",1e+21],"f32":["NaN",1e+21]}`)
+	Assert(t, &pb.NumberMap{F64: map[string]float64{"nan": math.NaN()}}, `{"f64":{"nan":"NaN"}}`)
+
+	got := &pb.NumberList{}
+	require.NoError(t, got.UnmarshalJSON([]byte(`{"f64":["NaN","Infinity","-Infinity",1e+21],"f32":["NaN",1e+21]}`)))
+	require.True(t, math.IsNaN(got.F64[0]))
+	require.Equal(t, []float64{math.Inf(1), math.Inf(-1), 1e21}, got.F64[1:])
+	require.True(t, math.IsNaN(float64(got.F32[0])))
+	require.Equal(t, float32(1e21), got.F32[1])
+}
+
+func TestNumberMap_MarshalJSON_Deterministic(t *testing.T) {
+	args := &pb.NumberMap{
+		U64: map[uint64]uint64{10: 1, 2: 2, math.MaxUint64: 3, 0: 4},
+		S32: map[int32]int32{-1: 1, 1: 2, math.MinInt32: 3, 0: 4},
+		F64: map[string]float64{"b": 1, "a": 2, "B": 3, "": 4, "ab": 5},
+	}
+	want := `{"u64":{"0":"4","2":"2","10":"1","18446744073709551615":"3"},` +
+		`"s32":{"-2147483648":3,"-1":1,"0":4,"1":2},` +
+		`"f64":{"":4,"B":3,"a":2,"ab":5,"b":1}}`
+	for i := 0; i < 10; i++ {
+		Assert(t, args, want)
+	}
+	Assert(t, &pb.Map{Bools: map[bool]*pb.Bool{true: {B: true}, false: {}}}, `{"bools":{"false":{"b":false},"true":{"b":true}}}`)
+	Assert(t, &pb.Map{Bools: map[bool]*pb.Bool{true: {}}}, `{"bools":{"true":{"b":false}}}`)
+}
+
+func TestNumberMap_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		args *pb.NumberMap
+		want string
+	}{
+		{name: "u32 min", args: &pb.NumberMap{U32: map[uint32]uint32{0: math.MaxUint32}}, want: `{"u32":{"0":4294967295}}`},
+		{name: "u32 max", args: &pb.NumberMap{U32: map[uint32]uint32{math.MaxUint32: 0}}, want: `{"u32":{"4294967295":0}}`},
+		{name: "u64 min", args: &pb.NumberMap{U64: map[uint64]uint64{0: math.MaxUint64}}, want: `{"u64":{"0":"18446744073709551615"}}`},
+		{name: "u64 max", args: &pb.NumberMap{U64: map[uint64]uint64{math.MaxUint64: 0}}, want: `{"u64":{"18446744073709551615":"0"}}`},
+		{name: "s32 min", args: &pb.NumberMap{S32: map[int32]int32{math.MinInt32: math.MaxInt32}}, want: `{"s32":{"-2147483648":2147483647}}`},
+		{name: "s32 max", args: &pb.NumberMap{S32: map[int32]int32{math.MaxInt32: math.MinInt32}}, want: `{"s32":{"2147483647":-2147483648}}`},
+		{name: "s64 min", args: &pb.NumberMap{S64: map[int64]int64{math.MinInt64: math.MaxInt64}}, want: `{"s64":{"-9223372036854775808":"9223372036854775807"}}`},
+		{name: "s64 max", args: &pb.NumberMap{S64: map[int64]int64{math.MaxInt64: math.MinInt64}}, want: `{"s64":{"9223372036854775807":"-9223372036854775808"}}`},
+		{name: "uf32 min", args: &pb.NumberMap{Uf32: map[uint32]uint32{0: math.MaxUint32}}, want: `{"uf32":{"0":4294967295}}`},
+		{name: "uf32 max", args: &pb.NumberMap{Uf32: map[uint32]uint32{math.MaxUint32: 0}}, want: `{"uf32":{"4294967295":0}}`},
+		{name: "uf64 min", args: &pb.NumberMap{Uf64: map[uint64]uint64{0: math.MaxUint64}}, want: `{"uf64":{"0":"18446744073709551615"}}`},
+		{name: "uf64 max", args: &pb.NumberMap{Uf64: map[uint64]uint64{math.MaxUint64: 0}}, want: `{"uf64":{"18446744073709551615":"0"}}`},
+		{name: "sf32 min", args: &pb.NumberMap{Sf32: map[int32]int32{math.MinInt32: math.MaxInt32}}, want: `{"sf32":{"-2147483648":2147483647}}`},
+		{name: "sf32 max", args: &pb.NumberMap{Sf32: map[int32]int32{math.MaxInt32: math.MinInt32}}, want: `{"sf32":{"2147483647":-2147483648}}`},
+		{name: "sf64 min", args: &pb.NumberMap{Sf64: map[int64]int64{math.MinInt64: math.MaxInt64}}, want: `{"sf64":{"-9223372036854775808":"9223372036854775807"}}`},
+		{name: "sf64 max", args: &pb.NumberMap{Sf64: map[int64]int64{math.MaxInt64: math.MinInt64}}, want: `{"sf64":{"9223372036854775807":"-9223372036854775808"}}`},
+		{name: "i32 min", args: &pb.NumberMap{I32: map[int32]int32{math.MinInt32: math.MaxInt32}}, want: `{"i32":{"-2147483648":2147483647}}`},
+		{name: "i32 max", args: &pb.NumberMap{I32: map[int32]int32{math.MaxInt32: math.MinInt32}}, want: `{"i32":{"2147483647":-2147483648}}`},
+		{name: "i64 min", args: &pb.NumberMap{I64: map[int64]int64{math.MinInt64: math.MaxInt64}}, want: `{"i64":{"-9223372036854775808":"9223372036854775807"}}`},
+		{name: "i64 max", args: &pb.NumberMap{I64: map[int64]int64{math.MaxInt64: math.MinInt64}}, want: `{"i64":{"9223372036854775807":"-9223372036854775808"}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Assert(t, tt.args, tt.want)
+			AssertRoundTrip(t, tt.args)
+		})
+	}
+}
+
+func TestNumberMap_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    *pb.NumberMap
+		wantErr string
+	}{
+		{
+			name: "plus signed key",
+			data: `{"s32":{"+1":1},"s64":{"+2":"2"},"sf32":{"+0":0},"sf64":{"-3":"3"},"i32":{"+2147483647":1},"i64":{"+4":"4"}}`,
+			want: &pb.NumberMap{S32: map[int32]int32{1: 1}, S64: map[int64]int64{2: 2}, Sf32: map[int32]int32{0: 0},
+				Sf64: map[int64]int64{-3: 3}, I32: map[int32]int32{math.MaxInt32: 1}, I64: map[int64]int64{4: 4}},
+		},
+		{name: "plus unsigned key", data: `{"u32":{"+1":1}}`, wantErr: `pb.NumberMap.u32: invalid map key "+1"`},
+		{name: "plus uint64 key", data: `{"uf64":{"+1":"1"}}`, wantErr: `pb.NumberMap.uf64: invalid map key "+1"`},
+		{name: "double sign key", data: `{"i32":{"+-1":1}}`, wantErr: `pb.NumberMap.i32: invalid map key "+-1"`},
+		{name: "exponent key", data: `{"i64":{"1e2":"1"}}`, wantErr: `pb.NumberMap.i64: invalid map key "1e2"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := &pb.NumberMap{}
+			err := got.UnmarshalJSON([]byte(tt.data))
+			expect := &pb.NumberMap{}
+			expectErr := protojson.Unmarshal([]byte(tt.data), expect)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				require.Error(t, expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, proto.Equal(tt.want, got), "want %v, got %v", tt.want, got)
+			require.NoError(t, expectErr)
+			require.True(t, proto.Equal(expect, got), "protojson %v", expect)
+		})
+	}
+}
+
+func TestEnums_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		args *pb.Enums
+		want string
+	}{
+		{name: "empty", args: &pb.Enums{}, want: `{"type":"NUMBER"}`},
+		{
+			name: "known",
+			args: &pb.Enums{Type: pb.Type_BOOL, Types: []pb.Type{pb.Type_STRING}, Map: map[string]pb.Type{"a": pb.Type_BOOL}},
+			want: `{"type":"BOOL","types":["STRING"],"map":{"a":"BOOL"}}`,
+		},
+		{
+			name: "unknown",
+			args: &pb.Enums{Type: 7, Types: []pb.Type{pb.Type_STRING, -1}, Map: map[string]pb.Type{"a": 9}},
+			want: `{"type":7,"types":["STRING",-1],"map":{"a":9}}`,
+		},
+		{
+			name: "nulls",
+			args: &pb.Enums{Nulls: []structpb.NullValue{structpb.NullValue_NULL_VALUE, structpb.NullValue_NULL_VALUE}},
+			want: `{"type":"NUMBER","nulls":[null,null]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Assert(t, tt.args, tt.want)
+			AssertRoundTrip(t, tt.args)
+		})
+	}
+}
+
+func TestFieldOrder_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		args proto.Message
+		want string
+	}{
+		{name: "field order empty", args: &pb.FieldOrder{}, want: `{}`},
+		{name: "field order last", args: &pb.FieldOrder{A: 1}, want: `{"a":"1"}`},
+		{name: "field order first", args: &pb.FieldOrder{E: 5}, want: `{"e":5}`},
+		{
+			name: "field order all",
+			args: &pb.FieldOrder{E: 5, C: "c", D: proto.Bool(false), List: []uint32{2}, A: 1},
+			want: `{"e":5,"c":"c","d":false,"list":[2],"a":"1"}`,
+		},
+		{name: "field order gap", args: &pb.FieldOrder{C: "c", A: 1}, want: `{"c":"c","a":"1"}`},
+		{name: "oneof first empty", args: &pb.OneofFirst{}, want: `{}`},
+		{name: "oneof first only", args: &pb.OneofFirst{First: &pb.OneofFirst_U{U: 4}}, want: `{"u":4}`},
+		{name: "oneof second only", args: &pb.OneofFirst{Second: &pb.OneofFirst_T{T: "t"}}, want: `{"t":"t"}`},
+		{
+			name: "oneof first and map",
+			args: &pb.OneofFirst{First: &pb.OneofFirst_S{S: "s"}, Map: map[string]uint32{"k": 1}},
+			want: `{"s":"s","map":{"k":1}}`,
+		},
+		{
+			name: "oneof all",
+			args: &pb.OneofFirst{
+				First:  &pb.OneofFirst_S{S: "s"},
+				Map:    map[string]uint32{"k": 1},
+				Bool:   &pb.Bool{B: true},
+				Second: &pb.OneofFirst_B{B: &pb.Bool{B: true}},
+			},
+			want: `{"s":"s","map":{"k":1},"bool":{"b":true},"b":{"b":true}}`,
+		},
+		{name: "single empty", args: &pb.Single{}, want: `{}`},
+		{name: "single", args: &pb.Single{S: "s"}, want: `{"s":"s"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Assert(t, tt.args.(json.Marshaler), tt.want)
+			expect, err := protojson.Marshal(tt.args)
+			require.NoError(t, err)
+			require.JSONEq(t, string(expect), tt.want)
+			AssertRoundTrip(t, tt.args)
+		})
+	}
+}
+
+func TestWellKnown_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    *pb.WellKnown
+		want    string
+		wantErr bool
+	}{
+		{name: "empty", args: &pb.WellKnown{}, want: `{}`},
+		{
+			name: "time",
+			args: &pb.WellKnown{
+				Timestamp:  &timestamppb.Timestamp{Seconds: 1700000000, Nanos: 120000000},
+				Duration:   &durationpb.Duration{Seconds: -1, Nanos: -500000000},
+				Timestamps: []*timestamppb.Timestamp{{}, nil},
+				Durations:  map[string]*durationpb.Duration{"a": {Seconds: 3}, "b": nil},
+				Time:       &pb.WellKnown_After{After: &durationpb.Duration{Nanos: 1000}},
+			},
+			want: `{"timestamp":"2023-11-14T22:13:20.120Z","duration":"-1.500s",` +
+				`"timestamps":["1970-01-01T00:00:00Z","1970-01-01T00:00:00Z"],"durations":{"a":"3s","b":"0s"},"after":"0.000001s"}`,
+		},
+		{
+			name: "mask and empty",
+			args: &pb.WellKnown{
+				Mask:    &fieldmaskpb.FieldMask{Paths: []string{"number_x", "string.str"}},
+				Empty:   &emptypb.Empty{},
+				Masks:   []*fieldmaskpb.FieldMask{{}, nil},
+				Empties: map[string]*emptypb.Empty{"a": {}, "b": nil},
+			},
+			want: `{"mask":"numberX,string.str","empty":{},"masks":["",""],"empties":{"a":{},"b":{}}}`,
+		},
+		{name: "mask irreversible", args: &pb.WellKnown{Mask: &fieldmaskpb.FieldMask{Paths: []string{"numberX"}}}, wantErr: true},
+		{name: "timestamp out of range", args: &pb.WellKnown{Timestamp: &timestamppb.Timestamp{Seconds: -62135596801}}, wantErr: true},
+		{name: "duration out of range", args: &pb.WellKnown{Time: &pb.WellKnown_After{After: &durationpb.Duration{Seconds: 315576000001}}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, expectErr := protojson.Marshal(tt.args)
+			if tt.wantErr {
+				_, err := tt.args.MarshalJSON()
+				require.Error(t, err)
+				require.Error(t, expectErr)
+				return
+			}
+			Assert(t, tt.args, tt.want)
+			expect, err := protojson.Marshal(tt.args)
+			require.NoError(t, err)
+			require.JSONEq(t, string(expect), tt.want)
+		})
+	}
+}
+
+func TestWellKnown_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    *pb.WellKnown
+		wantErr bool
+	}{
+		{
+			name: "time",
+			data: `{"timestamp":"2023-11-15T06:13:20.12+08:00","duration":"1.5s","timestamps":["1970-01-01T00:00:00Z"],` +
+				`"durations":{"a":"-3s"},"at":"1970-01-01T00:00:01Z"}`,
+			want: &pb.WellKnown{
+				Timestamp:  &timestamppb.Timestamp{Seconds: 1700000000, Nanos: 120000000},
+				Duration:   &durationpb.Duration{Seconds: 1, Nanos: 500000000},
+				Timestamps: []*timestamppb.Timestamp{{}},
+				Durations:  map[string]*durationpb.Duration{"a": {Seconds: -3}},
+				Time:       &pb.WellKnown_At{At: &timestamppb.Timestamp{Seconds: 1}},
+			},
+		},
+		{name: "null", data: `{"timestamp":null,"duration":null}`, want: &pb.WellKnown{}},
+		{
+			name: "mask and empty",
+			data: `{"mask":"numberX,string.str","empty":{},"masks":[""],"empties":{"a":{}}}`,
+			want: &pb.WellKnown{
+				Mask:    &fieldmaskpb.FieldMask{Paths: []string{"number_x", "string.str"}},
+				Empty:   &emptypb.Empty{},
+				Masks:   []*fieldmaskpb.FieldMask{{}},
+				Empties: map[string]*emptypb.Empty{"a": {}},
+			},
+		},
+		{name: "mask snake case", data: `{"mask":"number_x"}`, wantErr: true},
+		{name: "empty not object", data: `{"empty":[]}`, wantErr: true},
+		{name: "object timestamp", data: `{"timestamp":{"seconds":1}}`, wantErr: true},
+		{name: "bad duration", data: `{"duration":"1m"}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got pb.WellKnown
+			err := got.UnmarshalJSON([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Error(t, protojson.Unmarshal([]byte(tt.data), new(pb.WellKnown)))
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, proto.Equal(tt.want, &got), "got %v", &got)
+			AssertRoundTrip(t, tt.want)
+		})
+	}
+}
+
+func TestWrappers_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		args *pb.Wrappers
+		want string
+	}{
+		{name: "empty", args: &pb.Wrappers{}, want: `{}`},
+		{
+			name: "zero",
+			args: &pb.Wrappers{
+				F64: wrapperspb.Double(0), F32: wrapperspb.Float(0), I64: wrapperspb.Int64(0), U64: wrapperspb.UInt64(0),
+				I32: wrapperspb.Int32(0), U32: wrapperspb.UInt32(0), B: wrapperspb.Bool(false), Str: wrapperspb.String(""),
+				Bytes: wrapperspb.Bytes(nil),
+			},
+			want: `{"f64":0,"f32":0,"i64":"0","u64":"0","i32":0,"u32":0,"b":false,"str":"","bytes":""}`,
+		},
+		{
+			name: "values",
+			args: &pb.Wrappers{
+				F64: wrapperspb.Double(math.Inf(1)), F32: wrapperspb.Float(1.5), I64: wrapperspb.Int64(math.MinInt64),
+				U64: wrapperspb.UInt64(math.MaxUint64), I32: wrapperspb.Int32(-1), U32: wrapperspb.UInt32(1),
+				B: wrapperspb.Bool(true), Str: wrapperspb.String("a\"b"), Bytes: wrapperspb.Bytes([]byte("hi")),
+			},
+			want: `{"f64":"Infinity","f32":1.5,"i64":"-9223372036854775808","u64":"18446744073709551615","i32":-1,"u32":1,` +
+				`"b":true,"str":"a\"b","bytes":"aGk="}`,
+		},
+		{
+			name: "list map oneof",
+			args: &pb.Wrappers{
+				I64S:  []*wrapperspb.Int64Value{wrapperspb.Int64(1), nil},
+				Strs:  map[string]*wrapperspb.StringValue{"a": wrapperspb.String("x"), "b": nil},
+				Oneof: &pb.Wrappers_Ob{Ob: wrapperspb.Bool(false)},
+			},
+			want: `{"i64s":["1","0"],"strs":{"a":"x","b":""},"ob":false}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Assert(t, tt.args, tt.want)
+			expect, err := protojson.Marshal(tt.args)
+			require.NoError(t, err)
+			require.JSONEq(t, string(expect), tt.want)
+		})
+	}
+}
+
+func TestWrappers_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    *pb.Wrappers
+		wantErr bool
+	}{
+		{name: "null", data: `{"f64":null,"i64":null,"str":null}`, want: &pb.Wrappers{}},
+		{
+			name: "values",
+			data: `{"f64":"NaN","f32":1.5,"i64":"-1","u64":2,"i32":-3,"u32":4,"b":true,"str":"s","bytes":"aGk=",` +
+				`"i64s":["1",2],"strs":{"a":"x"},"of64":-1.5}`,
+			want: &pb.Wrappers{
+				F64: wrapperspb.Double(math.NaN()), F32: wrapperspb.Float(1.5), I64: wrapperspb.Int64(-1),
+				U64: wrapperspb.UInt64(2), I32: wrapperspb.Int32(-3), U32: wrapperspb.UInt32(4), B: wrapperspb.Bool(true),
+				Str: wrapperspb.String("s"), Bytes: wrapperspb.Bytes([]byte("hi")),
+				I64S: []*wrapperspb.Int64Value{wrapperspb.Int64(1), wrapperspb.Int64(2)},
+				Strs: map[string]*wrapperspb.StringValue{"a": wrapperspb.String("x")}, Oneof: &pb.Wrappers_Of64{Of64: wrapperspb.Double(-1.5)},
+			},
+		},
+		{name: "object", data: `{"i64":{"value":"1"}}`, wantErr: true},
+		{name: "null element", data: `{"i64s":[null]}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got pb.Wrappers
+			err := got.UnmarshalJSON([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Error(t, protojson.Unmarshal([]byte(tt.data), new(pb.Wrappers)))
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, proto.Equal(tt.want, &got), "got %v", &got)
+		})
+	}
+}
+
+func TestStructs_MarshalJSON(t *testing.T) {
+	meta, err := structpb.NewStruct(map[string]interface{}{
+		"name": "a<b", "n": 1.5, "ok": true, "nil": nil,
+		"list": []interface{}{1.0, "x", nil, map[string]interface{}{}}, "obj": map[string]interface{}{"z": 1.0, "a": false},
+	})
+	require.NoError(t, err)
+	tests := []struct {
+		name    string
+		args    *pb.Structs
+		want    string
+		wantErr bool
+	}{
+		{name: "empty", args: &pb.Structs{}, want: `{}`},
+		{
+			name: "struct",
+			args: &pb.Structs{Struct: meta},
+			want: `{"struct":{"list":[1,"x",null,{}],"n":1.5,"name":"a<b","nil":null,"obj":{"a":false,"z":1},"ok":true}}`,
+		},
+		{
+			name: "values",
+			args: &pb.Structs{
+				Struct:  &structpb.Struct{},
+				Value:   structpb.NewNullValue(),
+				List:    &structpb.ListValue{Values: []*structpb.Value{structpb.NewNumberValue(1e21), structpb.NewListValue(nil)}},
+				Values:  []*structpb.Value{structpb.NewBoolValue(false), structpb.NewStringValue("")},
+				Structs: map[string]*structpb.Struct{"b": nil, "a": {}},
+				Oneof:   &pb.Structs_Ov{Ov: structpb.NewNumberValue(-0.5)},
+			},
+			want: `{"struct":{},"value":null,"list":[1e+21,[]],"values":[false,""],"structs":{"a":{},"b":{}},"ov":-0.5}`,
+		},
+		{name: "value kind not set", args: &pb.Structs{Value: &structpb.Value{}}, wantErr: true},
+		{name: "value nan", args: &pb.Structs{Values: []*structpb.Value{structpb.NewNumberValue(math.NaN())}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, expectErr := protojson.Marshal(tt.args)
+			if tt.wantErr {
+				_, err := tt.args.MarshalJSON()
+				require.Error(t, err)
+				require.Error(t, expectErr)
+				return
+			}
+			Assert(t, tt.args, tt.want)
+			expect, err := protojson.Marshal(tt.args)
+			require.NoError(t, err)
+			require.JSONEq(t, string(expect), tt.want)
+		})
+	}
+}
+
+func TestStructs_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{name: "struct", data: `{"struct":{"a":[1,"x",null,{"b":{}}],"t":true,"f":false,"n":-1.5e3,"s":"é"}}`},
+		{name: "value null", data: `{"value":null,"ov":null}`},
+		{name: "value", data: `{"value":{"k":[]},"list":[[],{},null],"values":[null,1,"2"],"structs":{"a":{"x":null}}}`},
+		{name: "struct null", data: `{"struct":null,"list":null}`},
+		{name: "struct array", data: `{"struct":[]}`, wantErr: true},
+		{name: "list object", data: `{"list":{}}`, wantErr: true},
+		{name: "bad value", data: `{"value":nul}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got pb.Structs
+			err := got.UnmarshalJSON([]byte(tt.data))
+			expect := new(pb.Structs)
+			expectErr := protojson.Unmarshal([]byte(tt.data), expect)
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Error(t, expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, expectErr)
+			require.True(t, proto.Equal(expect, &got), "got %v, want %v", &got, expect)
+			AssertRoundTrip(t, &got)
+		})
+	}
+}
+
+func MustAny(t *testing.T, m proto.Message) *anypb.Any {
+	t.Helper()
+	a, err := anypb.New(m)
+	require.NoError(t, err)
+	return a
+}
+
+func TestAnys_MarshalJSON(t *testing.T) {
+	meta, err := structpb.NewStruct(map[string]interface{}{"k": "v"})
+	require.NoError(t, err)
+	tests := []struct {
+		name    string
+		args    *pb.Anys
+		want    string
+		wantErr bool
+	}{
+		{name: "empty", args: &pb.Anys{Any: &anypb.Any{}}, want: `{"any":{}}`},
+		{
+			name: "message",
+			args: &pb.Anys{Any: MustAny(t, &pb.Number{U32: 1, I64: -2})},
+			want: `{"any":{"@type":"type.googleapis.com/pb.Number","u32":1,"i64":"-2"}}`,
+		},
+		{
+			name: "empty message",
+			args: &pb.Anys{Any: MustAny(t, &pb.Empty{})},
+			want: `{"any":{"@type":"type.googleapis.com/pb.Empty"}}`,
+		},
+		{
+			name: "well known",
+			args: &pb.Anys{
+				Anys: []*anypb.Any{
+					MustAny(t, &timestamppb.Timestamp{Seconds: 1}),
+					MustAny(t, wrapperspb.Int64(3)),
+					MustAny(t, meta),
+					nil,
+				},
+				Map: map[string]*anypb.Any{"a": MustAny(t, MustAny(t, &pb.String{Str: "s"}))},
+			},
+			want: `{"anys":[{"@type":"type.googleapis.com/google.protobuf.Timestamp","value":"1970-01-01T00:00:01Z"},` +
+				`{"@type":"type.googleapis.com/google.protobuf.Int64Value","value":"3"},` +
+				`{"@type":"type.googleapis.com/google.protobuf.Struct","value":{"k":"v"}},{}],` +
+				`"map":{"a":{"@type":"type.googleapis.com/google.protobuf.Any","value":{"@type":"type.googleapis.com/pb.String","str":"s"}}}}`,
+		},
+		{name: "unresolvable", args: &pb.Anys{Any: &anypb.Any{TypeUrl: "type.googleapis.com/pb.Unknown"}}, wantErr: true},
+		{name: "no type url", args: &pb.Anys{Any: &anypb.Any{Value: []byte{8, 1}}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, expectErr := protojson.Marshal(tt.args)
+			if tt.wantErr {
+				_, err := tt.args.MarshalJSON()
+				require.Error(t, err)
+				require.Error(t, expectErr)
+				return
+			}
+			Assert(t, tt.args, tt.want)
+			expect, err := protojson.Marshal(tt.args)
+			require.NoError(t, err)
+			require.JSONEq(t, string(expect), tt.want)
+			AssertRoundTrip(t, tt.args)
+		})
+	}
+}
+
+func TestAnys_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{name: "type last", data: `{"any":{"u32":1,"@type":"type.googleapis.com/pb.Number"}}`},
+		{name: "well known", data: `{"anys":[{"value":"1.5s","@type":"type.googleapis.com/google.protobuf.Duration"},{}]}`},
+		{name: "value null", data: `{"any":{"@type":"type.googleapis.com/google.protobuf.Value","value":null}}`},
+		{name: "missing type", data: `{"any":{"u32":1}}`, wantErr: true},
+		{name: "unresolvable", data: `{"any":{"@type":"type.googleapis.com/pb.Unknown"}}`, wantErr: true},
+		{name: "well known extra field", data: `{"any":{"@type":"type.googleapis.com/google.protobuf.Duration","value":"1s","x":1}}`, wantErr: true},
+		{name: "well known missing value", data: `{"any":{"@type":"type.googleapis.com/google.protobuf.Duration"}}`, wantErr: true},
+		{name: "bad field", data: `{"any":{"@type":"type.googleapis.com/pb.Number","u32":"x"}}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got pb.Anys
+			err := got.UnmarshalJSON([]byte(tt.data))
+			expect := new(pb.Anys)
+			expectErr := protojson.Unmarshal([]byte(tt.data), expect)
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Error(t, expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, expectErr)
+			require.True(t, proto.Equal(expect, &got), "got %v, want %v", &got, expect)
+		})
+	}
+}
+
+func TestAnys_Resolver(t *testing.T) {
+	defer func(resolver runtime.TypeResolver) { runtime.AnyResolver = resolver }(runtime.AnyResolver)
+	types := new(protoregistry.Types)
+	require.NoError(t, types.RegisterMessage((&pb.String{}).ProtoReflect().Type()))
+	runtime.AnyResolver = types
+
+	args := &pb.Anys{Any: MustAny(t, &pb.String{Str: "s"})}
+	Assert(t, args, `{"any":{"@type":"type.googleapis.com/pb.String","str":"s"}}`)
+	AssertRoundTrip(t, args)
+
+	_, err := (&pb.Anys{Any: MustAny(t, &pb.Number{})}).MarshalJSON()
+	require.Error(t, err)
+	require.Error(t, new(pb.Anys).UnmarshalJSON([]byte(`{"any":{"@type":"type.googleapis.com/pb.Number"}}`)))
+}
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.28.1
+// 	protoc-gen-go v1.31.0
 // 	protoc        v3.21.9
 // source: module.proto
 
@@ -9,6 +9,13 @@ package pb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -204,6 +211,276 @@ func (x *Number) GetF32() float32 {
 	return 0
 }
 
+type NumberList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	U32  []uint32  `protobuf:"varint,1,rep,packed,name=u32,proto3" json:"u32,omitempty"`
+	U64  []uint64  `protobuf:"varint,2,rep,packed,name=u64,proto3" json:"u64,omitempty"`
+	S32  []int32   `protobuf:"zigzag32,3,rep,packed,name=s32,proto3" json:"s32,omitempty"`
+	S64  []int64   `protobuf:"zigzag64,4,rep,packed,name=s64,proto3" json:"s64,omitempty"`
+	Uf32 []uint32  `protobuf:"fixed32,5,rep,packed,name=uf32,proto3" json:"uf32,omitempty"`
+	Uf64 []uint64  `protobuf:"fixed64,6,rep,packed,name=uf64,proto3" json:"uf64,omitempty"`
+	Sf32 []int32   `protobuf:"fixed32,7,rep,packed,name=sf32,proto3" json:"sf32,omitempty"`
+	Sf64 []int64   `protobuf:"fixed64,8,rep,packed,name=sf64,proto3" json:"sf64,omitempty"`
+	I32  []int32   `protobuf:"varint,9,rep,packed,name=i32,proto3" json:"i32,omitempty"`
+	I64  []int64   `protobuf:"varint,10,rep,packed,name=i64,proto3" json:"i64,omitempty"`
+	F64  []float64 `protobuf:"fixed64,11,rep,packed,name=f64,proto3" json:"f64,omitempty"`
+	F32  []float32 `protobuf:"fixed32,12,rep,packed,name=f32,proto3" json:"f32,omitempty"`
+}
+
+func (x *NumberList) Reset() {
+	*x = NumberList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_module_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NumberList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NumberList) ProtoMessage() {}
+
+func (x *NumberList) ProtoReflect() protoreflect.Message {
+	mi := &file_module_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NumberList.ProtoReflect.Descriptor instead.
+func (*NumberList) Descriptor() ([]byte, []int) {
+	return file_module_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NumberList) GetU32() []uint32 {
+	if x != nil {
+		return x.U32
+	}
+	return nil
+}
+
+func (x *NumberList) GetU64() []uint64 {
+	if x != nil {
+		return x.U64
+	}
+	return nil
+}
+
+func (x *NumberList) GetS32() []int32 {
+	if x != nil {
+		return x.S32
+	}
+	return nil
+}
+
+func (x *NumberList) GetS64() []int64 {
+	if x != nil {
+		return x.S64
+	}
+	return nil
+}
+
+func (x *NumberList) GetUf32() []uint32 {
+	if x != nil {
+		return x.Uf32
+	}
+	return nil
+}
+
+func (x *NumberList) GetUf64() []uint64 {
+	if x != nil {
+		return x.Uf64
+	}
+	return nil
+}
+
+func (x *NumberList) GetSf32() []int32 {
+	if x != nil {
+		return x.Sf32
+	}
+	return nil
+}
+
+func (x *NumberList) GetSf64() []int64 {
+	if x != nil {
+		return x.Sf64
+	}
+	return nil
+}
+
+func (x *NumberList) GetI32() []int32 {
+	if x != nil {
+		return x.I32
+	}
+	return nil
+}
+
+func (x *NumberList) GetI64() []int64 {
+	if x != nil {
+		return x.I64
+	}
+	return nil
+}
+
+func (x *NumberList) GetF64() []float64 {
+	if x != nil {
+		return x.F64
+	}
+	return nil
+}
+
+func (x *NumberList) GetF32() []float32 {
+	if x != nil {
+		return x.F32
+	}
+	return nil
+}
+
+type NumberMap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	U32  map[uint32]uint32  `protobuf:"bytes,1,rep,name=u32,proto3" json:"u32,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	U64  map[uint64]uint64  `protobuf:"bytes,2,rep,name=u64,proto3" json:"u64,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	S32  map[int32]int32    `protobuf:"bytes,3,rep,name=s32,proto3" json:"s32,omitempty" protobuf_key:"zigzag32,1,opt,name=key,proto3" protobuf_val:"zigzag32,2,opt,name=value,proto3"`
+	S64  map[int64]int64    `protobuf:"bytes,4,rep,name=s64,proto3" json:"s64,omitempty" protobuf_key:"zigzag64,1,opt,name=key,proto3" protobuf_val:"zigzag64,2,opt,name=value,proto3"`
+	Uf32 map[uint32]uint32  `protobuf:"bytes,5,rep,name=uf32,proto3" json:"uf32,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	Uf64 map[uint64]uint64  `protobuf:"bytes,6,rep,name=uf64,proto3" json:"uf64,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Sf32 map[int32]int32    `protobuf:"bytes,7,rep,name=sf32,proto3" json:"sf32,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	Sf64 map[int64]int64    `protobuf:"bytes,8,rep,name=sf64,proto3" json:"sf64,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	I32  map[int32]int32    `protobuf:"bytes,9,rep,name=i32,proto3" json:"i32,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	I64  map[int64]int64    `protobuf:"bytes,10,rep,name=i64,proto3" json:"i64,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	F64  map[string]float64 `protobuf:"bytes,11,rep,name=f64,proto3" json:"f64,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	F32  map[string]float32 `protobuf:"bytes,12,rep,name=f32,proto3" json:"f32,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+}
+
+func (x *NumberMap) Reset() {
+	*x = NumberMap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_module_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NumberMap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NumberMap) ProtoMessage() {}
+
+func (x *NumberMap) ProtoReflect() protoreflect.Message {
+	mi := &file_module_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NumberMap.ProtoReflect.Descriptor instead.
+func (*NumberMap) Descriptor() ([]byte, []int) {
+	return file_module_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NumberMap) GetU32() map[uint32]uint32 {
+	if x != nil {
+		return x.U32
+	}
+	return nil
+}
+
+func (x *NumberMap) GetU64() map[uint64]uint64 {
+	if x != nil {
+		return x.U64
+	}
+	return nil
+}
+
+func (x *NumberMap) GetS32() map[int32]int32 {
+	if x != nil {
+		return x.S32
+	}
+	return nil
+}
+
+func (x *NumberMap) GetS64() map[int64]int64 {
+	if x != nil {
+		return x.S64
+	}
+	return nil
+}
+
+func (x *NumberMap) GetUf32() map[uint32]uint32 {
+	if x != nil {
+		return x.Uf32
+	}
+	return nil
+}
+
+func (x *NumberMap) GetUf64() map[uint64]uint64 {
+	if x != nil {
+		return x.Uf64
+	}
+	return nil
+}
+
+func (x *NumberMap) GetSf32() map[int32]int32 {
+	if x != nil {
+		return x.Sf32
+	}
+	return nil
+}
+
+func (x *NumberMap) GetSf64() map[int64]int64 {
+	if x != nil {
+		return x.Sf64
+	}
+	return nil
+}
+
+func (x *NumberMap) GetI32() map[int32]int32 {
+	if x != nil {
+		return x.I32
+	}
+	return nil
+}
+
+func (x *NumberMap) GetI64() map[int64]int64 {
+	if x != nil {
+		return x.I64
+	}
+	return nil
+}
+
+func (x *NumberMap) GetF64() map[string]float64 {
+	if x != nil {
+		return x.F64
+	}
+	return nil
+}
+
+func (x *NumberMap) GetF32() map[string]float32 {
+	if x != nil {
+		return x.F32
+	}
+	return nil
+}
+
 type String struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -216,7 +493,7 @@ type String struct {
 func (x *String) Reset() {
 	*x = String{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_module_proto_msgTypes[1]
+		mi := &file_module_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -229,7 +506,7 @@ func (x *String) String() string {
 func (*String) ProtoMessage() {}
 
 func (x *String) ProtoReflect() protoreflect.Message {
-	mi := &file_module_proto_msgTypes[1]
+	mi := &file_module_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -242,7 +519,7 @@ func (x *String) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use String.ProtoReflect.Descriptor instead.
 func (*String) Descriptor() ([]byte, []int) {
-	return file_module_proto_rawDescGZIP(), []int{1}
+	return file_module_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *String) GetStr() string {
@@ -270,7 +547,7 @@ type Bool struct {
 func (x *Bool) Reset() {
 	*x = Bool{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_module_proto_msgTypes[2]
+		mi := &file_module_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -283,7 +560,7 @@ func (x *Bool) String() string {
 func (*Bool) ProtoMessage() {}
 
 func (x *Bool) ProtoReflect() protoreflect.Message {
-	mi := &file_module_proto_msgTypes[2]
+	mi := &file_module_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -296,7 +573,7 @@ func (x *Bool) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Bool.ProtoReflect.Descriptor instead.
 func (*Bool) Descriptor() ([]byte, []int) {
-	return file_module_proto_rawDescGZIP(), []int{2}
+	return file_module_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Bool) GetB() bool {
@@ -306,34 +583,945 @@ func (x *Bool) GetB() bool {
 	return false
 }
 
-type Message struct {
+type Enums struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type  Type                 `protobuf:"varint,1,opt,name=type,proto3,enum=pb.Type" json:"type,omitempty"`
+	Types []Type               `protobuf:"varint,2,rep,packed,name=types,proto3,enum=pb.Type" json:"types,omitempty"`
+	Map   map[string]Type      `protobuf:"bytes,3,rep,name=map,proto3" json:"map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=pb.Type"`
+	Null  structpb.NullValue   `protobuf:"varint,4,opt,name=null,proto3,enum=google.protobuf.NullValue" json:"null,omitempty"`
+	Nulls []structpb.NullValue `protobuf:"varint,5,rep,packed,name=nulls,proto3,enum=google.protobuf.NullValue" json:"nulls,omitempty"`
+}
+
+func (x *Enums) Reset() {
+	*x = Enums{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_module_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Enums) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Enums) ProtoMessage() {}
+
+func (x *Enums) ProtoReflect() protoreflect.Message {
+	mi := &file_module_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Enums.ProtoReflect.Descriptor instead.
+func (*Enums) Descriptor() ([]byte, []int) {
+	return file_module_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Enums) GetType() Type {
+	if x != nil {
+		return x.Type
+	}
+	return Type_NUMBER
+}
+
+func (x *Enums) GetTypes() []Type {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *Enums) GetMap() map[string]Type {
+	if x != nil {
+		return x.Map
+	}
+	return nil
+}
+
+func (x *Enums) GetNull() structpb.NullValue {
+	if x != nil {
+		return x.Null
+	}
+	return structpb.NullValue(0)
+}
+
+func (x *Enums) GetNulls() []structpb.NullValue {
+	if x != nil {
+		return x.Nulls
+	}
+	return nil
+}
+
+type Message struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type    Type    `protobuf:"varint,1,opt,name=type,proto3,enum=pb.Type" json:"type,omitempty"`
+	Number  *Number `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+	String_ *String `protobuf:"bytes,3,opt,name=string,proto3" json:"string,omitempty"`
+	Bool    *Bool   `protobuf:"bytes,4,opt,name=bool,proto3" json:"bool,omitempty"`
+}
+
+func (x *Message) Reset() {
+	*x = Message{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_module_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message) ProtoMessage() {}
+
+func (x *Message) ProtoReflect() protoreflect.Message {
+	mi := &file_module_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Message.ProtoReflect.Descriptor instead.
+func (*Message) Descriptor() ([]byte, []int) {
+	return file_module_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Message) GetType() Type {
+	if x != nil {
+		return x.Type
+	}
+	return Type_NUMBER
+}
+
+func (x *Message) GetNumber() *Number {
+	if x != nil {
+		return x.Number
+	}
+	return nil
+}
+
+func (x *Message) GetString_() *String {
+	if x != nil {
+		return x.String_
+	}
+	return nil
+}
+
+func (x *Message) GetBool() *Bool {
+	if x != nil {
+		return x.Bool
+	}
+	return nil
+}
+
+type Array struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Numbers  []*Number  `protobuf:"bytes,1,rep,name=numbers,proto3" json:"numbers,omitempty"`
+	Strings  []*String  `protobuf:"bytes,2,rep,name=strings,proto3" json:"strings,omitempty"`
+	Bools    []*Bool    `protobuf:"bytes,3,rep,name=bools,proto3" json:"bools,omitempty"`
+	Messages []*Message `protobuf:"bytes,4,rep,name=messages,proto3" json:"messages,omitempty"`
+	Arrays   []*Array   `protobuf:"bytes,5,rep,name=arrays,proto3" json:"arrays,omitempty"`
+	Types    []Type     `protobuf:"varint,6,rep,packed,name=types,proto3,enum=pb.Type" json:"types,omitempty"`
+	U32S     []uint32   `protobuf:"varint,7,rep,packed,name=u32s,proto3" json:"u32s,omitempty"`
+	Strs     []string   `protobuf:"bytes,8,rep,name=strs,proto3" json:"strs,omitempty"`
+}
+
+func (x *Array) Reset() {
+	*x = Array{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_module_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Array) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Array) ProtoMessage() {}
+
+func (x *Array) ProtoReflect() protoreflect.Message {
+	mi := &file_module_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Array.ProtoReflect.Descriptor instead.
+func (*Array) Descriptor() ([]byte, []int) {
+	return file_module_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Array) GetNumbers() []*Number {
+	if x != nil {
+		return x.Numbers
+	}
+	return nil
+}
+
+func (x *Array) GetStrings() []*String {
+	if x != nil {
+		return x.Strings
+	}
+	return nil
+}
+
+func (x *Array) GetBools() []*Bool {
+	if x != nil {
+		return x.Bools
+	}
+	return nil
+}
+
+func (x *Array) GetMessages() []*Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *Array) GetArrays() []*Array {
+	if x != nil {
+		return x.Arrays
+	}
+	return nil
+}
+
+func (x *Array) GetTypes() []Type {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *Array) GetU32S() []uint32 {
+	if x != nil {
+		return x.U32S
+	}
+	return nil
+}
+
+func (x *Array) GetStrs() []string {
+	if x != nil {
+		return x.Strs
+	}
+	return nil
+}
+
+type Map struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Numbers   map[uint32]*Number   `protobuf:"bytes,1,rep,name=numbers,proto3" json:"numbers,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Strings   map[string]*String   `protobuf:"bytes,2,rep,name=strings,proto3" json:"strings,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Bools     map[bool]*Bool       `protobuf:"bytes,3,rep,name=bools,proto3" json:"bools,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Messages  map[string]*Message  `protobuf:"bytes,4,rep,name=messages,proto3" json:"messages,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Arrays    map[string]*Array    `protobuf:"bytes,5,rep,name=arrays,proto3" json:"arrays,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Types     map[int32]Type       `protobuf:"bytes,6,rep,name=types,proto3" json:"types,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=pb.Type"`
+	U32S      map[string]uint32    `protobuf:"bytes,7,rep,name=u32s,proto3" json:"u32s,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Strs      map[string]string    `protobuf:"bytes,8,rep,name=strs,proto3" json:"strs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Empties   map[string]*Empty    `protobuf:"bytes,9,rep,name=empties,proto3" json:"empties,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Optionals map[string]*Optional `protobuf:"bytes,10,rep,name=optionals,proto3" json:"optionals,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Oneofs    map[string]*Oneof    `protobuf:"bytes,11,rep,name=oneofs,proto3" json:"oneofs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Map) Reset() {
+	*x = Map{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_module_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Map) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Map) ProtoMessage() {}
+
+func (x *Map) ProtoReflect() protoreflect.Message {
+	mi := &file_module_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Map.ProtoReflect.Descriptor instead.
+func (*Map) Descriptor() ([]byte, []int) {
+	return file_module_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Map) GetNumbers() map[uint32]*Number {
+	if x != nil {
+		return x.Numbers
+	}
+	return nil
+}
+
+func (x *Map) GetStrings() map[string]*String {
+	if x != nil {
+		return x.Strings
+	}
+	return nil
+}
+
+func (x *Map) GetBools() map[bool]*Bool {
+	if x != nil {
+		return x.Bools
+	}
+	return nil
+}
+
+func (x *Map) GetMessages() map[string]*Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *Map) GetArrays() map[string]*Array {
+	if x != nil {
+		return x.Arrays
+	}
+	return nil
+}
+
+func (x *Map) GetTypes() map[int32]Type {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *Map) GetU32S() map[string]uint32 {
+	if x != nil {
+		return x.U32S
+	}
+	return nil
+}
+
+func (x *Map) GetStrs() map[string]string {
+	if x != nil {
+		return x.Strs
+	}
+	return nil
+}
+
+func (x *Map) GetEmpties() map[string]*Empty {
+	if x != nil {
+		return x.Empties
+	}
+	return nil
+}
+
+func (x *Map) GetOptionals() map[string]*Optional {
+	if x != nil {
+		return x.Optionals
+	}
+	return nil
+}
+
+func (x *Map) GetOneofs() map[string]*Oneof {
+	if x != nil {
+		return x.Oneofs
+	}
+	return nil
+}
+
+type Empty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_module_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_module_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_module_proto_rawDescGZIP(), []int{9}
+}
+
+type Optional struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number  *Number  `protobuf:"bytes,1,opt,name=number,proto3,oneof" json:"number,omitempty"`
+	String_ *String  `protobuf:"bytes,2,opt,name=string,proto3,oneof" json:"string,omitempty"`
+	Bool    *Bool    `protobuf:"bytes,3,opt,name=bool,proto3,oneof" json:"bool,omitempty"`
+	Message *Message `protobuf:"bytes,4,opt,name=message,proto3,oneof" json:"message,omitempty"`
+	Array   *Array   `protobuf:"bytes,5,opt,name=array,proto3,oneof" json:"array,omitempty"`
+	Type    *Type    `protobuf:"varint,6,opt,name=type,proto3,enum=pb.Type,oneof" json:"type,omitempty"`
+	U32     *uint32  `protobuf:"varint,7,opt,name=u32,proto3,oneof" json:"u32,omitempty"`
+	Str     *string  `protobuf:"bytes,8,opt,name=str,proto3,oneof" json:"str,omitempty"`
+}
+
+func (x *Optional) Reset() {
+	*x = Optional{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_module_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Optional) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Optional) ProtoMessage() {}
+
+func (x *Optional) ProtoReflect() protoreflect.Message {
+	mi := &file_module_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Optional.ProtoReflect.Descriptor instead.
+func (*Optional) Descriptor() ([]byte, []int) {
+	return file_module_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Optional) GetNumber() *Number {
+	if x != nil {
+		return x.Number
+	}
+	return nil
+}
+
+func (x *Optional) GetString_() *String {
+	if x != nil {
+		return x.String_
+	}
+	return nil
+}
+
+func (x *Optional) GetBool() *Bool {
+	if x != nil {
+		return x.Bool
+	}
+	return nil
+}
+
+func (x *Optional) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *Optional) GetArray() *Array {
+	if x != nil {
+		return x.Array
+	}
+	return nil
+}
+
+func (x *Optional) GetType() Type {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return Type_NUMBER
+}
+
+func (x *Optional) GetU32() uint32 {
+	if x != nil && x.U32 != nil {
+		return *x.U32
+	}
+	return 0
+}
+
+func (x *Optional) GetStr() string {
+	if x != nil && x.Str != nil {
+		return *x.Str
+	}
+	return ""
+}
+
+type Oneof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number *Number `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	// Types that are assignable to Oneof:
+	//	*Oneof_String_
+	//	*Oneof_Bool
+	//	*Oneof_Message
+	//	*Oneof_Array
+	//	*Oneof_Type
+	//	*Oneof_U32
+	//	*Oneof_Str
+	Oneof   isOneof_Oneof `protobuf_oneof:"oneof"`
+	NumberX *Number       `protobuf:"bytes,9,opt,name=number_x,json=numberX,proto3" json:"number_x,omitempty"`
+	StringX *String       `protobuf:"bytes,10,opt,name=string_x,json=stringX,proto3" json:"string_x,omitempty"`
+}
+
+func (x *Oneof) Reset() {
+	*x = Oneof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_module_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Oneof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Oneof) ProtoMessage() {}
+
+func (x *Oneof) ProtoReflect() protoreflect.Message {
+	mi := &file_module_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Oneof.ProtoReflect.Descriptor instead.
+func (*Oneof) Descriptor() ([]byte, []int) {
+	return file_module_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Oneof) GetNumber() *Number {
+	if x != nil {
+		return x.Number
+	}
+	return nil
+}
+
+func (m *Oneof) GetOneof() isOneof_Oneof {
+	if m != nil {
+		return m.Oneof
+	}
+	return nil
+}
+
+func (x *Oneof) GetString_() *String {
+	if x, ok := x.GetOneof().(*Oneof_String_); ok {
+		return x.String_
+	}
+	return nil
+}
+
+func (x *Oneof) GetBool() *Bool {
+	if x, ok := x.GetOneof().(*Oneof_Bool); ok {
+		return x.Bool
+	}
+	return nil
+}
+
+func (x *Oneof) GetMessage() *Message {
+	if x, ok := x.GetOneof().(*Oneof_Message); ok {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *Oneof) GetArray() *Array {
+	if x, ok := x.GetOneof().(*Oneof_Array); ok {
+		return x.Array
+	}
+	return nil
+}
+
+func (x *Oneof) GetType() Type {
+	if x, ok := x.GetOneof().(*Oneof_Type); ok {
+		return x.Type
+	}
+	return Type_NUMBER
+}
+
+func (x *Oneof) GetU32() uint32 {
+	if x, ok := x.GetOneof().(*Oneof_U32); ok {
+		return x.U32
+	}
+	return 0
+}
+
+func (x *Oneof) GetStr() string {
+	if x, ok := x.GetOneof().(*Oneof_Str); ok {
+		return x.Str
+	}
+	return ""
+}
+
+func (x *Oneof) GetNumberX() *Number {
+	if x != nil {
+		return x.NumberX
+	}
+	return nil
+}
+
+func (x *Oneof) GetStringX() *String {
+	if x != nil {
+		return x.StringX
+	}
+	return nil
+}
+
+type isOneof_Oneof interface {
+	isOneof_Oneof()
+}
+
+type Oneof_String_ struct {
+	String_ *String `protobuf:"bytes,2,opt,name=string,proto3,oneof"`
+}
+
+type Oneof_Bool struct {
+	Bool *Bool `protobuf:"bytes,3,opt,name=bool,proto3,oneof"`
+}
+
+type Oneof_Message struct {
+	Message *Message `protobuf:"bytes,4,opt,name=message,proto3,oneof"`
+}
+
+type Oneof_Array struct {
+	Array *Array `protobuf:"bytes,5,opt,name=array,proto3,oneof"`
+}
+
+type Oneof_Type struct {
+	Type Type `protobuf:"varint,6,opt,name=type,proto3,enum=pb.Type,oneof"`
+}
+
+type Oneof_U32 struct {
+	U32 uint32 `protobuf:"varint,7,opt,name=u32,proto3,oneof"`
+}
+
+type Oneof_Str struct {
+	Str string `protobuf:"bytes,8,opt,name=str,proto3,oneof"`
+}
+
+func (*Oneof_String_) isOneof_Oneof() {}
+
+func (*Oneof_Bool) isOneof_Oneof() {}
+
+func (*Oneof_Message) isOneof_Oneof() {}
+
+func (*Oneof_Array) isOneof_Oneof() {}
+
+func (*Oneof_Type) isOneof_Oneof() {}
+
+func (*Oneof_U32) isOneof_Oneof() {}
+
+func (*Oneof_Str) isOneof_Oneof() {}
+
+// 第一个字段编号不是 1, 声明顺序与编号顺序不同, 中间有 reserved 的编号
+type FieldOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	E    uint32   `protobuf:"varint,5,opt,name=e,proto3" json:"e,omitempty"`
+	C    string   `protobuf:"bytes,3,opt,name=c,proto3" json:"c,omitempty"`
+	D    *bool    `protobuf:"varint,9,opt,name=d,proto3,oneof" json:"d,omitempty"`
+	List []uint32 `protobuf:"varint,2,rep,packed,name=list,proto3" json:"list,omitempty"`
+	A    uint64   `protobuf:"varint,1,opt,name=a,proto3" json:"a,omitempty"`
+}
+
+func (x *FieldOrder) Reset() {
+	*x = FieldOrder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_module_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldOrder) ProtoMessage() {}
+
+func (x *FieldOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_module_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldOrder.ProtoReflect.Descriptor instead.
+func (*FieldOrder) Descriptor() ([]byte, []int) {
+	return file_module_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FieldOrder) GetE() uint32 {
+	if x != nil {
+		return x.E
+	}
+	return 0
+}
+
+func (x *FieldOrder) GetC() string {
+	if x != nil {
+		return x.C
+	}
+	return ""
+}
+
+func (x *FieldOrder) GetD() bool {
+	if x != nil && x.D != nil {
+		return *x.D
+	}
+	return false
+}
+
+func (x *FieldOrder) GetList() []uint32 {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *FieldOrder) GetA() uint64 {
+	if x != nil {
+		return x.A
+	}
+	return 0
+}
+
+// 第一个字段属于 oneof
+type OneofFirst struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to First:
+	//	*OneofFirst_S
+	//	*OneofFirst_U
+	First isOneofFirst_First `protobuf_oneof:"first"`
+	Map   map[string]uint32  `protobuf:"bytes,2,rep,name=map,proto3" json:"map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Bool  *Bool              `protobuf:"bytes,7,opt,name=bool,proto3" json:"bool,omitempty"`
+	// Types that are assignable to Second:
+	//	*OneofFirst_T
+	//	*OneofFirst_B
+	Second isOneofFirst_Second `protobuf_oneof:"second"`
+}
+
+func (x *OneofFirst) Reset() {
+	*x = OneofFirst{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_module_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OneofFirst) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OneofFirst) ProtoMessage() {}
+
+func (x *OneofFirst) ProtoReflect() protoreflect.Message {
+	mi := &file_module_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OneofFirst.ProtoReflect.Descriptor instead.
+func (*OneofFirst) Descriptor() ([]byte, []int) {
+	return file_module_proto_rawDescGZIP(), []int{13}
+}
+
+func (m *OneofFirst) GetFirst() isOneofFirst_First {
+	if m != nil {
+		return m.First
+	}
+	return nil
+}
+
+func (x *OneofFirst) GetS() string {
+	if x, ok := x.GetFirst().(*OneofFirst_S); ok {
+		return x.S
+	}
+	return ""
+}
+
+func (x *OneofFirst) GetU() uint32 {
+	if x, ok := x.GetFirst().(*OneofFirst_U); ok {
+		return x.U
+	}
+	return 0
+}
+
+func (x *OneofFirst) GetMap() map[string]uint32 {
+	if x != nil {
+		return x.Map
+	}
+	return nil
+}
+
+func (x *OneofFirst) GetBool() *Bool {
+	if x != nil {
+		return x.Bool
+	}
+	return nil
+}
+
+func (m *OneofFirst) GetSecond() isOneofFirst_Second {
+	if m != nil {
+		return m.Second
+	}
+	return nil
+}
+
+func (x *OneofFirst) GetT() string {
+	if x, ok := x.GetSecond().(*OneofFirst_T); ok {
+		return x.T
+	}
+	return ""
+}
+
+func (x *OneofFirst) GetB() *Bool {
+	if x, ok := x.GetSecond().(*OneofFirst_B); ok {
+		return x.B
+	}
+	return nil
+}
+
+type isOneofFirst_First interface {
+	isOneofFirst_First()
+}
+
+type OneofFirst_S struct {
+	S string `protobuf:"bytes,3,opt,name=s,proto3,oneof"`
+}
+
+type OneofFirst_U struct {
+	U uint32 `protobuf:"varint,4,opt,name=u,proto3,oneof"`
+}
+
+func (*OneofFirst_S) isOneofFirst_First() {}
+
+func (*OneofFirst_U) isOneofFirst_First() {}
+
+type isOneofFirst_Second interface {
+	isOneofFirst_Second()
+}
+
+type OneofFirst_T struct {
+	T string `protobuf:"bytes,8,opt,name=t,proto3,oneof"`
+}
+
+type OneofFirst_B struct {
+	B *Bool `protobuf:"bytes,9,opt,name=b,proto3,oneof"`
+}
+
+func (*OneofFirst_T) isOneofFirst_Second() {}
+
+func (*OneofFirst_B) isOneofFirst_Second() {}
+
+// 只有一个有条件写出的字段, 且编号不是 1
+type Single struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type    Type    `protobuf:"varint,1,opt,name=type,proto3,enum=pb.Type" json:"type,omitempty"`
-	Number  *Number `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
-	String_ *String `protobuf:"bytes,3,opt,name=string,proto3" json:"string,omitempty"`
-	Bool    *Bool   `protobuf:"bytes,4,opt,name=bool,proto3" json:"bool,omitempty"`
+	S string `protobuf:"bytes,2,opt,name=s,proto3" json:"s,omitempty"`
 }
 
-func (x *Message) Reset() {
-	*x = Message{}
+func (x *Single) Reset() {
+	*x = Single{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_module_proto_msgTypes[3]
+		mi := &file_module_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Message) String() string {
+func (x *Single) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Message) ProtoMessage() {}
+func (*Single) ProtoMessage() {}
 
-func (x *Message) ProtoReflect() protoreflect.Message {
-	mi := &file_module_proto_msgTypes[3]
+func (x *Single) ProtoReflect() protoreflect.Message {
+	mi := &file_module_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -344,71 +1532,54 @@ func (x *Message) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Message.ProtoReflect.Descriptor instead.
-func (*Message) Descriptor() ([]byte, []int) {
-	return file_module_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *Message) GetType() Type {
-	if x != nil {
-		return x.Type
-	}
-	return Type_NUMBER
-}
-
-func (x *Message) GetNumber() *Number {
-	if x != nil {
-		return x.Number
-	}
-	return nil
-}
-
-func (x *Message) GetString_() *String {
-	if x != nil {
-		return x.String_
-	}
-	return nil
+// Deprecated: Use Single.ProtoReflect.Descriptor instead.
+func (*Single) Descriptor() ([]byte, []int) {
+	return file_module_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Message) GetBool() *Bool {
+func (x *Single) GetS() string {
 	if x != nil {
-		return x.Bool
+		return x.S
 	}
-	return nil
+	return ""
 }
 
-type Array struct {
+type WellKnown struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Numbers  []*Number  `protobuf:"bytes,1,rep,name=numbers,proto3" json:"numbers,omitempty"`
-	Strings  []*String  `protobuf:"bytes,2,rep,name=strings,proto3" json:"strings,omitempty"`
-	Bools    []*Bool    `protobuf:"bytes,3,rep,name=bools,proto3" json:"bools,omitempty"`
-	Messages []*Message `protobuf:"bytes,4,rep,name=messages,proto3" json:"messages,omitempty"`
-	Arrays   []*Array   `protobuf:"bytes,5,rep,name=arrays,proto3" json:"arrays,omitempty"`
-	Types    []Type     `protobuf:"varint,6,rep,packed,name=types,proto3,enum=pb.Type" json:"types,omitempty"`
-	U32S     []uint32   `protobuf:"varint,7,rep,packed,name=u32s,proto3" json:"u32s,omitempty"`
-	Strs     []string   `protobuf:"bytes,8,rep,name=strs,proto3" json:"strs,omitempty"`
-}
-
-func (x *Array) Reset() {
-	*x = Array{}
+	Timestamp  *timestamppb.Timestamp          `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Duration   *durationpb.Duration            `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	Timestamps []*timestamppb.Timestamp        `protobuf:"bytes,3,rep,name=timestamps,proto3" json:"timestamps,omitempty"`
+	Durations  map[string]*durationpb.Duration `protobuf:"bytes,4,rep,name=durations,proto3" json:"durations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Types that are assignable to Time:
+	//	*WellKnown_At
+	//	*WellKnown_After
+	Time    isWellKnown_Time          `protobuf_oneof:"time"`
+	Mask    *fieldmaskpb.FieldMask    `protobuf:"bytes,7,opt,name=mask,proto3" json:"mask,omitempty"`
+	Empty   *emptypb.Empty            `protobuf:"bytes,8,opt,name=empty,proto3" json:"empty,omitempty"`
+	Masks   []*fieldmaskpb.FieldMask  `protobuf:"bytes,9,rep,name=masks,proto3" json:"masks,omitempty"`
+	Empties map[string]*emptypb.Empty `protobuf:"bytes,10,rep,name=empties,proto3" json:"empties,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *WellKnown) Reset() {
+	*x = WellKnown{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_module_proto_msgTypes[4]
+		mi := &file_module_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Array) String() string {
+func (x *WellKnown) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Array) ProtoMessage() {}
+func (*WellKnown) ProtoMessage() {}
 
-func (x *Array) ProtoReflect() protoreflect.Message {
-	mi := &file_module_proto_msgTypes[4]
+func (x *WellKnown) ProtoReflect() protoreflect.Message {
+	mi := &file_module_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -419,102 +1590,137 @@ func (x *Array) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Array.ProtoReflect.Descriptor instead.
-func (*Array) Descriptor() ([]byte, []int) {
-	return file_module_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use WellKnown.ProtoReflect.Descriptor instead.
+func (*WellKnown) Descriptor() ([]byte, []int) {
+	return file_module_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Array) GetNumbers() []*Number {
+func (x *WellKnown) GetTimestamp() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Numbers
+		return x.Timestamp
 	}
 	return nil
 }
 
-func (x *Array) GetStrings() []*String {
+func (x *WellKnown) GetDuration() *durationpb.Duration {
 	if x != nil {
-		return x.Strings
+		return x.Duration
 	}
 	return nil
 }
 
-func (x *Array) GetBools() []*Bool {
+func (x *WellKnown) GetTimestamps() []*timestamppb.Timestamp {
 	if x != nil {
-		return x.Bools
+		return x.Timestamps
 	}
 	return nil
 }
 
-func (x *Array) GetMessages() []*Message {
+func (x *WellKnown) GetDurations() map[string]*durationpb.Duration {
 	if x != nil {
-		return x.Messages
+		return x.Durations
 	}
 	return nil
 }
 
-func (x *Array) GetArrays() []*Array {
+func (m *WellKnown) GetTime() isWellKnown_Time {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (x *WellKnown) GetAt() *timestamppb.Timestamp {
+	if x, ok := x.GetTime().(*WellKnown_At); ok {
+		return x.At
+	}
+	return nil
+}
+
+func (x *WellKnown) GetAfter() *durationpb.Duration {
+	if x, ok := x.GetTime().(*WellKnown_After); ok {
+		return x.After
+	}
+	return nil
+}
+
+func (x *WellKnown) GetMask() *fieldmaskpb.FieldMask {
 	if x != nil {
-		return x.Arrays
+		return x.Mask
 	}
 	return nil
 }
 
-func (x *Array) GetTypes() []Type {
+func (x *WellKnown) GetEmpty() *emptypb.Empty {
 	if x != nil {
-		return x.Types
+		return x.Empty
 	}
 	return nil
 }
 
-func (x *Array) GetU32S() []uint32 {
+func (x *WellKnown) GetMasks() []*fieldmaskpb.FieldMask {
 	if x != nil {
-		return x.U32S
+		return x.Masks
 	}
 	return nil
 }
 
-func (x *Array) GetStrs() []string {
+func (x *WellKnown) GetEmpties() map[string]*emptypb.Empty {
 	if x != nil {
-		return x.Strs
+		return x.Empties
 	}
 	return nil
 }
 
-type Map struct {
+type isWellKnown_Time interface {
+	isWellKnown_Time()
+}
+
+type WellKnown_At struct {
+	At *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=at,proto3,oneof"`
+}
+
+type WellKnown_After struct {
+	After *durationpb.Duration `protobuf:"bytes,6,opt,name=after,proto3,oneof"`
+}
+
+func (*WellKnown_At) isWellKnown_Time() {}
+
+func (*WellKnown_After) isWellKnown_Time() {}
+
+type Structs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Numbers   map[uint32]*Number   `protobuf:"bytes,1,rep,name=numbers,proto3" json:"numbers,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Strings   map[string]*String   `protobuf:"bytes,2,rep,name=strings,proto3" json:"strings,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Bools     map[bool]*Bool       `protobuf:"bytes,3,rep,name=bools,proto3" json:"bools,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Messages  map[string]*Message  `protobuf:"bytes,4,rep,name=messages,proto3" json:"messages,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Arrays    map[string]*Array    `protobuf:"bytes,5,rep,name=arrays,proto3" json:"arrays,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Types     map[int32]Type       `protobuf:"bytes,6,rep,name=types,proto3" json:"types,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=pb.Type"`
-	U32S      map[string]uint32    `protobuf:"bytes,7,rep,name=u32s,proto3" json:"u32s,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	Strs      map[string]string    `protobuf:"bytes,8,rep,name=strs,proto3" json:"strs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Empties   map[string]*Empty    `protobuf:"bytes,9,rep,name=empties,proto3" json:"empties,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Optionals map[string]*Optional `protobuf:"bytes,10,rep,name=optionals,proto3" json:"optionals,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Oneofs    map[string]*Oneof    `protobuf:"bytes,11,rep,name=oneofs,proto3" json:"oneofs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Struct  *structpb.Struct            `protobuf:"bytes,1,opt,name=struct,proto3" json:"struct,omitempty"`
+	Value   *structpb.Value             `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	List    *structpb.ListValue         `protobuf:"bytes,3,opt,name=list,proto3" json:"list,omitempty"`
+	Values  []*structpb.Value           `protobuf:"bytes,4,rep,name=values,proto3" json:"values,omitempty"`
+	Structs map[string]*structpb.Struct `protobuf:"bytes,5,rep,name=structs,proto3" json:"structs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Types that are assignable to Oneof:
+	//	*Structs_Ov
+	//	*Structs_Os
+	Oneof isStructs_Oneof `protobuf_oneof:"oneof"`
 }
 
-func (x *Map) Reset() {
-	*x = Map{}
+func (x *Structs) Reset() {
+	*x = Structs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_module_proto_msgTypes[5]
+		mi := &file_module_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Map) String() string {
+func (x *Structs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Map) ProtoMessage() {}
+func (*Structs) ProtoMessage() {}
 
-func (x *Map) ProtoReflect() protoreflect.Message {
-	mi := &file_module_proto_msgTypes[5]
+func (x *Structs) ProtoReflect() protoreflect.Message {
+	mi := &file_module_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -525,158 +1731,110 @@ func (x *Map) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Map.ProtoReflect.Descriptor instead.
-func (*Map) Descriptor() ([]byte, []int) {
-	return file_module_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *Map) GetNumbers() map[uint32]*Number {
-	if x != nil {
-		return x.Numbers
-	}
-	return nil
-}
-
-func (x *Map) GetStrings() map[string]*String {
-	if x != nil {
-		return x.Strings
-	}
-	return nil
-}
-
-func (x *Map) GetBools() map[bool]*Bool {
-	if x != nil {
-		return x.Bools
-	}
-	return nil
+// Deprecated: Use Structs.ProtoReflect.Descriptor instead.
+func (*Structs) Descriptor() ([]byte, []int) {
+	return file_module_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *Map) GetMessages() map[string]*Message {
+func (x *Structs) GetStruct() *structpb.Struct {
 	if x != nil {
-		return x.Messages
+		return x.Struct
 	}
 	return nil
 }
 
-func (x *Map) GetArrays() map[string]*Array {
+func (x *Structs) GetValue() *structpb.Value {
 	if x != nil {
-		return x.Arrays
+		return x.Value
 	}
 	return nil
 }
 
-func (x *Map) GetTypes() map[int32]Type {
+func (x *Structs) GetList() *structpb.ListValue {
 	if x != nil {
-		return x.Types
+		return x.List
 	}
 	return nil
 }
 
-func (x *Map) GetU32S() map[string]uint32 {
+func (x *Structs) GetValues() []*structpb.Value {
 	if x != nil {
-		return x.U32S
+		return x.Values
 	}
 	return nil
 }
 
-func (x *Map) GetStrs() map[string]string {
+func (x *Structs) GetStructs() map[string]*structpb.Struct {
 	if x != nil {
-		return x.Strs
+		return x.Structs
 	}
 	return nil
 }
 
-func (x *Map) GetEmpties() map[string]*Empty {
-	if x != nil {
-		return x.Empties
+func (m *Structs) GetOneof() isStructs_Oneof {
+	if m != nil {
+		return m.Oneof
 	}
 	return nil
 }
 
-func (x *Map) GetOptionals() map[string]*Optional {
-	if x != nil {
-		return x.Optionals
+func (x *Structs) GetOv() *structpb.Value {
+	if x, ok := x.GetOneof().(*Structs_Ov); ok {
+		return x.Ov
 	}
 	return nil
 }
 
-func (x *Map) GetOneofs() map[string]*Oneof {
-	if x != nil {
-		return x.Oneofs
+func (x *Structs) GetOs() *structpb.Struct {
+	if x, ok := x.GetOneof().(*Structs_Os); ok {
+		return x.Os
 	}
 	return nil
 }
 
-type Empty struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type isStructs_Oneof interface {
+	isStructs_Oneof()
 }
 
-func (x *Empty) Reset() {
-	*x = Empty{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_module_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+type Structs_Ov struct {
+	Ov *structpb.Value `protobuf:"bytes,6,opt,name=ov,proto3,oneof"`
 }
 
-func (x *Empty) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type Structs_Os struct {
+	Os *structpb.Struct `protobuf:"bytes,7,opt,name=os,proto3,oneof"`
 }
 
-func (*Empty) ProtoMessage() {}
-
-func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_module_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
+func (*Structs_Ov) isStructs_Oneof() {}
 
-// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
-func (*Empty) Descriptor() ([]byte, []int) {
-	return file_module_proto_rawDescGZIP(), []int{6}
-}
+func (*Structs_Os) isStructs_Oneof() {}
 
-type Optional struct {
+type Anys struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Number  *Number  `protobuf:"bytes,1,opt,name=number,proto3,oneof" json:"number,omitempty"`
-	String_ *String  `protobuf:"bytes,2,opt,name=string,proto3,oneof" json:"string,omitempty"`
-	Bool    *Bool    `protobuf:"bytes,3,opt,name=bool,proto3,oneof" json:"bool,omitempty"`
-	Message *Message `protobuf:"bytes,4,opt,name=message,proto3,oneof" json:"message,omitempty"`
-	Array   *Array   `protobuf:"bytes,5,opt,name=array,proto3,oneof" json:"array,omitempty"`
-	Type    *Type    `protobuf:"varint,6,opt,name=type,proto3,enum=pb.Type,oneof" json:"type,omitempty"`
-	U32     *uint32  `protobuf:"varint,7,opt,name=u32,proto3,oneof" json:"u32,omitempty"`
-	Str     *string  `protobuf:"bytes,8,opt,name=str,proto3,oneof" json:"str,omitempty"`
+	Any  *anypb.Any            `protobuf:"bytes,1,opt,name=any,proto3" json:"any,omitempty"`
+	Anys []*anypb.Any          `protobuf:"bytes,2,rep,name=anys,proto3" json:"anys,omitempty"`
+	Map  map[string]*anypb.Any `protobuf:"bytes,3,rep,name=map,proto3" json:"map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *Optional) Reset() {
-	*x = Optional{}
+func (x *Anys) Reset() {
+	*x = Anys{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_module_proto_msgTypes[7]
+		mi := &file_module_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Optional) String() string {
+func (x *Anys) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Optional) ProtoMessage() {}
+func (*Anys) ProtoMessage() {}
 
-func (x *Optional) ProtoReflect() protoreflect.Message {
-	mi := &file_module_proto_msgTypes[7]
+func (x *Anys) ProtoReflect() protoreflect.Message {
+	mi := &file_module_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -687,104 +1845,71 @@ func (x *Optional) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Optional.ProtoReflect.Descriptor instead.
-func (*Optional) Descriptor() ([]byte, []int) {
-	return file_module_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *Optional) GetNumber() *Number {
-	if x != nil {
-		return x.Number
-	}
-	return nil
-}
-
-func (x *Optional) GetString_() *String {
-	if x != nil {
-		return x.String_
-	}
-	return nil
-}
-
-func (x *Optional) GetBool() *Bool {
-	if x != nil {
-		return x.Bool
-	}
-	return nil
-}
-
-func (x *Optional) GetMessage() *Message {
-	if x != nil {
-		return x.Message
-	}
-	return nil
+// Deprecated: Use Anys.ProtoReflect.Descriptor instead.
+func (*Anys) Descriptor() ([]byte, []int) {
+	return file_module_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Optional) GetArray() *Array {
+func (x *Anys) GetAny() *anypb.Any {
 	if x != nil {
-		return x.Array
+		return x.Any
 	}
-	return nil
-}
-
-func (x *Optional) GetType() Type {
-	if x != nil && x.Type != nil {
-		return *x.Type
-	}
-	return Type_NUMBER
+	return nil
 }
 
-func (x *Optional) GetU32() uint32 {
-	if x != nil && x.U32 != nil {
-		return *x.U32
+func (x *Anys) GetAnys() []*anypb.Any {
+	if x != nil {
+		return x.Anys
 	}
-	return 0
+	return nil
 }
 
-func (x *Optional) GetStr() string {
-	if x != nil && x.Str != nil {
-		return *x.Str
+func (x *Anys) GetMap() map[string]*anypb.Any {
+	if x != nil {
+		return x.Map
 	}
-	return ""
+	return nil
 }
 
-type Oneof struct {
+type Wrappers struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Number *Number `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	F64   *wrapperspb.DoubleValue            `protobuf:"bytes,1,opt,name=f64,proto3" json:"f64,omitempty"`
+	F32   *wrapperspb.FloatValue             `protobuf:"bytes,2,opt,name=f32,proto3" json:"f32,omitempty"`
+	I64   *wrapperspb.Int64Value             `protobuf:"bytes,3,opt,name=i64,proto3" json:"i64,omitempty"`
+	U64   *wrapperspb.UInt64Value            `protobuf:"bytes,4,opt,name=u64,proto3" json:"u64,omitempty"`
+	I32   *wrapperspb.Int32Value             `protobuf:"bytes,5,opt,name=i32,proto3" json:"i32,omitempty"`
+	U32   *wrapperspb.UInt32Value            `protobuf:"bytes,6,opt,name=u32,proto3" json:"u32,omitempty"`
+	B     *wrapperspb.BoolValue              `protobuf:"bytes,7,opt,name=b,proto3" json:"b,omitempty"`
+	Str   *wrapperspb.StringValue            `protobuf:"bytes,8,opt,name=str,proto3" json:"str,omitempty"`
+	Bytes *wrapperspb.BytesValue             `protobuf:"bytes,9,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	I64S  []*wrapperspb.Int64Value           `protobuf:"bytes,10,rep,name=i64s,proto3" json:"i64s,omitempty"`
+	Strs  map[string]*wrapperspb.StringValue `protobuf:"bytes,11,rep,name=strs,proto3" json:"strs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// Types that are assignable to Oneof:
-	//
-	//	*Oneof_String_
-	//	*Oneof_Bool
-	//	*Oneof_Message
-	//	*Oneof_Array
-	//	*Oneof_Type
-	//	*Oneof_U32
-	//	*Oneof_Str
-	Oneof   isOneof_Oneof `protobuf_oneof:"oneof"`
-	NumberX *Number       `protobuf:"bytes,9,opt,name=number_x,json=numberX,proto3" json:"number_x,omitempty"`
-	StringX *String       `protobuf:"bytes,10,opt,name=string_x,json=stringX,proto3" json:"string_x,omitempty"`
+	//	*Wrappers_Ob
+	//	*Wrappers_Of64
+	Oneof isWrappers_Oneof `protobuf_oneof:"oneof"`
 }
 
-func (x *Oneof) Reset() {
-	*x = Oneof{}
+func (x *Wrappers) Reset() {
+	*x = Wrappers{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_module_proto_msgTypes[8]
+		mi := &file_module_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Oneof) String() string {
+func (x *Wrappers) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Oneof) ProtoMessage() {}
+func (*Wrappers) ProtoMessage() {}
 
-func (x *Oneof) ProtoReflect() protoreflect.Message {
-	mi := &file_module_proto_msgTypes[8]
+func (x *Wrappers) ProtoReflect() protoreflect.Message {
+	mi := &file_module_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -795,133 +1920,124 @@ func (x *Oneof) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Oneof.ProtoReflect.Descriptor instead.
-func (*Oneof) Descriptor() ([]byte, []int) {
-	return file_module_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use Wrappers.ProtoReflect.Descriptor instead.
+func (*Wrappers) Descriptor() ([]byte, []int) {
+	return file_module_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *Oneof) GetNumber() *Number {
+func (x *Wrappers) GetF64() *wrapperspb.DoubleValue {
 	if x != nil {
-		return x.Number
+		return x.F64
 	}
 	return nil
 }
 
-func (m *Oneof) GetOneof() isOneof_Oneof {
-	if m != nil {
-		return m.Oneof
+func (x *Wrappers) GetF32() *wrapperspb.FloatValue {
+	if x != nil {
+		return x.F32
 	}
 	return nil
 }
 
-func (x *Oneof) GetString_() *String {
-	if x, ok := x.GetOneof().(*Oneof_String_); ok {
-		return x.String_
+func (x *Wrappers) GetI64() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.I64
 	}
 	return nil
 }
 
-func (x *Oneof) GetBool() *Bool {
-	if x, ok := x.GetOneof().(*Oneof_Bool); ok {
-		return x.Bool
+func (x *Wrappers) GetU64() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.U64
 	}
 	return nil
 }
 
-func (x *Oneof) GetMessage() *Message {
-	if x, ok := x.GetOneof().(*Oneof_Message); ok {
-		return x.Message
+func (x *Wrappers) GetI32() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.I32
 	}
 	return nil
 }
 
-func (x *Oneof) GetArray() *Array {
-	if x, ok := x.GetOneof().(*Oneof_Array); ok {
-		return x.Array
+func (x *Wrappers) GetU32() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.U32
 	}
 	return nil
 }
 
-func (x *Oneof) GetType() Type {
-	if x, ok := x.GetOneof().(*Oneof_Type); ok {
-		return x.Type
-	}
-	return Type_NUMBER
-}
-
-func (x *Oneof) GetU32() uint32 {
-	if x, ok := x.GetOneof().(*Oneof_U32); ok {
-		return x.U32
+func (x *Wrappers) GetB() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.B
 	}
-	return 0
+	return nil
 }
 
-func (x *Oneof) GetStr() string {
-	if x, ok := x.GetOneof().(*Oneof_Str); ok {
+func (x *Wrappers) GetStr() *wrapperspb.StringValue {
+	if x != nil {
 		return x.Str
 	}
-	return ""
+	return nil
 }
 
-func (x *Oneof) GetNumberX() *Number {
+func (x *Wrappers) GetBytes() *wrapperspb.BytesValue {
 	if x != nil {
-		return x.NumberX
+		return x.Bytes
 	}
 	return nil
 }
 
-func (x *Oneof) GetStringX() *String {
+func (x *Wrappers) GetI64S() []*wrapperspb.Int64Value {
 	if x != nil {
-		return x.StringX
+		return x.I64S
 	}
 	return nil
 }
 
-type isOneof_Oneof interface {
-	isOneof_Oneof()
-}
-
-type Oneof_String_ struct {
-	String_ *String `protobuf:"bytes,2,opt,name=string,proto3,oneof"`
+func (x *Wrappers) GetStrs() map[string]*wrapperspb.StringValue {
+	if x != nil {
+		return x.Strs
+	}
+	return nil
 }
 
-type Oneof_Bool struct {
-	Bool *Bool `protobuf:"bytes,3,opt,name=bool,proto3,oneof"`
+func (m *Wrappers) GetOneof() isWrappers_Oneof {
+	if m != nil {
+		return m.Oneof
+	}
+	return nil
 }
 
-type Oneof_Message struct {
-	Message *Message `protobuf:"bytes,4,opt,name=message,proto3,oneof"`
+func (x *Wrappers) GetOb() *wrapperspb.BoolValue {
+	if x, ok := x.GetOneof().(*Wrappers_Ob); ok {
+		return x.Ob
+	}
+	return nil
 }
 
-type Oneof_Array struct {
-	Array *Array `protobuf:"bytes,5,opt,name=array,proto3,oneof"`
+func (x *Wrappers) GetOf64() *wrapperspb.DoubleValue {
+	if x, ok := x.GetOneof().(*Wrappers_Of64); ok {
+		return x.Of64
+	}
+	return nil
 }
 
-type Oneof_Type struct {
-	Type Type `protobuf:"varint,6,opt,name=type,proto3,enum=pb.Type,oneof"`
+type isWrappers_Oneof interface {
+	isWrappers_Oneof()
 }
 
-type Oneof_U32 struct {
-	U32 uint32 `protobuf:"varint,7,opt,name=u32,proto3,oneof"`
+type Wrappers_Ob struct {
+	Ob *wrapperspb.BoolValue `protobuf:"bytes,12,opt,name=ob,proto3,oneof"`
 }
 
-type Oneof_Str struct {
-	Str string `protobuf:"bytes,8,opt,name=str,proto3,oneof"`
+type Wrappers_Of64 struct {
+	Of64 *wrapperspb.DoubleValue `protobuf:"bytes,13,opt,name=of64,proto3,oneof"`
 }
 
-func (*Oneof_String_) isOneof_Oneof() {}
-
-func (*Oneof_Bool) isOneof_Oneof() {}
-
-func (*Oneof_Message) isOneof_Oneof() {}
-
-func (*Oneof_Array) isOneof_Oneof() {}
-
-func (*Oneof_Type) isOneof_Oneof() {}
-
-func (*Oneof_U32) isOneof_Oneof() {}
+func (*Wrappers_Ob) isWrappers_Oneof() {}
 
-func (*Oneof_Str) isOneof_Oneof() {}
+func (*Wrappers_Of64) isWrappers_Oneof() {}
 
 type UnsafeTest struct {
 	state         protoimpl.MessageState
@@ -929,7 +2045,6 @@ type UnsafeTest struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Sub:
-	//
 	//	*UnsafeTest_Sub1_
 	//	*UnsafeTest_Sub2_
 	//	*UnsafeTest_Sub3_
@@ -940,7 +2055,7 @@ type UnsafeTest struct {
 func (x *UnsafeTest) Reset() {
 	*x = UnsafeTest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_module_proto_msgTypes[9]
+		mi := &file_module_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -953,7 +2068,7 @@ func (x *UnsafeTest) String() string {
 func (*UnsafeTest) ProtoMessage() {}
 
 func (x *UnsafeTest) ProtoReflect() protoreflect.Message {
-	mi := &file_module_proto_msgTypes[9]
+	mi := &file_module_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -966,7 +2081,7 @@ func (x *UnsafeTest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnsafeTest.ProtoReflect.Descriptor instead.
 func (*UnsafeTest) Descriptor() ([]byte, []int) {
-	return file_module_proto_rawDescGZIP(), []int{9}
+	return file_module_proto_rawDescGZIP(), []int{19}
 }
 
 func (m *UnsafeTest) GetSub() isUnsafeTest_Sub {
@@ -1044,7 +2159,7 @@ type UnsafeTest_Sub1 struct {
 func (x *UnsafeTest_Sub1) Reset() {
 	*x = UnsafeTest_Sub1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_module_proto_msgTypes[21]
+		mi := &file_module_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1057,7 +2172,7 @@ func (x *UnsafeTest_Sub1) String() string {
 func (*UnsafeTest_Sub1) ProtoMessage() {}
 
 func (x *UnsafeTest_Sub1) ProtoReflect() protoreflect.Message {
-	mi := &file_module_proto_msgTypes[21]
+	mi := &file_module_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1070,7 +2185,7 @@ func (x *UnsafeTest_Sub1) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnsafeTest_Sub1.ProtoReflect.Descriptor instead.
 func (*UnsafeTest_Sub1) Descriptor() ([]byte, []int) {
-	return file_module_proto_rawDescGZIP(), []int{9, 0}
+	return file_module_proto_rawDescGZIP(), []int{19, 0}
 }
 
 func (x *UnsafeTest_Sub1) GetS() string {
@@ -1099,7 +2214,7 @@ type UnsafeTest_Sub2 struct {
 func (x *UnsafeTest_Sub2) Reset() {
 	*x = UnsafeTest_Sub2{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_module_proto_msgTypes[22]
+		mi := &file_module_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1112,7 +2227,7 @@ func (x *UnsafeTest_Sub2) String() string {
 func (*UnsafeTest_Sub2) ProtoMessage() {}
 
 func (x *UnsafeTest_Sub2) ProtoReflect() protoreflect.Message {
-	mi := &file_module_proto_msgTypes[22]
+	mi := &file_module_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1125,7 +2240,7 @@ func (x *UnsafeTest_Sub2) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnsafeTest_Sub2.ProtoReflect.Descriptor instead.
 func (*UnsafeTest_Sub2) Descriptor() ([]byte, []int) {
-	return file_module_proto_rawDescGZIP(), []int{9, 1}
+	return file_module_proto_rawDescGZIP(), []int{19, 1}
 }
 
 func (x *UnsafeTest_Sub2) GetS() []string {
@@ -1153,7 +2268,7 @@ type UnsafeTest_Sub3 struct {
 func (x *UnsafeTest_Sub3) Reset() {
 	*x = UnsafeTest_Sub3{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_module_proto_msgTypes[23]
+		mi := &file_module_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1166,7 +2281,7 @@ func (x *UnsafeTest_Sub3) String() string {
 func (*UnsafeTest_Sub3) ProtoMessage() {}
 
 func (x *UnsafeTest_Sub3) ProtoReflect() protoreflect.Message {
-	mi := &file_module_proto_msgTypes[23]
+	mi := &file_module_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1179,7 +2294,7 @@ func (x *UnsafeTest_Sub3) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnsafeTest_Sub3.ProtoReflect.Descriptor instead.
 func (*UnsafeTest_Sub3) Descriptor() ([]byte, []int) {
-	return file_module_proto_rawDescGZIP(), []int{9, 2}
+	return file_module_proto_rawDescGZIP(), []int{19, 2}
 }
 
 func (x *UnsafeTest_Sub3) GetFoo() map[string]*UnsafeTest_Sub2 {
@@ -1195,7 +2310,6 @@ type UnsafeTest_Sub4 struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Foo:
-	//
 	//	*UnsafeTest_Sub4_S
 	//	*UnsafeTest_Sub4_B
 	Foo isUnsafeTest_Sub4_Foo `protobuf_oneof:"foo"`
@@ -1204,7 +2318,7 @@ type UnsafeTest_Sub4 struct {
 func (x *UnsafeTest_Sub4) Reset() {
 	*x = UnsafeTest_Sub4{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_module_proto_msgTypes[24]
+		mi := &file_module_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1217,7 +2331,7 @@ func (x *UnsafeTest_Sub4) String() string {
 func (*UnsafeTest_Sub4) ProtoMessage() {}
 
 func (x *UnsafeTest_Sub4) ProtoReflect() protoreflect.Message {
-	mi := &file_module_proto_msgTypes[24]
+	mi := &file_module_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1230,7 +2344,7 @@ func (x *UnsafeTest_Sub4) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnsafeTest_Sub4.ProtoReflect.Descriptor instead.
 func (*UnsafeTest_Sub4) Descriptor() ([]byte, []int) {
-	return file_module_proto_rawDescGZIP(), []int{9, 3}
+	return file_module_proto_rawDescGZIP(), []int{19, 3}
 }
 
 func (m *UnsafeTest_Sub4) GetFoo() isUnsafeTest_Sub4_Foo {
@@ -1274,26 +2388,147 @@ var File_module_proto protoreflect.FileDescriptor
 
 var file_module_proto_rawDesc = []byte{
 	0x0a, 0x0c, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02,
-	0x70, 0x62, 0x22, 0xe8, 0x01, 0x0a, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x10, 0x0a,
-	0x03, 0x75, 0x33, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x75, 0x33, 0x32, 0x12,
-	0x10, 0x0a, 0x03, 0x75, 0x36, 0x34, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x75, 0x36,
-	0x34, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x33, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x11, 0x52, 0x03,
-	0x73, 0x33, 0x32, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x36, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x12,
-	0x52, 0x03, 0x73, 0x36, 0x34, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x66, 0x33, 0x32, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x07, 0x52, 0x04, 0x75, 0x66, 0x33, 0x32, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x66, 0x36,
-	0x34, 0x18, 0x06, 0x20, 0x01, 0x28, 0x06, 0x52, 0x04, 0x75, 0x66, 0x36, 0x34, 0x12, 0x12, 0x0a,
-	0x04, 0x73, 0x66, 0x33, 0x32, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0f, 0x52, 0x04, 0x73, 0x66, 0x33,
-	0x32, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x66, 0x36, 0x34, 0x18, 0x08, 0x20, 0x01, 0x28, 0x10, 0x52,
-	0x04, 0x73, 0x66, 0x36, 0x34, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x33, 0x32, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x03, 0x69, 0x33, 0x32, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x36, 0x34, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x69, 0x36, 0x34, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x36, 0x34,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x66, 0x36, 0x34, 0x12, 0x10, 0x0a, 0x03, 0x66,
-	0x33, 0x32, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x03, 0x66, 0x33, 0x32, 0x22, 0x30, 0x0a,
-	0x06, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x74, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x74, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22,
-	0x14, 0x0a, 0x04, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x0c, 0x0a, 0x01, 0x62, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x01, 0x62, 0x22, 0x8d, 0x01, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x70, 0x62, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61,
+	0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe8, 0x01, 0x0a, 0x06,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x33, 0x32, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x03, 0x75, 0x33, 0x32, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x36, 0x34, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x75, 0x36, 0x34, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x33,
+	0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x11, 0x52, 0x03, 0x73, 0x33, 0x32, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x36, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x12, 0x52, 0x03, 0x73, 0x36, 0x34, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x66, 0x33, 0x32, 0x18, 0x05, 0x20, 0x01, 0x28, 0x07, 0x52, 0x04, 0x75, 0x66,
+	0x33, 0x32, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x66, 0x36, 0x34, 0x18, 0x06, 0x20, 0x01, 0x28, 0x06,
+	0x52, 0x04, 0x75, 0x66, 0x36, 0x34, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x66, 0x33, 0x32, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0f, 0x52, 0x04, 0x73, 0x66, 0x33, 0x32, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x66,
+	0x36, 0x34, 0x18, 0x08, 0x20, 0x01, 0x28, 0x10, 0x52, 0x04, 0x73, 0x66, 0x36, 0x34, 0x12, 0x10,
+	0x0a, 0x03, 0x69, 0x33, 0x32, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x69, 0x33, 0x32,
+	0x12, 0x10, 0x0a, 0x03, 0x69, 0x36, 0x34, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x69,
+	0x36, 0x34, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x36, 0x34, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x03, 0x66, 0x36, 0x34, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x33, 0x32, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x03, 0x66, 0x33, 0x32, 0x22, 0xec, 0x01, 0x0a, 0x0a, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x33, 0x32, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0d, 0x52, 0x03, 0x75, 0x33, 0x32, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x36, 0x34, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x04, 0x52, 0x03, 0x75, 0x36, 0x34, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x33, 0x32,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x11, 0x52, 0x03, 0x73, 0x33, 0x32, 0x12, 0x10, 0x0a, 0x03, 0x73,
+	0x36, 0x34, 0x18, 0x04, 0x20, 0x03, 0x28, 0x12, 0x52, 0x03, 0x73, 0x36, 0x34, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x66, 0x33, 0x32, 0x18, 0x05, 0x20, 0x03, 0x28, 0x07, 0x52, 0x04, 0x75, 0x66, 0x33,
+	0x32, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x66, 0x36, 0x34, 0x18, 0x06, 0x20, 0x03, 0x28, 0x06, 0x52,
+	0x04, 0x75, 0x66, 0x36, 0x34, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x66, 0x33, 0x32, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0f, 0x52, 0x04, 0x73, 0x66, 0x33, 0x32, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x66, 0x36,
+	0x34, 0x18, 0x08, 0x20, 0x03, 0x28, 0x10, 0x52, 0x04, 0x73, 0x66, 0x36, 0x34, 0x12, 0x10, 0x0a,
+	0x03, 0x69, 0x33, 0x32, 0x18, 0x09, 0x20, 0x03, 0x28, 0x05, 0x52, 0x03, 0x69, 0x33, 0x32, 0x12,
+	0x10, 0x0a, 0x03, 0x69, 0x36, 0x34, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x69, 0x36,
+	0x34, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x36, 0x34, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x01, 0x52, 0x03,
+	0x66, 0x36, 0x34, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x33, 0x32, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x02,
+	0x52, 0x03, 0x66, 0x33, 0x32, 0x22, 0xb3, 0x09, 0x0a, 0x09, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x4d, 0x61, 0x70, 0x12, 0x28, 0x0a, 0x03, 0x75, 0x33, 0x32, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x2e,
+	0x55, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x75, 0x33, 0x32, 0x12, 0x28, 0x0a,
+	0x03, 0x75, 0x36, 0x34, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x2e,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x2e, 0x55, 0x36, 0x34, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x03, 0x75, 0x36, 0x34, 0x12, 0x28, 0x0a, 0x03, 0x73, 0x33, 0x32, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x4d, 0x61, 0x70, 0x2e, 0x53, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x73, 0x33,
+	0x32, 0x12, 0x28, 0x0a, 0x03, 0x73, 0x36, 0x34, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x2e, 0x53, 0x36,
+	0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x73, 0x36, 0x34, 0x12, 0x2b, 0x0a, 0x04, 0x75,
+	0x66, 0x33, 0x32, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x2e, 0x55, 0x66, 0x33, 0x32, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x04, 0x75, 0x66, 0x33, 0x32, 0x12, 0x2b, 0x0a, 0x04, 0x75, 0x66, 0x36, 0x34,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x4d, 0x61, 0x70, 0x2e, 0x55, 0x66, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x04, 0x75, 0x66, 0x36, 0x34, 0x12, 0x2b, 0x0a, 0x04, 0x73, 0x66, 0x33, 0x32, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4d,
+	0x61, 0x70, 0x2e, 0x53, 0x66, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x73, 0x66,
+	0x33, 0x32, 0x12, 0x2b, 0x0a, 0x04, 0x73, 0x66, 0x36, 0x34, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x2e,
+	0x53, 0x66, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x73, 0x66, 0x36, 0x34, 0x12,
+	0x28, 0x0a, 0x03, 0x69, 0x33, 0x32, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70,
+	0x62, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x2e, 0x49, 0x33, 0x32, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x69, 0x33, 0x32, 0x12, 0x28, 0x0a, 0x03, 0x69, 0x36, 0x34,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x4d, 0x61, 0x70, 0x2e, 0x49, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03,
+	0x69, 0x36, 0x34, 0x12, 0x28, 0x0a, 0x03, 0x66, 0x36, 0x34, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x2e,
+	0x46, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x66, 0x36, 0x34, 0x12, 0x28, 0x0a,
+	0x03, 0x66, 0x33, 0x32, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x2e,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x2e, 0x46, 0x33, 0x32, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x03, 0x66, 0x33, 0x32, 0x1a, 0x36, 0x0a, 0x08, 0x55, 0x33, 0x32, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x36, 0x0a, 0x08, 0x55, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x36, 0x0a, 0x08, 0x53, 0x33, 0x32, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x11,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x11, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x36, 0x0a, 0x08, 0x53, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x12, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x12, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x37, 0x0a, 0x09, 0x55, 0x66, 0x33, 0x32, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x07, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x07, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x37, 0x0a, 0x09, 0x55, 0x66, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x06, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x37, 0x0a, 0x09, 0x53, 0x66, 0x33,
+	0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0f, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x37, 0x0a, 0x09, 0x53, 0x66, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x10, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x10,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x36, 0x0a, 0x08, 0x49,
+	0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x36, 0x0a, 0x08, 0x49, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x36, 0x0a, 0x08, 0x46,
+	0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x36, 0x0a, 0x08, 0x46, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x30, 0x0a, 0x06, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x74, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x73, 0x74, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0x14, 0x0a,
+	0x04, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x0c, 0x0a, 0x01, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x01, 0x62, 0x22, 0x8f, 0x02, 0x0a, 0x05, 0x45, 0x6e, 0x75, 0x6d, 0x73, 0x12, 0x1c, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x08, 0x2e, 0x70, 0x62,
+	0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x05, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x03, 0x6d,
+	0x61, 0x70, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6e,
+	0x75, 0x6d, 0x73, 0x2e, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x6d, 0x61,
+	0x70, 0x12, 0x2e, 0x0a, 0x04, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x75, 0x6c,
+	0x6c, 0x12, 0x30, 0x0a, 0x05, 0x6e, 0x75, 0x6c, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x6e, 0x75,
+	0x6c, 0x6c, 0x73, 0x1a, 0x40, 0x0a, 0x08, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x1e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8d, 0x01, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
 	0x65, 0x12, 0x1c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
 	0x08, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
 	0x22, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
@@ -1443,39 +2678,193 @@ var file_module_proto_rawDesc = []byte{
 	0x72, 0x52, 0x07, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x58, 0x12, 0x25, 0x0a, 0x08, 0x73, 0x74,
 	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70,
 	0x62, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x58, 0x42, 0x07, 0x0a, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x22, 0xbc, 0x03, 0x0a, 0x0a, 0x55,
-	0x6e, 0x73, 0x61, 0x66, 0x65, 0x54, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x04, 0x73, 0x75, 0x62,
-	0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73,
-	0x61, 0x66, 0x65, 0x54, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x31, 0x48, 0x00, 0x52, 0x04,
-	0x73, 0x75, 0x62, 0x31, 0x12, 0x29, 0x0a, 0x04, 0x73, 0x75, 0x62, 0x32, 0x18, 0x02, 0x20, 0x01,
+	0x58, 0x42, 0x07, 0x0a, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x22, 0x6f, 0x0a, 0x0a, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0c, 0x0a, 0x01, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x01, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x01, 0x63, 0x12, 0x11, 0x0a, 0x01, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x00, 0x52, 0x01, 0x64, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x01, 0x61, 0x42, 0x04, 0x0a, 0x02, 0x5f, 0x64, 0x4a,
+	0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x09, 0x22, 0xf0, 0x01, 0x0a, 0x0a,
+	0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x46, 0x69, 0x72, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x01, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x01, 0x73, 0x12, 0x0e, 0x0a, 0x01, 0x75, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x01, 0x75, 0x12, 0x29, 0x0a, 0x03, 0x6d, 0x61,
+	0x70, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x6e, 0x65,
+	0x6f, 0x66, 0x46, 0x69, 0x72, 0x73, 0x74, 0x2e, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x03, 0x6d, 0x61, 0x70, 0x12, 0x1c, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x04, 0x62,
+	0x6f, 0x6f, 0x6c, 0x12, 0x0e, 0x0a, 0x01, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x01, 0x74, 0x12, 0x18, 0x0a, 0x01, 0x62, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08,
+	0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x48, 0x01, 0x52, 0x01, 0x62, 0x1a, 0x36, 0x0a,
+	0x08, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x42, 0x08,
+	0x0a, 0x06, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0x16,
+	0x0a, 0x06, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x01, 0x73, 0x22, 0xd0, 0x05, 0x0a, 0x09, 0x57, 0x65, 0x6c, 0x6c, 0x4b,
+	0x6e, 0x6f, 0x77, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x35,
+	0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x73, 0x12, 0x3a, 0x0a, 0x09, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x6c, 0x6c, 0x4b, 0x6e,
+	0x6f, 0x77, 0x6e, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x09, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a,
+	0x02, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x02, 0x61, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x2e,
+	0x0a, 0x04, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x6b, 0x12, 0x2c,
+	0x0a, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x05,
+	0x6d, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x05, 0x6d, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x34,
+	0x0a, 0x07, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6d, 0x70,
+	0x74, 0x69, 0x65, 0x73, 0x1a, 0x57, 0x0a, 0x0e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x52, 0x0a,
+	0x0c, 0x45, 0x6d, 0x70, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xaf, 0x03, 0x0a, 0x07, 0x53, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x06,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04,
+	0x6c, 0x69, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x07, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x02, 0x6f, 0x76, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x02,
+	0x6f, 0x76, 0x12, 0x29, 0x0a, 0x02, 0x6f, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x73, 0x1a, 0x53, 0x0a,
+	0x0c, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x22, 0xcb, 0x01, 0x0a, 0x04,
+	0x41, 0x6e, 0x79, 0x73, 0x12, 0x26, 0x0a, 0x03, 0x61, 0x6e, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x03, 0x61, 0x6e, 0x79, 0x12, 0x28, 0x0a, 0x04,
+	0x61, 0x6e, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79,
+	0x52, 0x04, 0x61, 0x6e, 0x79, 0x73, 0x12, 0x23, 0x0a, 0x03, 0x6d, 0x61, 0x70, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x6e, 0x79, 0x73, 0x2e, 0x4d, 0x61,
+	0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x6d, 0x61, 0x70, 0x1a, 0x4c, 0x0a, 0x08, 0x4d,
+	0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd3, 0x05, 0x0a, 0x08, 0x57, 0x72,
+	0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x03, 0x66, 0x36, 0x34, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x03, 0x66, 0x36, 0x34, 0x12, 0x2d, 0x0a, 0x03, 0x66, 0x33, 0x32, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x03, 0x66, 0x33, 0x32, 0x12, 0x2d, 0x0a, 0x03, 0x69, 0x36, 0x34, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x03, 0x69, 0x36, 0x34, 0x12, 0x2e, 0x0a, 0x03, 0x75, 0x36, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x03, 0x75, 0x36, 0x34, 0x12, 0x2d, 0x0a, 0x03, 0x69, 0x33, 0x32, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03,
+	0x69, 0x33, 0x32, 0x12, 0x2e, 0x0a, 0x03, 0x75, 0x33, 0x32, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03,
+	0x75, 0x33, 0x32, 0x12, 0x28, 0x0a, 0x01, 0x62, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x01, 0x62, 0x12, 0x2e, 0x0a,
+	0x03, 0x73, 0x74, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x73, 0x74, 0x72, 0x12, 0x31, 0x0a,
+	0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x2f, 0x0a, 0x04, 0x69, 0x36, 0x34, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x69, 0x36, 0x34,
+	0x73, 0x12, 0x2a, 0x0a, 0x04, 0x73, 0x74, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x53, 0x74,
+	0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x73, 0x74, 0x72, 0x73, 0x12, 0x2c, 0x0a,
+	0x02, 0x6f, 0x62, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x62, 0x12, 0x32, 0x0a, 0x04, 0x6f,
+	0x66, 0x36, 0x34, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62,
+	0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x04, 0x6f, 0x66, 0x36, 0x34, 0x1a,
+	0x55, 0x0a, 0x09, 0x53, 0x74, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x22,
+	0xbc, 0x03, 0x0a, 0x0a, 0x55, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x54, 0x65, 0x73, 0x74, 0x12, 0x29,
+	0x0a, 0x04, 0x73, 0x75, 0x62, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70,
+	0x62, 0x2e, 0x55, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x54, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x62,
+	0x31, 0x48, 0x00, 0x52, 0x04, 0x73, 0x75, 0x62, 0x31, 0x12, 0x29, 0x0a, 0x04, 0x73, 0x75, 0x62,
+	0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73,
+	0x61, 0x66, 0x65, 0x54, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x32, 0x48, 0x00, 0x52, 0x04,
+	0x73, 0x75, 0x62, 0x32, 0x12, 0x29, 0x0a, 0x04, 0x73, 0x75, 0x62, 0x33, 0x18, 0x03, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x54, 0x65,
-	0x73, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x32, 0x48, 0x00, 0x52, 0x04, 0x73, 0x75, 0x62, 0x32, 0x12,
-	0x29, 0x0a, 0x04, 0x73, 0x75, 0x62, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x73, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x33, 0x48, 0x00, 0x52, 0x04, 0x73, 0x75, 0x62, 0x33, 0x12,
+	0x29, 0x0a, 0x04, 0x73, 0x75, 0x62, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
 	0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x54, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75,
-	0x62, 0x33, 0x48, 0x00, 0x52, 0x04, 0x73, 0x75, 0x62, 0x33, 0x12, 0x29, 0x0a, 0x04, 0x73, 0x75,
-	0x62, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e,
-	0x73, 0x61, 0x66, 0x65, 0x54, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x34, 0x48, 0x00, 0x52,
-	0x04, 0x73, 0x75, 0x62, 0x34, 0x1a, 0x22, 0x0a, 0x04, 0x53, 0x75, 0x62, 0x31, 0x12, 0x0c, 0x0a,
-	0x01, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x73, 0x12, 0x0c, 0x0a, 0x01, 0x62,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x01, 0x62, 0x1a, 0x22, 0x0a, 0x04, 0x53, 0x75, 0x62,
-	0x32, 0x12, 0x0c, 0x0a, 0x01, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x01, 0x73, 0x12,
-	0x0c, 0x0a, 0x01, 0x62, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x01, 0x62, 0x1a, 0x83, 0x01,
-	0x0a, 0x04, 0x53, 0x75, 0x62, 0x33, 0x12, 0x2e, 0x0a, 0x03, 0x66, 0x6f, 0x6f, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x54,
-	0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x33, 0x2e, 0x46, 0x6f, 0x6f, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x03, 0x66, 0x6f, 0x6f, 0x1a, 0x4b, 0x0a, 0x08, 0x46, 0x6f, 0x6f, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x54,
-	0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x32, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x1a, 0x2d, 0x0a, 0x04, 0x53, 0x75, 0x62, 0x34, 0x12, 0x0e, 0x0a, 0x01, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x01, 0x73, 0x12, 0x0e, 0x0a, 0x01, 0x62,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x01, 0x62, 0x42, 0x05, 0x0a, 0x03, 0x66,
-	0x6f, 0x6f, 0x42, 0x05, 0x0a, 0x03, 0x73, 0x75, 0x62, 0x2a, 0x28, 0x0a, 0x04, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0a, 0x0a,
-	0x06, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x4f, 0x4f,
-	0x4c, 0x10, 0x02, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x62, 0x34, 0x48, 0x00, 0x52, 0x04, 0x73, 0x75, 0x62, 0x34, 0x1a, 0x22, 0x0a, 0x04, 0x53, 0x75,
+	0x62, 0x31, 0x12, 0x0c, 0x0a, 0x01, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x73,
+	0x12, 0x0c, 0x0a, 0x01, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x01, 0x62, 0x1a, 0x22,
+	0x0a, 0x04, 0x53, 0x75, 0x62, 0x32, 0x12, 0x0c, 0x0a, 0x01, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x01, 0x73, 0x12, 0x0c, 0x0a, 0x01, 0x62, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52,
+	0x01, 0x62, 0x1a, 0x83, 0x01, 0x0a, 0x04, 0x53, 0x75, 0x62, 0x33, 0x12, 0x2e, 0x0a, 0x03, 0x66,
+	0x6f, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e,
+	0x73, 0x61, 0x66, 0x65, 0x54, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x33, 0x2e, 0x46, 0x6f,
+	0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x66, 0x6f, 0x6f, 0x1a, 0x4b, 0x0a, 0x08, 0x46,
+	0x6f, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e,
+	0x73, 0x61, 0x66, 0x65, 0x54, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x32, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x2d, 0x0a, 0x04, 0x53, 0x75, 0x62, 0x34,
+	0x12, 0x0e, 0x0a, 0x01, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x01, 0x73,
+	0x12, 0x0e, 0x0a, 0x01, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x01, 0x62,
+	0x42, 0x05, 0x0a, 0x03, 0x66, 0x6f, 0x6f, 0x42, 0x05, 0x0a, 0x03, 0x73, 0x75, 0x62, 0x2a, 0x28,
+	0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52,
+	0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x08,
+	0x0a, 0x04, 0x42, 0x4f, 0x4f, 0x4c, 0x10, 0x02, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1491,92 +2880,198 @@ func file_module_proto_rawDescGZIP() []byte {
 }
 
 var file_module_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_module_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_module_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
 var file_module_proto_goTypes = []interface{}{
-	(Type)(0),               // 0: pb.Type
-	(*Number)(nil),          // 1: pb.Number
-	(*String)(nil),          // 2: pb.String
-	(*Bool)(nil),            // 3: pb.Bool
-	(*Message)(nil),         // 4: pb.Message
-	(*Array)(nil),           // 5: pb.Array
-	(*Map)(nil),             // 6: pb.Map
-	(*Empty)(nil),           // 7: pb.Empty
-	(*Optional)(nil),        // 8: pb.Optional
-	(*Oneof)(nil),           // 9: pb.Oneof
-	(*UnsafeTest)(nil),      // 10: pb.UnsafeTest
-	nil,                     // 11: pb.Map.NumbersEntry
-	nil,                     // 12: pb.Map.StringsEntry
-	nil,                     // 13: pb.Map.BoolsEntry
-	nil,                     // 14: pb.Map.MessagesEntry
-	nil,                     // 15: pb.Map.ArraysEntry
-	nil,                     // 16: pb.Map.TypesEntry
-	nil,                     // 17: pb.Map.U32sEntry
-	nil,                     // 18: pb.Map.StrsEntry
-	nil,                     // 19: pb.Map.EmptiesEntry
-	nil,                     // 20: pb.Map.OptionalsEntry
-	nil,                     // 21: pb.Map.OneofsEntry
-	(*UnsafeTest_Sub1)(nil), // 22: pb.UnsafeTest.Sub1
-	(*UnsafeTest_Sub2)(nil), // 23: pb.UnsafeTest.Sub2
-	(*UnsafeTest_Sub3)(nil), // 24: pb.UnsafeTest.Sub3
-	(*UnsafeTest_Sub4)(nil), // 25: pb.UnsafeTest.Sub4
-	nil,                     // 26: pb.UnsafeTest.Sub3.FooEntry
+	(Type)(0),                      // 0: pb.Type
+	(*Number)(nil),                 // 1: pb.Number
+	(*NumberList)(nil),             // 2: pb.NumberList
+	(*NumberMap)(nil),              // 3: pb.NumberMap
+	(*String)(nil),                 // 4: pb.String
+	(*Bool)(nil),                   // 5: pb.Bool
+	(*Enums)(nil),                  // 6: pb.Enums
+	(*Message)(nil),                // 7: pb.Message
+	(*Array)(nil),                  // 8: pb.Array
+	(*Map)(nil),                    // 9: pb.Map
+	(*Empty)(nil),                  // 10: pb.Empty
+	(*Optional)(nil),               // 11: pb.Optional
+	(*Oneof)(nil),                  // 12: pb.Oneof
+	(*FieldOrder)(nil),             // 13: pb.FieldOrder
+	(*OneofFirst)(nil),             // 14: pb.OneofFirst
+	(*Single)(nil),                 // 15: pb.Single
+	(*WellKnown)(nil),              // 16: pb.WellKnown
+	(*Structs)(nil),                // 17: pb.Structs
+	(*Anys)(nil),                   // 18: pb.Anys
+	(*Wrappers)(nil),               // 19: pb.Wrappers
+	(*UnsafeTest)(nil),             // 20: pb.UnsafeTest
+	nil,                            // 21: pb.NumberMap.U32Entry
+	nil,                            // 22: pb.NumberMap.U64Entry
+	nil,                            // 23: pb.NumberMap.S32Entry
+	nil,                            // 24: pb.NumberMap.S64Entry
+	nil,                            // 25: pb.NumberMap.Uf32Entry
+	nil,                            // 26: pb.NumberMap.Uf64Entry
+	nil,                            // 27: pb.NumberMap.Sf32Entry
+	nil,                            // 28: pb.NumberMap.Sf64Entry
+	nil,                            // 29: pb.NumberMap.I32Entry
+	nil,                            // 30: pb.NumberMap.I64Entry
+	nil,                            // 31: pb.NumberMap.F64Entry
+	nil,                            // 32: pb.NumberMap.F32Entry
+	nil,                            // 33: pb.Enums.MapEntry
+	nil,                            // 34: pb.Map.NumbersEntry
+	nil,                            // 35: pb.Map.StringsEntry
+	nil,                            // 36: pb.Map.BoolsEntry
+	nil,                            // 37: pb.Map.MessagesEntry
+	nil,                            // 38: pb.Map.ArraysEntry
+	nil,                            // 39: pb.Map.TypesEntry
+	nil,                            // 40: pb.Map.U32sEntry
+	nil,                            // 41: pb.Map.StrsEntry
+	nil,                            // 42: pb.Map.EmptiesEntry
+	nil,                            // 43: pb.Map.OptionalsEntry
+	nil,                            // 44: pb.Map.OneofsEntry
+	nil,                            // 45: pb.OneofFirst.MapEntry
+	nil,                            // 46: pb.WellKnown.DurationsEntry
+	nil,                            // 47: pb.WellKnown.EmptiesEntry
+	nil,                            // 48: pb.Structs.StructsEntry
+	nil,                            // 49: pb.Anys.MapEntry
+	nil,                            // 50: pb.Wrappers.StrsEntry
+	(*UnsafeTest_Sub1)(nil),        // 51: pb.UnsafeTest.Sub1
+	(*UnsafeTest_Sub2)(nil),        // 52: pb.UnsafeTest.Sub2
+	(*UnsafeTest_Sub3)(nil),        // 53: pb.UnsafeTest.Sub3
+	(*UnsafeTest_Sub4)(nil),        // 54: pb.UnsafeTest.Sub4
+	nil,                            // 55: pb.UnsafeTest.Sub3.FooEntry
+	(structpb.NullValue)(0),        // 56: google.protobuf.NullValue
+	(*timestamppb.Timestamp)(nil),  // 57: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),    // 58: google.protobuf.Duration
+	(*fieldmaskpb.FieldMask)(nil),  // 59: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),          // 60: google.protobuf.Empty
+	(*structpb.Struct)(nil),        // 61: google.protobuf.Struct
+	(*structpb.Value)(nil),         // 62: google.protobuf.Value
+	(*structpb.ListValue)(nil),     // 63: google.protobuf.ListValue
+	(*anypb.Any)(nil),              // 64: google.protobuf.Any
+	(*wrapperspb.DoubleValue)(nil), // 65: google.protobuf.DoubleValue
+	(*wrapperspb.FloatValue)(nil),  // 66: google.protobuf.FloatValue
+	(*wrapperspb.Int64Value)(nil),  // 67: google.protobuf.Int64Value
+	(*wrapperspb.UInt64Value)(nil), // 68: google.protobuf.UInt64Value
+	(*wrapperspb.Int32Value)(nil),  // 69: google.protobuf.Int32Value
+	(*wrapperspb.UInt32Value)(nil), // 70: google.protobuf.UInt32Value
+	(*wrapperspb.BoolValue)(nil),   // 71: google.protobuf.BoolValue
+	(*wrapperspb.StringValue)(nil), // 72: google.protobuf.StringValue
+	(*wrapperspb.BytesValue)(nil),  // 73: google.protobuf.BytesValue
 }
 var file_module_proto_depIdxs = []int32{
-	0,  // 0: pb.Message.type:type_name -> pb.Type
-	1,  // 1: pb.Message.number:type_name -> pb.Number
-	2,  // 2: pb.Message.string:type_name -> pb.String
-	3,  // 3: pb.Message.bool:type_name -> pb.Bool
-	1,  // 4: pb.Array.numbers:type_name -> pb.Number
-	2,  // 5: pb.Array.strings:type_name -> pb.String
-	3,  // 6: pb.Array.bools:type_name -> pb.Bool
-	4,  // 7: pb.Array.messages:type_name -> pb.Message
-	5,  // 8: pb.Array.arrays:type_name -> pb.Array
-	0,  // 9: pb.Array.types:type_name -> pb.Type
-	11, // 10: pb.Map.numbers:type_name -> pb.Map.NumbersEntry
-	12, // 11: pb.Map.strings:type_name -> pb.Map.StringsEntry
-	13, // 12: pb.Map.bools:type_name -> pb.Map.BoolsEntry
-	14, // 13: pb.Map.messages:type_name -> pb.Map.MessagesEntry
-	15, // 14: pb.Map.arrays:type_name -> pb.Map.ArraysEntry
-	16, // 15: pb.Map.types:type_name -> pb.Map.TypesEntry
-	17, // 16: pb.Map.u32s:type_name -> pb.Map.U32sEntry
-	18, // 17: pb.Map.strs:type_name -> pb.Map.StrsEntry
-	19, // 18: pb.Map.empties:type_name -> pb.Map.EmptiesEntry
-	20, // 19: pb.Map.optionals:type_name -> pb.Map.OptionalsEntry
-	21, // 20: pb.Map.oneofs:type_name -> pb.Map.OneofsEntry
-	1,  // 21: pb.Optional.number:type_name -> pb.Number
-	2,  // 22: pb.Optional.string:type_name -> pb.String
-	3,  // 23: pb.Optional.bool:type_name -> pb.Bool
-	4,  // 24: pb.Optional.message:type_name -> pb.Message
-	5,  // 25: pb.Optional.array:type_name -> pb.Array
-	0,  // 26: pb.Optional.type:type_name -> pb.Type
-	1,  // 27: pb.Oneof.number:type_name -> pb.Number
-	2,  // 28: pb.Oneof.string:type_name -> pb.String
-	3,  // 29: pb.Oneof.bool:type_name -> pb.Bool
-	4,  // 30: pb.Oneof.message:type_name -> pb.Message
-	5,  // 31: pb.Oneof.array:type_name -> pb.Array
-	0,  // 32: pb.Oneof.type:type_name -> pb.Type
-	1,  // 33: pb.Oneof.number_x:type_name -> pb.Number
-	2,  // 34: pb.Oneof.string_x:type_name -> pb.String
-	22, // 35: pb.UnsafeTest.sub1:type_name -> pb.UnsafeTest.Sub1
-	23, // 36: pb.UnsafeTest.sub2:type_name -> pb.UnsafeTest.Sub2
-	24, // 37: pb.UnsafeTest.sub3:type_name -> pb.UnsafeTest.Sub3
-	25, // 38: pb.UnsafeTest.sub4:type_name -> pb.UnsafeTest.Sub4
-	1,  // 39: pb.Map.NumbersEntry.value:type_name -> pb.Number
-	2,  // 40: pb.Map.StringsEntry.value:type_name -> pb.String
-	3,  // 41: pb.Map.BoolsEntry.value:type_name -> pb.Bool
-	4,  // 42: pb.Map.MessagesEntry.value:type_name -> pb.Message
-	5,  // 43: pb.Map.ArraysEntry.value:type_name -> pb.Array
-	0,  // 44: pb.Map.TypesEntry.value:type_name -> pb.Type
-	7,  // 45: pb.Map.EmptiesEntry.value:type_name -> pb.Empty
-	8,  // 46: pb.Map.OptionalsEntry.value:type_name -> pb.Optional
-	9,  // 47: pb.Map.OneofsEntry.value:type_name -> pb.Oneof
-	26, // 48: pb.UnsafeTest.Sub3.foo:type_name -> pb.UnsafeTest.Sub3.FooEntry
-	23, // 49: pb.UnsafeTest.Sub3.FooEntry.value:type_name -> pb.UnsafeTest.Sub2
-	50, // [50:50] is the sub-list for method output_type
-	50, // [50:50] is the sub-list for method input_type
-	50, // [50:50] is the sub-list for extension type_name
-	50, // [50:50] is the sub-list for extension extendee
-	0,  // [0:50] is the sub-list for field type_name
+	21,  // 0: pb.NumberMap.u32:type_name -> pb.NumberMap.U32Entry
+	22,  // 1: pb.NumberMap.u64:type_name -> pb.NumberMap.U64Entry
+	23,  // 2: pb.NumberMap.s32:type_name -> pb.NumberMap.S32Entry
+	24,  // 3: pb.NumberMap.s64:type_name -> pb.NumberMap.S64Entry
+	25,  // 4: pb.NumberMap.uf32:type_name -> pb.NumberMap.Uf32Entry
+	26,  // 5: pb.NumberMap.uf64:type_name -> pb.NumberMap.Uf64Entry
+	27,  // 6: pb.NumberMap.sf32:type_name -> pb.NumberMap.Sf32Entry
+	28,  // 7: pb.NumberMap.sf64:type_name -> pb.NumberMap.Sf64Entry
+	29,  // 8: pb.NumberMap.i32:type_name -> pb.NumberMap.I32Entry
+	30,  // 9: pb.NumberMap.i64:type_name -> pb.NumberMap.I64Entry
+	31,  // 10: pb.NumberMap.f64:type_name -> pb.NumberMap.F64Entry
+	32,  // 11: pb.NumberMap.f32:type_name -> pb.NumberMap.F32Entry
+	0,   // 12: pb.Enums.type:type_name -> pb.Type
+	0,   // 13: pb.Enums.types:type_name -> pb.Type
+	33,  // 14: pb.Enums.map:type_name -> pb.Enums.MapEntry
+	56,  // 15: pb.Enums.null:type_name -> google.protobuf.NullValue
+	56,  // 16: pb.Enums.nulls:type_name -> google.protobuf.NullValue
+	0,   // 17: pb.Message.type:type_name -> pb.Type
+	1,   // 18: pb.Message.number:type_name -> pb.Number
+	4,   // 19: pb.Message.string:type_name -> pb.String
+	5,   // 20: pb.Message.bool:type_name -> pb.Bool
+	1,   // 21: pb.Array.numbers:type_name -> pb.Number
+	4,   // 22: pb.Array.strings:type_name -> pb.String
+	5,   // 23: pb.Array.bools:type_name -> pb.Bool
+	7,   // 24: pb.Array.messages:type_name -> pb.Message
+	8,   // 25: pb.Array.arrays:type_name -> pb.Array
+	0,   // 26: pb.Array.types:type_name -> pb.Type
+	34,  // 27: pb.Map.numbers:type_name -> pb.Map.NumbersEntry
+	35,  // 28: pb.Map.strings:type_name -> pb.Map.StringsEntry
+	36,  // 29: pb.Map.bools:type_name -> pb.Map.BoolsEntry
+	37,  // 30: pb.Map.messages:type_name -> pb.Map.MessagesEntry
+	38,  // 31: pb.Map.arrays:type_name -> pb.Map.ArraysEntry
+	39,  // 32: pb.Map.types:type_name -> pb.Map.TypesEntry
+	40,  // 33: pb.Map.u32s:type_name -> pb.Map.U32sEntry
+	41,  // 34: pb.Map.strs:type_name -> pb.Map.StrsEntry
+	42,  // 35: pb.Map.empties:type_name -> pb.Map.EmptiesEntry
+	43,  // 36: pb.Map.optionals:type_name -> pb.Map.OptionalsEntry
+	44,  // 37: pb.Map.oneofs:type_name -> pb.Map.OneofsEntry
+	1,   // 38: pb.Optional.number:type_name -> pb.Number
+	4,   // 39: pb.Optional.string:type_name -> pb.String
+	5,   // 40: pb.Optional.bool:type_name -> pb.Bool
+	7,   // 41: pb.Optional.message:type_name -> pb.Message
+	8,   // 42: pb.Optional.array:type_name -> pb.Array
+	0,   // 43: pb.Optional.type:type_name -> pb.Type
+	1,   // 44: pb.Oneof.number:type_name -> pb.Number
+	4,   // 45: pb.Oneof.string:type_name -> pb.String
+	5,   // 46: pb.Oneof.bool:type_name -> pb.Bool
+	7,   // 47: pb.Oneof.message:type_name -> pb.Message
+	8,   // 48: pb.Oneof.array:type_name -> pb.Array
+	0,   // 49: pb.Oneof.type:type_name -> pb.Type
+	1,   // 50: pb.Oneof.number_x:type_name -> pb.Number
+	4,   // 51: pb.Oneof.string_x:type_name -> pb.String
+	45,  // 52: pb.OneofFirst.map:type_name -> pb.OneofFirst.MapEntry
+	5,   // 53: pb.OneofFirst.bool:type_name -> pb.Bool
+	5,   // 54: pb.OneofFirst.b:type_name -> pb.Bool
+	57,  // 55: pb.WellKnown.timestamp:type_name -> google.protobuf.Timestamp
+	58,  // 56: pb.WellKnown.duration:type_name -> google.protobuf.Duration
+	57,  // 57: pb.WellKnown.timestamps:type_name -> google.protobuf.Timestamp
+	46,  // 58: pb.WellKnown.durations:type_name -> pb.WellKnown.DurationsEntry
+	57,  // 59: pb.WellKnown.at:type_name -> google.protobuf.Timestamp
+	58,  // 60: pb.WellKnown.after:type_name -> google.protobuf.Duration
+	59,  // 61: pb.WellKnown.mask:type_name -> google.protobuf.FieldMask
+	60,  // 62: pb.WellKnown.empty:type_name -> google.protobuf.Empty
+	59,  // 63: pb.WellKnown.masks:type_name -> google.protobuf.FieldMask
+	47,  // 64: pb.WellKnown.empties:type_name -> pb.WellKnown.EmptiesEntry
+	61,  // 65: pb.Structs.struct:type_name -> google.protobuf.Struct
+	62,  // 66: pb.Structs.value:type_name -> google.protobuf.Value
+	63,  // 67: pb.Structs.list:type_name -> google.protobuf.ListValue
+	62,  // 68: pb.Structs.values:type_name -> google.protobuf.Value
+	48,  // 69: pb.Structs.structs:type_name -> pb.Structs.StructsEntry
+	62,  // 70: pb.Structs.ov:type_name -> google.protobuf.Value
+	61,  // 71: pb.Structs.os:type_name -> google.protobuf.Struct
+	64,  // 72: pb.Anys.any:type_name -> google.protobuf.Any
+	64,  // 73: pb.Anys.anys:type_name -> google.protobuf.Any
+	49,  // 74: pb.Anys.map:type_name -> pb.Anys.MapEntry
+	65,  // 75: pb.Wrappers.f64:type_name -> google.protobuf.DoubleValue
+	66,  // 76: pb.Wrappers.f32:type_name -> google.protobuf.FloatValue
+	67,  // 77: pb.Wrappers.i64:type_name -> google.protobuf.Int64Value
+	68,  // 78: pb.Wrappers.u64:type_name -> google.protobuf.UInt64Value
+	69,  // 79: pb.Wrappers.i32:type_name -> google.protobuf.Int32Value
+	70,  // 80: pb.Wrappers.u32:type_name -> google.protobuf.UInt32Value
+	71,  // 81: pb.Wrappers.b:type_name -> google.protobuf.BoolValue
+	72,  // 82: pb.Wrappers.str:type_name -> google.protobuf.StringValue
+	73,  // 83: pb.Wrappers.bytes:type_name -> google.protobuf.BytesValue
+	67,  // 84: pb.Wrappers.i64s:type_name -> google.protobuf.Int64Value
+	50,  // 85: pb.Wrappers.strs:type_name -> pb.Wrappers.StrsEntry
+	71,  // 86: pb.Wrappers.ob:type_name -> google.protobuf.BoolValue
+	65,  // 87: pb.Wrappers.of64:type_name -> google.protobuf.DoubleValue
+	51,  // 88: pb.UnsafeTest.sub1:type_name -> pb.UnsafeTest.Sub1
+	52,  // 89: pb.UnsafeTest.sub2:type_name -> pb.UnsafeTest.Sub2
+	53,  // 90: pb.UnsafeTest.sub3:type_name -> pb.UnsafeTest.Sub3
+	54,  // 91: pb.UnsafeTest.sub4:type_name -> pb.UnsafeTest.Sub4
+	0,   // 92: pb.Enums.MapEntry.value:type_name -> pb.Type
+	1,   // 93: pb.Map.NumbersEntry.value:type_name -> pb.Number
+	4,   // 94: pb.Map.StringsEntry.value:type_name -> pb.String
+	5,   // 95: pb.Map.BoolsEntry.value:type_name -> pb.Bool
+	7,   // 96: pb.Map.MessagesEntry.value:type_name -> pb.Message
+	8,   // 97: pb.Map.ArraysEntry.value:type_name -> pb.Array
+	0,   // 98: pb.Map.TypesEntry.value:type_name -> pb.Type
+	10,  // 99: pb.Map.EmptiesEntry.value:type_name -> pb.Empty
+	11,  // 100: pb.Map.OptionalsEntry.value:type_name -> pb.Optional
+	12,  // 101: pb.Map.OneofsEntry.value:type_name -> pb.Oneof
+	58,  // 102: pb.WellKnown.DurationsEntry.value:type_name -> google.protobuf.Duration
+	60,  // 103: pb.WellKnown.EmptiesEntry.value:type_name -> google.protobuf.Empty
+	61,  // 104: pb.Structs.StructsEntry.value:type_name -> google.protobuf.Struct
+	64,  // 105: pb.Anys.MapEntry.value:type_name -> google.protobuf.Any
+	72,  // 106: pb.Wrappers.StrsEntry.value:type_name -> google.protobuf.StringValue
+	55,  // 107: pb.UnsafeTest.Sub3.foo:type_name -> pb.UnsafeTest.Sub3.FooEntry
+	52,  // 108: pb.UnsafeTest.Sub3.FooEntry.value:type_name -> pb.UnsafeTest.Sub2
+	109, // [109:109] is the sub-list for method output_type
+	109, // [109:109] is the sub-list for method input_type
+	109, // [109:109] is the sub-list for extension type_name
+	109, // [109:109] is the sub-list for extension extendee
+	0,   // [0:109] is the sub-list for field type_name
 }
 
 func init() { file_module_proto_init() }
@@ -1598,7 +3093,7 @@ func file_module_proto_init() {
 			}
 		}
 		file_module_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*String); i {
+			switch v := v.(*NumberList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1610,7 +3105,7 @@ func file_module_proto_init() {
 			}
 		}
 		file_module_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Bool); i {
+			switch v := v.(*NumberMap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1622,7 +3117,7 @@ func file_module_proto_init() {
 			}
 		}
 		file_module_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Message); i {
+			switch v := v.(*String); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1634,7 +3129,7 @@ func file_module_proto_init() {
 			}
 		}
 		file_module_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Array); i {
+			switch v := v.(*Bool); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1646,7 +3141,7 @@ func file_module_proto_init() {
 			}
 		}
 		file_module_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Map); i {
+			switch v := v.(*Enums); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1658,7 +3153,7 @@ func file_module_proto_init() {
 			}
 		}
 		file_module_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Empty); i {
+			switch v := v.(*Message); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1670,7 +3165,7 @@ func file_module_proto_init() {
 			}
 		}
 		file_module_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Optional); i {
+			switch v := v.(*Array); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1682,7 +3177,7 @@ func file_module_proto_init() {
 			}
 		}
 		file_module_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Oneof); i {
+			switch v := v.(*Map); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1694,6 +3189,126 @@ func file_module_proto_init() {
 			}
 		}
 		file_module_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_module_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Optional); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_module_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Oneof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_module_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldOrder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_module_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OneofFirst); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_module_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Single); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_module_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WellKnown); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_module_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Structs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_module_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Anys); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_module_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Wrappers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_module_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UnsafeTest); i {
 			case 0:
 				return &v.state
@@ -1705,7 +3320,7 @@ func file_module_proto_init() {
 				return nil
 			}
 		}
-		file_module_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_module_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UnsafeTest_Sub1); i {
 			case 0:
 				return &v.state
@@ -1717,7 +3332,7 @@ func file_module_proto_init() {
 				return nil
 			}
 		}
-		file_module_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_module_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UnsafeTest_Sub2); i {
 			case 0:
 				return &v.state
@@ -1729,7 +3344,7 @@ func file_module_proto_init() {
 				return nil
 			}
 		}
-		file_module_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_module_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UnsafeTest_Sub3); i {
 			case 0:
 				return &v.state
@@ -1741,7 +3356,7 @@ func file_module_proto_init() {
 				return nil
 			}
 		}
-		file_module_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_module_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UnsafeTest_Sub4); i {
 			case 0:
 				return &v.state
@@ -1754,8 +3369,8 @@ func file_module_proto_init() {
 			}
 		}
 	}
-	file_module_proto_msgTypes[7].OneofWrappers = []interface{}{}
-	file_module_proto_msgTypes[8].OneofWrappers = []interface{}{
+	file_module_proto_msgTypes[10].OneofWrappers = []interface{}{}
+	file_module_proto_msgTypes[11].OneofWrappers = []interface{}{
 		(*Oneof_String_)(nil),
 		(*Oneof_Bool)(nil),
 		(*Oneof_Message)(nil),
@@ -1764,13 +3379,32 @@ func file_module_proto_init() {
 		(*Oneof_U32)(nil),
 		(*Oneof_Str)(nil),
 	}
-	file_module_proto_msgTypes[9].OneofWrappers = []interface{}{
+	file_module_proto_msgTypes[12].OneofWrappers = []interface{}{}
+	file_module_proto_msgTypes[13].OneofWrappers = []interface{}{
+		(*OneofFirst_S)(nil),
+		(*OneofFirst_U)(nil),
+		(*OneofFirst_T)(nil),
+		(*OneofFirst_B)(nil),
+	}
+	file_module_proto_msgTypes[15].OneofWrappers = []interface{}{
+		(*WellKnown_At)(nil),
+		(*WellKnown_After)(nil),
+	}
+	file_module_proto_msgTypes[16].OneofWrappers = []interface{}{
+		(*Structs_Ov)(nil),
+		(*Structs_Os)(nil),
+	}
+	file_module_proto_msgTypes[18].OneofWrappers = []interface{}{
+		(*Wrappers_Ob)(nil),
+		(*Wrappers_Of64)(nil),
+	}
+	file_module_proto_msgTypes[19].OneofWrappers = []interface{}{
 		(*UnsafeTest_Sub1_)(nil),
 		(*UnsafeTest_Sub2_)(nil),
 		(*UnsafeTest_Sub3_)(nil),
 		(*UnsafeTest_Sub4_)(nil),
 	}
-	file_module_proto_msgTypes[24].OneofWrappers = []interface{}{
+	file_module_proto_msgTypes[53].OneofWrappers = []interface{}{
 		(*UnsafeTest_Sub4_S)(nil),
 		(*UnsafeTest_Sub4_B)(nil),
 	}
@@ -1780,7 +3414,7 @@ func file_module_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_module_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   26,
+			NumMessages:   55,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,7790 @@
+// Code generated by protoc-gen-go-json. DO NOT EDIT.
+// protoc-gen-go-json version: (devel)
+// source: pbopt/module.proto
+
+package pbopt
+
+import (
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	io "io"
+	runtime "protoc-gen-go-json/runtime"
+	strconv "strconv"
+)
+
+var jsonSizeHint_Number runtime.SizeHint
+
+// pbopt.Number
+func (x *Number) MarshalJSON() ([]byte, error) {
+	buf := runtime.NewPooledBufferHint(&jsonSizeHint_Number)
+	data, err := x.AppendJSON(buf.AvailableBuffer())
+	if err != nil {
+		buf.Release()
+		return nil, err
+	}
+	buf.Write(data)
+	return buf.Detach(), nil
+}
+
+// AppendJSON 追加 pbopt.Number 的 json 编码到 dst
+func (x *Number) AppendJSON(dst []byte) ([]byte, error) {
+	return x.EncodeJSON(dst, nil)
+}
+
+// WriteJSONTo 把 pbopt.Number 的 json 编码分段写入 w, 返回写入的字节数
+func (x *Number) WriteJSONTo(w io.Writer) (int64, error) {
+	stream := runtime.NewStream(w)
+	dst, err := x.EncodeJSON(stream.Buffer(), stream)
+	return stream.Done(dst, err)
+}
+
+// JSONSizeHint 估算 pbopt.Number 的 json 编码大小
+func (x *Number) JSONSizeHint() int {
+	if x == nil {
+		return 4
+	}
+	return 303
+}
+
+// EncodeJSON 追加 pbopt.Number 的 json 编码到 dst, stream 不为 nil 时在 list 与 map 元素之间分段写出
+func (x *Number) EncodeJSON(dst []byte, stream *runtime.Stream) ([]byte, error) {
+	if x == nil {
+		return append(dst, "null"...), nil
+	}
+	dst = append(dst, '{')
+	// go name U32 : kind uint32
+	// number 1
+	dst = append(dst, `"u32":`...)
+	dst = strconv.AppendUint(dst, uint64(x.U32), 10)
+	// go name U64 : kind uint64
+	// number 2
+	dst = append(dst, ',')
+	dst = append(dst, `"u64":`...)
+	dst = append(dst, '"')
+	dst = strconv.AppendUint(dst, uint64(x.U64), 10)
+	dst = append(dst, '"')
+	// go name S32 : kind sint32
+	// number 3
+	dst = append(dst, ',')
+	dst = append(dst, `"s32":`...)
+	dst = strconv.AppendInt(dst, int64(x.S32), 10)
+	// go name S64 : kind sint64
+	// number 4
+	dst = append(dst, ',')
+	dst = append(dst, `"s64":`...)
+	dst = append(dst, '"')
+	dst = strconv.AppendInt(dst, int64(x.S64), 10)
+	dst = append(dst, '"')
+	// go name Uf32 : kind fixed32
+	// number 5
+	dst = append(dst, ',')
+	dst = append(dst, `"uf32":`...)
+	dst = strconv.AppendUint(dst, uint64(x.Uf32), 10)
+	// go name Uf64 : kind fixed64
+	// number 6
+	dst = append(dst, ',')
+	dst = append(dst, `"uf64":`...)
+	dst = append(dst, '"')
+	dst = strconv.AppendUint(dst, uint64(x.Uf64), 10)
+	dst = append(dst, '"')
+	// go name Sf32 : kind sfixed32
+	// number 7
+	dst = append(dst, ',')
+	dst = append(dst, `"sf32":`...)
+	dst = strconv.AppendInt(dst, int64(x.Sf32), 10)
+	// go name Sf64 : kind sfixed64
+	// number 8
+	dst = append(dst, ',')
+	dst = append(dst, `"sf64":`...)
+	dst = append(dst, '"')
+	dst = strconv.AppendInt(dst, int64(x.Sf64), 10)
+	dst = append(dst, '"')
+	// go name I32 : kind int32
+	// number 9
+	dst = append(dst, ',')
+	dst = append(dst, `"i32":`...)
+	dst = strconv.AppendInt(dst, int64(x.I32), 10)
+	// go name I64 : kind int64
+	// number 10
+	dst = append(dst, ',')
+	dst = append(dst, `"i64":`...)
+	dst = append(dst, '"')
+	dst = strconv.AppendInt(dst, int64(x.I64), 10)
+	dst = append(dst, '"')
+	// go name F64 : kind double
+	// number 11
+	dst = append(dst, ',')
+	dst = append(dst, `"f64":`...)
+	dst = runtime.AppendFloat(dst, float64(x.F64), 64)
+	// go name F32 : kind float
+	// number 12
+	dst = append(dst, ',')
+	dst = append(dst, `"f32":`...)
+	dst = runtime.AppendFloat(dst, float64(x.F32), 32)
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// pbopt.Number
+func (x *Number) UnmarshalJSON(data []byte) error {
+	d := runtime.UnmarshalOptions{DiscardUnknown: true, AllowDuplicates: true}.NewDecoder(data)
+	if d.ReadNull() {
+		return d.End()
+	}
+	x.Reset()
+	if err := x.DecodeJSON(d); err != nil {
+		return runtime.WithMessage(err, "pbopt.Number")
+	}
+	return d.End()
+}
+
+func (x *Number) DecodeJSON(d *runtime.Decoder) error {
+	if err := d.ObjectStart(); err != nil {
+		return err
+	}
+	var seen uint64
+	for {
+		key, ok, err := d.ObjectNext()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch string(key) {
+		case "u32":
+			// uint32
+			if seen&(1<<0) != 0 {
+				if err = d.Duplicate("pbopt.Number", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 0
+			if d.ReadNull() {
+				continue
+			}
+			v, err := d.ReadUint32()
+			if err != nil {
+				return runtime.WithField(err, "u32")
+			}
+			x.U32 = v
+		case "u64":
+			// uint64
+			if seen&(1<<1) != 0 {
+				if err = d.Duplicate("pbopt.Number", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 1
+			if d.ReadNull() {
+				continue
+			}
+			v, err := d.ReadUint64()
+			if err != nil {
+				return runtime.WithField(err, "u64")
+			}
+			x.U64 = v
+		case "s32":
+			// sint32
+			if seen&(1<<2) != 0 {
+				if err = d.Duplicate("pbopt.Number", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 2
+			if d.ReadNull() {
+				continue
+			}
+			v, err := d.ReadInt32()
+			if err != nil {
+				return runtime.WithField(err, "s32")
+			}
+			x.S32 = v
+		case "s64":
+			// sint64
+			if seen&(1<<3) != 0 {
+				if err = d.Duplicate("pbopt.Number", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 3
+			if d.ReadNull() {
+				continue
+			}
+			v, err := d.ReadInt64()
+			if err != nil {
+				return runtime.WithField(err, "s64")
+			}
+			x.S64 = v
+		case "uf32":
+			// fixed32
+			if seen&(1<<4) != 0 {
+				if err = d.Duplicate("pbopt.Number", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 4
+			if d.ReadNull() {
+				continue
+			}
+			v, err := d.ReadUint32()
+			if err != nil {
+				return runtime.WithField(err, "uf32")
+			}
+			x.Uf32 = v
+		case "uf64":
+			// fixed64
+			if seen&(1<<5) != 0 {
+				if err = d.Duplicate("pbopt.Number", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 5
+			if d.ReadNull() {
+				continue
+			}
+			v, err := d.ReadUint64()
+			if err != nil {
+				return runtime.WithField(err, "uf64")
+			}
+			x.Uf64 = v
+		case "sf32":
+			// sfixed32
+			if seen&(1<<6) != 0 {
+				if err = d.Duplicate("pbopt.Number", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 6
+			if d.ReadNull() {
+				continue
+			}
+			v, err := d.ReadInt32()
+			if err != nil {
+				return runtime.WithField(err, "sf32")
+			}
+			x.Sf32 = v
+		case "sf64":
+			// sfixed64
+			if seen&(1<<7) != 0 {
+				if err = d.Duplicate("pbopt.Number", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 7
+			if d.ReadNull() {
+				continue
+			}
+			v, err := d.ReadInt64()
+			if err != nil {
+				return runtime.WithField(err, "sf64")
+			}
+			x.Sf64 = v
+		case "i32":
+			// int32
+			if seen&(1<<8) != 0 {
+				if err = d.Duplicate("pbopt.Number", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 8
+			if d.ReadNull() {
+				continue
+			}
+			v, err := d.ReadInt32()
+			if err != nil {
+				return runtime.WithField(err, "i32")
+			}
+			x.I32 = v
+		case "i64":
+			// int64
+			if seen&(1<<9) != 0 {
+				if err = d.Duplicate("pbopt.Number", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 9
+			if d.ReadNull() {
+				continue
+			}
+			v, err := d.ReadInt64()
+			if err != nil {
+				return runtime.WithField(err, "i64")
+			}
+			x.I64 = v
+		case "f64":
+			// double
+			if seen&(1<<10) != 0 {
+				if err = d.Duplicate("pbopt.Number", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 10
+			if d.ReadNull() {
+				continue
+			}
+			v, err := d.ReadFloat64()
+			if err != nil {
+				return runtime.WithField(err, "f64")
+			}
+			x.F64 = v
+		case "f32":
+			// float
+			if seen&(1<<11) != 0 {
+				if err = d.Duplicate("pbopt.Number", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 11
+			if d.ReadNull() {
+				continue
+			}
+			v, err := d.ReadFloat32()
+			if err != nil {
+				return runtime.WithField(err, "f32")
+			}
+			x.F32 = v
+		default:
+			if err = d.Unknown("pbopt.Number", key); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+var jsonSizeHint_NumberList runtime.SizeHint
+
+// pbopt.NumberList
+func (x *NumberList) MarshalJSON() ([]byte, error) {
+	buf := runtime.NewPooledBufferHint(&jsonSizeHint_NumberList)
+	data, err := x.AppendJSON(buf.AvailableBuffer())
+	if err != nil {
+		buf.Release()
+		return nil, err
+	}
+	buf.Write(data)
+	return buf.Detach(), nil
+}
+
+// AppendJSON 追加 pbopt.NumberList 的 json 编码到 dst
+func (x *NumberList) AppendJSON(dst []byte) ([]byte, error) {
+	return x.EncodeJSON(dst, nil)
+}
+
+// WriteJSONTo 把 pbopt.NumberList 的 json 编码分段写入 w, 返回写入的字节数
+func (x *NumberList) WriteJSONTo(w io.Writer) (int64, error) {
+	stream := runtime.NewStream(w)
+	dst, err := x.EncodeJSON(stream.Buffer(), stream)
+	return stream.Done(dst, err)
+}
+
+// JSONSizeHint 估算 pbopt.NumberList 的 json 编码大小
+func (x *NumberList) JSONSizeHint() int {
+	if x == nil {
+		return 4
+	}
+	n := 114
+	n += len(x.U32) * 12
+	n += len(x.U64) * 23
+	n += len(x.S32) * 12
+	n += len(x.S64) * 23
+	n += len(x.Uf32) * 12
+	n += len(x.Uf64) * 23
+	n += len(x.Sf32) * 12
+	n += len(x.Sf64) * 23
+	n += len(x.I32) * 12
+	n += len(x.I64) * 23
+	n += len(x.F64) * 25
+	n += len(x.F32) * 25
+	return n
+}
+
+// EncodeJSON 追加 pbopt.NumberList 的 json 编码到 dst, stream 不为 nil 时在 list 与 map 元素之间分段写出
+func (x *NumberList) EncodeJSON(dst []byte, stream *runtime.Stream) ([]byte, error) {
+	if x == nil {
+		return append(dst, "null"...), nil
+	}
+	dst = append(dst, '{')
+	// go name U32 : kind uint32
+	// number 1
+	dst = append(dst, `"u32":[`...)
+	for i, val := range x.U32 {
+		// uint32
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		dst = strconv.AppendUint(dst, uint64(val), 10)
+		dst = stream.Flush(dst)
+	}
+	dst = append(dst, ']')
+	// go name U64 : kind uint64
+	// number 2
+	dst = append(dst, ',')
+	dst = append(dst, `"u64":[`...)
+	for i, val := range x.U64 {
+		// uint64
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		dst = append(dst, '"')
+		dst = strconv.AppendUint(dst, uint64(val), 10)
+		dst = append(dst, '"')
+		dst = stream.Flush(dst)
+	}
+	dst = append(dst, ']')
+	// go name S32 : kind sint32
+	// number 3
+	dst = append(dst, ',')
+	dst = append(dst, `"s32":[`...)
+	for i, val := range x.S32 {
+		// sint32
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		dst = strconv.AppendInt(dst, int64(val), 10)
+		dst = stream.Flush(dst)
+	}
+	dst = append(dst, ']')
+	// go name S64 : kind sint64
+	// number 4
+	dst = append(dst, ',')
+	dst = append(dst, `"s64":[`...)
+	for i, val := range x.S64 {
+		// sint64
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		dst = append(dst, '"')
+		dst = strconv.AppendInt(dst, int64(val), 10)
+		dst = append(dst, '"')
+		dst = stream.Flush(dst)
+	}
+	dst = append(dst, ']')
+	// go name Uf32 : kind fixed32
+	// number 5
+	dst = append(dst, ',')
+	dst = append(dst, `"uf32":[`...)
+	for i, val := range x.Uf32 {
+		// fixed32
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		dst = strconv.AppendUint(dst, uint64(val), 10)
+		dst = stream.Flush(dst)
+	}
+	dst = append(dst, ']')
+	// go name Uf64 : kind fixed64
+	// number 6
+	dst = append(dst, ',')
+	dst = append(dst, `"uf64":[`...)
+	for i, val := range x.Uf64 {
+		// fixed64
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		dst = append(dst, '"')
+		dst = strconv.AppendUint(dst, uint64(val), 10)
+		dst = append(dst, '"')
+		dst = stream.Flush(dst)
+	}
+	dst = append(dst, ']')
+	// go name Sf32 : kind sfixed32
+	// number 7
+	dst = append(dst, ',')
+	dst = append(dst, `"sf32":[`...)
+	for i, val := range x.Sf32 {
+		// sfixed32
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		dst = strconv.AppendInt(dst, int64(val), 10)
+		dst = stream.Flush(dst)
+	}
+	dst = append(dst, ']')
+	// go name Sf64 : kind sfixed64
+	// number 8
+	dst = append(dst, ',')
+	dst = append(dst, `"sf64":[`...)
+	for i, val := range x.Sf64 {
+		// sfixed64
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		dst = append(dst, '"')
+		dst = strconv.AppendInt(dst, int64(val), 10)
+		dst = append(dst, '"')
+		dst = stream.Flush(dst)
+	}
+	dst = append(dst, ']')
+	// go name I32 : kind int32
+	// number 9
+	dst = append(dst, ',')
+	dst = append(dst, `"i32":[`...)
+	for i, val := range x.I32 {
+		// int32
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		dst = strconv.AppendInt(dst, int64(val), 10)
+		dst = stream.Flush(dst)
+	}
+	dst = append(dst, ']')
+	// go name I64 : kind int64
+	// number 10
+	dst = append(dst, ',')
+	dst = append(dst, `"i64":[`...)
+	for i, val := range x.I64 {
+		// int64
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		dst = append(dst, '"')
+		dst = strconv.AppendInt(dst, int64(val), 10)
+		dst = append(dst, '"')
+		dst = stream.Flush(dst)
+	}
+	dst = append(dst, ']')
+	// go name F64 : kind double
+	// number 11
+	dst = append(dst, ',')
+	dst = append(dst, `"f64":[`...)
+	for i, val := range x.F64 {
+		// double
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		dst = runtime.AppendFloat(dst, float64(val), 64)
+		dst = stream.Flush(dst)
+	}
+	dst = append(dst, ']')
+	// go name F32 : kind float
+	// number 12
+	dst = append(dst, ',')
+	dst = append(dst, `"f32":[`...)
+	for i, val := range x.F32 {
+		// float
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		dst = runtime.AppendFloat(dst, float64(val), 32)
+		dst = stream.Flush(dst)
+	}
+	dst = append(dst, ']')
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// pbopt.NumberList
+func (x *NumberList) UnmarshalJSON(data []byte) error {
+	d := runtime.UnmarshalOptions{DiscardUnknown: true, AllowDuplicates: true}.NewDecoder(data)
+	if d.ReadNull() {
+		return d.End()
+	}
+	x.Reset()
+	if err := x.DecodeJSON(d); err != nil {
+		return runtime.WithMessage(err, "pbopt.NumberList")
+	}
+	return d.End()
+}
+
+func (x *NumberList) DecodeJSON(d *runtime.Decoder) error {
+	if err := d.ObjectStart(); err != nil {
+		return err
+	}
+	var seen uint64
+	for {
+		key, ok, err := d.ObjectNext()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch string(key) {
+		case "u32":
+			// uint32
+			if seen&(1<<0) != 0 {
+				if err = d.Duplicate("pbopt.NumberList", key); err != nil {
+					return err
+				}
+				x.U32 = nil
+			}
+			seen |= 1 << 0
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ArrayStart(); err != nil {
+				return runtime.WithField(err, "u32")
+			}
+			for i := 0; ; i++ {
+				if ok, err = d.ArrayNext(); err != nil {
+					return runtime.WithField(err, "u32")
+				} else if !ok {
+					break
+				}
+				v, err := d.ReadUint32()
+				if err != nil {
+					return runtime.WithIndex(err, "u32", i)
+				}
+				x.U32 = append(x.U32, v)
+			}
+		case "u64":
+			// uint64
+			if seen&(1<<1) != 0 {
+				if err = d.Duplicate("pbopt.NumberList", key); err != nil {
+					return err
+				}
+				x.U64 = nil
+			}
+			seen |= 1 << 1
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ArrayStart(); err != nil {
+				return runtime.WithField(err, "u64")
+			}
+			for i := 0; ; i++ {
+				if ok, err = d.ArrayNext(); err != nil {
+					return runtime.WithField(err, "u64")
+				} else if !ok {
+					break
+				}
+				v, err := d.ReadUint64()
+				if err != nil {
+					return runtime.WithIndex(err, "u64", i)
+				}
+				x.U64 = append(x.U64, v)
+			}
+		case "s32":
+			// sint32
+			if seen&(1<<2) != 0 {
+				if err = d.Duplicate("pbopt.NumberList", key); err != nil {
+					return err
+				}
+				x.S32 = nil
+			}
+			seen |= 1 << 2
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ArrayStart(); err != nil {
+				return runtime.WithField(err, "s32")
+			}
+			for i := 0; ; i++ {
+				if ok, err = d.ArrayNext(); err != nil {
+					return runtime.WithField(err, "s32")
+				} else if !ok {
+					break
+				}
+				v, err := d.ReadInt32()
+				if err != nil {
+					return runtime.WithIndex(err, "s32", i)
+				}
+				x.S32 = append(x.S32, v)
+			}
+		case "s64":
+			// sint64
+			if seen&(1<<3) != 0 {
+				if err = d.Duplicate("pbopt.NumberList", key); err != nil {
+					return err
+				}
+				x.S64 = nil
+			}
+			seen |= 1 << 3
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ArrayStart(); err != nil {
+				return runtime.WithField(err, "s64")
+			}
+			for i := 0; ; i++ {
+				if ok, err = d.ArrayNext(); err != nil {
+					return runtime.WithField(err, "s64")
+				} else if !ok {
+					break
+				}
+				v, err := d.ReadInt64()
+				if err != nil {
+					return runtime.WithIndex(err, "s64", i)
+				}
+				x.S64 = append(x.S64, v)
+			}
+		case "uf32":
+			// fixed32
+			if seen&(1<<4) != 0 {
+				if err = d.Duplicate("pbopt.NumberList", key); err != nil {
+					return err
+				}
+				x.Uf32 = nil
+			}
+			seen |= 1 << 4
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ArrayStart(); err != nil {
+				return runtime.WithField(err, "uf32")
+			}
+			for i := 0; ; i++ {
+				if ok, err = d.ArrayNext(); err != nil {
+					return runtime.WithField(err, "uf32")
+				} else if !ok {
+					break
+				}
+				v, err := d.ReadUint32()
+				if err != nil {
+					return runtime.WithIndex(err, "uf32", i)
+				}
+				x.Uf32 = append(x.Uf32, v)
+			}
+		case "uf64":
+			// fixed64
+			if seen&(1<<5) != 0 {
+				if err = d.Duplicate("pbopt.NumberList", key); err != nil {
+					return err
+				}
+				x.Uf64 = nil
+			}
+			seen |= 1 << 5
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ArrayStart(); err != nil {
+				return runtime.WithField(err, "uf64")
+			}
+			for i := 0; ; i++ {
+				if ok, err = d.ArrayNext(); err != nil {
+					return runtime.WithField(err, "uf64")
+				} else if !ok {
+					break
+				}
+				v, err := d.ReadUint64()
+				if err != nil {
+					return runtime.WithIndex(err, "uf64", i)
+				}
+				x.Uf64 = append(x.Uf64, v)
+			}
+		case "sf32":
+			// sfixed32
+			if seen&(1<<6) != 0 {
+				if err = d.Duplicate("pbopt.NumberList", key); err != nil {
+					return err
+				}
+				x.Sf32 = nil
+			}
+			seen |= 1 << 6
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ArrayStart(); err != nil {
+				return runtime.WithField(err, "sf32")
+			}
+			for i := 0; ; i++ {
+				if ok, err = d.ArrayNext(); err != nil {
+					return runtime.WithField(err, "sf32")
+				} else if !ok {
+					break
+				}
+				v, err := d.ReadInt32()
+				if err != nil {
+					return runtime.WithIndex(err, "sf32", i)
+				}
+				x.Sf32 = append(x.Sf32, v)
+			}
+		case "sf64":
+			// sfixed64
+			if seen&(1<<7) != 0 {
+				if err = d.Duplicate("pbopt.NumberList", key); err != nil {
+					return err
+				}
+				x.Sf64 = nil
+			}
+			seen |= 1 << 7
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ArrayStart(); err != nil {
+				return runtime.WithField(err, "sf64")
+			}
+			for i := 0; ; i++ {
+				if ok, err = d.ArrayNext(); err != nil {
+					return runtime.WithField(err, "sf64")
+				} else if !ok {
+					break
+				}
+				v, err := d.ReadInt64()
+				if err != nil {
+					return runtime.WithIndex(err, "sf64", i)
+				}
+				x.Sf64 = append(x.Sf64, v)
+			}
+		case "i32":
+			// int32
+			if seen&(1<<8) != 0 {
+				if err = d.Duplicate("pbopt.NumberList", key); err != nil {
+					return err
+				}
+				x.I32 = nil
+			}
+			seen |= 1 << 8
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ArrayStart(); err != nil {
+				return runtime.WithField(err, "i32")
+			}
+			for i := 0; ; i++ {
+				if ok, err = d.ArrayNext(); err != nil {
+					return runtime.WithField(err, "i32")
+				} else if !ok {
+					break
+				}
+				v, err := d.ReadInt32()
+				if err != nil {
+					return runtime.WithIndex(err, "i32", i)
+				}
+				x.I32 = append(x.I32, v)
+			}
+		case "i64":
+			// int64
+			if seen&(1<<9) != 0 {
+				if err = d.Duplicate("pbopt.NumberList", key); err != nil {
+					return err
+				}
+				x.I64 = nil
+			}
+			seen |= 1 << 9
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ArrayStart(); err != nil {
+				return runtime.WithField(err, "i64")
+			}
+			for i := 0; ; i++ {
+				if ok, err = d.ArrayNext(); err != nil {
+					return runtime.WithField(err, "i64")
+				} else if !ok {
+					break
+				}
+				v, err := d.ReadInt64()
+				if err != nil {
+					return runtime.WithIndex(err, "i64", i)
+				}
+				x.I64 = append(x.I64, v)
+			}
+		case "f64":
+			// double
+			if seen&(1<<10) != 0 {
+				if err = d.Duplicate("pbopt.NumberList", key); err != nil {
+					return err
+				}
+				x.F64 = nil
+			}
+			seen |= 1 << 10
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ArrayStart(); err != nil {
+				return runtime.WithField(err, "f64")
+			}
+			for i := 0; ; i++ {
+				if ok, err = d.ArrayNext(); err != nil {
+					return runtime.WithField(err, "f64")
+				} else if !ok {
+					break
+				}
+				v, err := d.ReadFloat64()
+				if err != nil {
+					return runtime.WithIndex(err, "f64", i)
+				}
+				x.F64 = append(x.F64, v)
+			}
+		case "f32":
+			// float
+			if seen&(1<<11) != 0 {
+				if err = d.Duplicate("pbopt.NumberList", key); err != nil {
+					return err
+				}
+				x.F32 = nil
+			}
+			seen |= 1 << 11
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ArrayStart(); err != nil {
+				return runtime.WithField(err, "f32")
+			}
+			for i := 0; ; i++ {
+				if ok, err = d.ArrayNext(); err != nil {
+					return runtime.WithField(err, "f32")
+				} else if !ok {
+					break
+				}
+				v, err := d.ReadFloat32()
+				if err != nil {
+					return runtime.WithIndex(err, "f32", i)
+				}
+				x.F32 = append(x.F32, v)
+			}
+		default:
+			if err = d.Unknown("pbopt.NumberList", key); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+var jsonSizeHint_NumberMap runtime.SizeHint
+
+// pbopt.NumberMap
+func (x *NumberMap) MarshalJSON() ([]byte, error) {
+	buf := runtime.NewPooledBufferHint(&jsonSizeHint_NumberMap)
+	data, err := x.AppendJSON(buf.AvailableBuffer())
+	if err != nil {
+		buf.Release()
+		return nil, err
+	}
+	buf.Write(data)
+	return buf.Detach(), nil
+}
+
+// AppendJSON 追加 pbopt.NumberMap 的 json 编码到 dst
+func (x *NumberMap) AppendJSON(dst []byte) ([]byte, error) {
+	return x.EncodeJSON(dst, nil)
+}
+
+// WriteJSONTo 把 pbopt.NumberMap 的 json 编码分段写入 w, 返回写入的字节数
+func (x *NumberMap) WriteJSONTo(w io.Writer) (int64, error) {
+	stream := runtime.NewStream(w)
+	dst, err := x.EncodeJSON(stream.Buffer(), stream)
+	return stream.Done(dst, err)
+}
+
+// JSONSizeHint 估算 pbopt.NumberMap 的 json 编码大小
+func (x *NumberMap) JSONSizeHint() int {
+	if x == nil {
+		return 4
+	}
+	n := 114
+	n += len(x.U32) * 26
+	n += len(x.U64) * 48
+	n += len(x.S32) * 26
+	n += len(x.S64) * 48
+	n += len(x.Uf32) * 26
+	n += len(x.Uf64) * 48
+	n += len(x.Sf32) * 26
+	n += len(x.Sf64) * 48
+	n += len(x.I32) * 26
+	n += len(x.I64) * 48
+	n += len(x.F64) * 28
+	for key := range x.F64 {
+		n += len(key)
+	}
+	n += len(x.F32) * 28
+	for key := range x.F32 {
+		n += len(key)
+	}
+	return n
+}
+
+// EncodeJSON 追加 pbopt.NumberMap 的 json 编码到 dst, stream 不为 nil 时在 list 与 map 元素之间分段写出
+func (x *NumberMap) EncodeJSON(dst []byte, stream *runtime.Stream) ([]byte, error) {
+	if x == nil {
+		return append(dst, "null"...), nil
+	}
+	dst = append(dst, '{')
+	// go name U32 : kind message
+	// number 1
+	{
+		dst = append(dst, `"u32":{`...)
+		var many bool
+		var keys [16]uint32
+		for _, key := range runtime.SortedKeys(x.U32, keys[:0]) {
+			val := x.U32[key]
+			// message, key uint32, value uint32
+			if many {
+				dst = append(dst, ',')
+			} else {
+				many = true
+			}
+			dst = append(dst, '"')
+			dst = strconv.AppendUint(dst, uint64(key), 10)
+			dst = append(dst, '"')
+			dst = append(dst, ':')
+			dst = strconv.AppendUint(dst, uint64(val), 10)
+			dst = stream.Flush(dst)
+		}
+		dst = append(dst, '}')
+	}
+	// go name U64 : kind message
+	// number 2
+	{
+		dst = append(dst, ',')
+		dst = append(dst, `"u64":{`...)
+		var many bool
+		var keys [16]uint64
+		for _, key := range runtime.SortedKeys(x.U64, keys[:0]) {
+			val := x.U64[key]
+			// message, key uint64, value uint64
+			if many {
+				dst = append(dst, ',')
+			} else {
+				many = true
+			}
+			dst = append(dst, '"')
+			dst = strconv.AppendUint(dst, uint64(key), 10)
+			dst = append(dst, '"')
+			dst = append(dst, ':')
+			dst = append(dst, '"')
+			dst = strconv.AppendUint(dst, uint64(val), 10)
+			dst = append(dst, '"')
+			dst = stream.Flush(dst)
+		}
+		dst = append(dst, '}')
+	}
+	// go name S32 : kind message
+	// number 3
+	{
+		dst = append(dst, ',')
+		dst = append(dst, `"s32":{`...)
+		var many bool
+		var keys [16]int32
+		for _, key := range runtime.SortedKeys(x.S32, keys[:0]) {
+			val := x.S32[key]
+			// message, key sint32, value sint32
+			if many {
+				dst = append(dst, ',')
+			} else {
+				many = true
+			}
+			dst = append(dst, '"')
+			dst = strconv.AppendInt(dst, int64(key), 10)
+			dst = append(dst, '"')
+			dst = append(dst, ':')
+			dst = strconv.AppendInt(dst, int64(val), 10)
+			dst = stream.Flush(dst)
+		}
+		dst = append(dst, '}')
+	}
+	// go name S64 : kind message
+	// number 4
+	{
+		dst = append(dst, ',')
+		dst = append(dst, `"s64":{`...)
+		var many bool
+		var keys [16]int64
+		for _, key := range runtime.SortedKeys(x.S64, keys[:0]) {
+			val := x.S64[key]
+			// message, key sint64, value sint64
+			if many {
+				dst = append(dst, ',')
+			} else {
+				many = true
+			}
+			dst = append(dst, '"')
+			dst = strconv.AppendInt(dst, int64(key), 10)
+			dst = append(dst, '"')
+			dst = append(dst, ':')
+			dst = append(dst, '"')
+			dst = strconv.AppendInt(dst, int64(val), 10)
+			dst = append(dst, '"')
+			dst = stream.Flush(dst)
+		}
+		dst = append(dst, '}')
+	}
+	// go name Uf32 : kind message
+	// number 5
+	{
+		dst = append(dst, ',')
+		dst = append(dst, `"uf32":{`...)
+		var many bool
+		var keys [16]uint32
+		for _, key := range runtime.SortedKeys(x.Uf32, keys[:0]) {
+			val := x.Uf32[key]
+			// message, key fixed32, value fixed32
+			if many {
+				dst = append(dst, ',')
+			} else {
+				many = true
+			}
+			dst = append(dst, '"')
+			dst = strconv.AppendUint(dst, uint64(key), 10)
+			dst = append(dst, '"')
+			dst = append(dst, ':')
+			dst = strconv.AppendUint(dst, uint64(val), 10)
+			dst = stream.Flush(dst)
+		}
+		dst = append(dst, '}')
+	}
+	// go name Uf64 : kind message
+	// number 6
+	{
+		dst = append(dst, ',')
+		dst = append(dst, `"uf64":{`...)
+		var many bool
+		var keys [16]uint64
+		for _, key := range runtime.SortedKeys(x.Uf64, keys[:0]) {
+			val := x.Uf64[key]
+			// message, key fixed64, value fixed64
+			if many {
+				dst = append(dst, ',')
+			} else {
+				many = true
+			}
+			dst = append(dst, '"')
+			dst = strconv.AppendUint(dst, uint64(key), 10)
+			dst = append(dst, '"')
+			dst = append(dst, ':')
+			dst = append(dst, '"')
+			dst = strconv.AppendUint(dst, uint64(val), 10)
+			dst = append(dst, '"')
+			dst = stream.Flush(dst)
+		}
+		dst = append(dst, '}')
+	}
+	// go name Sf32 : kind message
+	// number 7
+	{
+		dst = append(dst, ',')
+		dst = append(dst, `"sf32":{`...)
+		var many bool
+		var keys [16]int32
+		for _, key := range runtime.SortedKeys(x.Sf32, keys[:0]) {
+			val := x.Sf32[key]
+			// message, key sfixed32, value sfixed32
+			if many {
+				dst = append(dst, ',')
+			} else {
+				many = true
+			}
+			dst = append(dst, '"')
+			dst = strconv.AppendInt(dst, int64(key), 10)
+			dst = append(dst, '"')
+			dst = append(dst, ':')
+			dst = strconv.AppendInt(dst, int64(val), 10)
+			dst = stream.Flush(dst)
+		}
+		dst = append(dst, '}')
+	}
+	// go name Sf64 : kind message
+	// number 8
+	{
+		dst = append(dst, ',')
+		dst = append(dst, `"sf64":{`...)
+		var many bool
+		var keys [16]int64
+		for _, key := range runtime.SortedKeys(x.Sf64, keys[:0]) {
+			val := x.Sf64[key]
+			// message, key sfixed64, value sfixed64
+			if many {
+				dst = append(dst, ',')
+			} else {
+				many = true
+			}
+			dst = append(dst, '"')
+			dst = strconv.AppendInt(dst, int64(key), 10)
+			dst = append(dst, '"')
+			dst = append(dst, ':')
+			dst = append(dst, '"')
+			dst = strconv.AppendInt(dst, int64(val), 10)
+			dst = append(dst, '"')
+			dst = stream.Flush(dst)
+		}
+		dst = append(dst, '}')
+	}
+	// go name I32 : kind message
+	// number 9
+	{
+		dst = append(dst, ',')
+		dst = append(dst, `"i32":{`...)
+		var many bool
+		var keys [16]int32
+		for _, key := range runtime.SortedKeys(x.I32, keys[:0]) {
+			val := x.I32[key]
+			// message, key int32, value int32
+			if many {
+				dst = append(dst, ',')
+			} else {
+				many = true
+			}
+			dst = append(dst, '"')
+			dst = strconv.AppendInt(dst, int64(key), 10)
+			dst = append(dst, '"')
+			dst = append(dst, ':')
+			dst = strconv.AppendInt(dst, int64(val), 10)
+			dst = stream.Flush(dst)
+		}
+		dst = append(dst, '}')
+	}
+	// go name I64 : kind message
+	// number 10
+	{
+		dst = append(dst, ',')
+		dst = append(dst, `"i64":{`...)
+		var many bool
+		var keys [16]int64
+		for _, key := range runtime.SortedKeys(x.I64, keys[:0]) {
+			val := x.I64[key]
+			// message, key int64, value int64
+			if many {
+				dst = append(dst, ',')
+			} else {
+				many = true
+			}
+			dst = append(dst, '"')
+			dst = strconv.AppendInt(dst, int64(key), 10)
+			dst = append(dst, '"')
+			dst = append(dst, ':')
+			dst = append(dst, '"')
+			dst = strconv.AppendInt(dst, int64(val), 10)
+			dst = append(dst, '"')
+			dst = stream.Flush(dst)
+		}
+		dst = append(dst, '}')
+	}
+	// go name F64 : kind message
+	// number 11
+	{
+		dst = append(dst, ',')
+		dst = append(dst, `"f64":{`...)
+		var many bool
+		var keys [16]string
+		for _, key := range runtime.SortedKeys(x.F64, keys[:0]) {
+			val := x.F64[key]
+			// message, key string, value double
+			if many {
+				dst = append(dst, ',')
+			} else {
+				many = true
+			}
+			if data, err := runtime.AppendString(dst, key); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+			dst = append(dst, ':')
+			dst = runtime.AppendFloat(dst, float64(val), 64)
+			dst = stream.Flush(dst)
+		}
+		dst = append(dst, '}')
+	}
+	// go name F32 : kind message
+	// number 12
+	{
+		dst = append(dst, ',')
+		dst = append(dst, `"f32":{`...)
+		var many bool
+		var keys [16]string
+		for _, key := range runtime.SortedKeys(x.F32, keys[:0]) {
+			val := x.F32[key]
+			// message, key string, value float
+			if many {
+				dst = append(dst, ',')
+			} else {
+				many = true
+			}
+			if data, err := runtime.AppendString(dst, key); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+			dst = append(dst, ':')
+			dst = runtime.AppendFloat(dst, float64(val), 32)
+			dst = stream.Flush(dst)
+		}
+		dst = append(dst, '}')
+	}
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// pbopt.NumberMap
+func (x *NumberMap) UnmarshalJSON(data []byte) error {
+	d := runtime.UnmarshalOptions{DiscardUnknown: true, AllowDuplicates: true}.NewDecoder(data)
+	if d.ReadNull() {
+		return d.End()
+	}
+	x.Reset()
+	if err := x.DecodeJSON(d); err != nil {
+		return runtime.WithMessage(err, "pbopt.NumberMap")
+	}
+	return d.End()
+}
+
+func (x *NumberMap) DecodeJSON(d *runtime.Decoder) error {
+	if err := d.ObjectStart(); err != nil {
+		return err
+	}
+	var seen uint64
+	for {
+		key, ok, err := d.ObjectNext()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch string(key) {
+		case "u32":
+			// message
+			if seen&(1<<0) != 0 {
+				if err = d.Duplicate("pbopt.NumberMap", key); err != nil {
+					return err
+				}
+				x.U32 = nil
+			}
+			seen |= 1 << 0
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ObjectStart(); err != nil {
+				return runtime.WithField(err, "u32")
+			}
+			if x.U32 == nil {
+				x.U32 = make(map[uint32]uint32)
+			}
+			for {
+				k, ok, err := d.ObjectNext()
+				if err != nil {
+					return runtime.WithField(err, "u32")
+				}
+				if !ok {
+					break
+				}
+				mk, err := d.KeyUint32(k)
+				if err != nil {
+					return runtime.WithField(err, "u32")
+				}
+				if _, dup := x.U32[mk]; dup {
+					if err = d.DuplicateKey(k); err != nil {
+						return runtime.WithKey(err, "u32", mk)
+					}
+				}
+				v, err := d.ReadUint32()
+				if err != nil {
+					return runtime.WithKey(err, "u32", mk)
+				}
+				x.U32[mk] = v
+			}
+		case "u64":
+			// message
+			if seen&(1<<1) != 0 {
+				if err = d.Duplicate("pbopt.NumberMap", key); err != nil {
+					return err
+				}
+				x.U64 = nil
+			}
+			seen |= 1 << 1
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ObjectStart(); err != nil {
+				return runtime.WithField(err, "u64")
+			}
+			if x.U64 == nil {
+				x.U64 = make(map[uint64]uint64)
+			}
+			for {
+				k, ok, err := d.ObjectNext()
+				if err != nil {
+					return runtime.WithField(err, "u64")
+				}
+				if !ok {
+					break
+				}
+				mk, err := d.KeyUint64(k)
+				if err != nil {
+					return runtime.WithField(err, "u64")
+				}
+				if _, dup := x.U64[mk]; dup {
+					if err = d.DuplicateKey(k); err != nil {
+						return runtime.WithKey(err, "u64", mk)
+					}
+				}
+				v, err := d.ReadUint64()
+				if err != nil {
+					return runtime.WithKey(err, "u64", mk)
+				}
+				x.U64[mk] = v
+			}
+		case "s32":
+			// message
+			if seen&(1<<2) != 0 {
+				if err = d.Duplicate("pbopt.NumberMap", key); err != nil {
+					return err
+				}
+				x.S32 = nil
+			}
+			seen |= 1 << 2
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ObjectStart(); err != nil {
+				return runtime.WithField(err, "s32")
+			}
+			if x.S32 == nil {
+				x.S32 = make(map[int32]int32)
+			}
+			for {
+				k, ok, err := d.ObjectNext()
+				if err != nil {
+					return runtime.WithField(err, "s32")
+				}
+				if !ok {
+					break
+				}
+				mk, err := d.KeyInt32(k)
+				if err != nil {
+					return runtime.WithField(err, "s32")
+				}
+				if _, dup := x.S32[mk]; dup {
+					if err = d.DuplicateKey(k); err != nil {
+						return runtime.WithKey(err, "s32", mk)
+					}
+				}
+				v, err := d.ReadInt32()
+				if err != nil {
+					return runtime.WithKey(err, "s32", mk)
+				}
+				x.S32[mk] = v
+			}
+		case "s64":
+			// message
+			if seen&(1<<3) != 0 {
+				if err = d.Duplicate("pbopt.NumberMap", key); err != nil {
+					return err
+				}
+				x.S64 = nil
+			}
+			seen |= 1 << 3
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ObjectStart(); err != nil {
+				return runtime.WithField(err, "s64")
+			}
+			if x.S64 == nil {
+				x.S64 = make(map[int64]int64)
+			}
+			for {
+				k, ok, err := d.ObjectNext()
+				if err != nil {
+					return runtime.WithField(err, "s64")
+				}
+				if !ok {
+					break
+				}
+				mk, err := d.KeyInt64(k)
+				if err != nil {
+					return runtime.WithField(err, "s64")
+				}
+				if _, dup := x.S64[mk]; dup {
+					if err = d.DuplicateKey(k); err != nil {
+						return runtime.WithKey(err, "s64", mk)
+					}
+				}
+				v, err := d.ReadInt64()
+				if err != nil {
+					return runtime.WithKey(err, "s64", mk)
+				}
+				x.S64[mk] = v
+			}
+		case "uf32":
+			// message
+			if seen&(1<<4) != 0 {
+				if err = d.Duplicate("pbopt.NumberMap", key); err != nil {
+					return err
+				}
+				x.Uf32 = nil
+			}
+			seen |= 1 << 4
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ObjectStart(); err != nil {
+				return runtime.WithField(err, "uf32")
+			}
+			if x.Uf32 == nil {
+				x.Uf32 = make(map[uint32]uint32)
+			}
+			for {
+				k, ok, err := d.ObjectNext()
+				if err != nil {
+					return runtime.WithField(err, "uf32")
+				}
+				if !ok {
+					break
+				}
+				mk, err := d.KeyUint32(k)
+				if err != nil {
+					return runtime.WithField(err, "uf32")
+				}
+				if _, dup := x.Uf32[mk]; dup {
+					if err = d.DuplicateKey(k); err != nil {
+						return runtime.WithKey(err, "uf32", mk)
+					}
+				}
+				v, err := d.ReadUint32()
+				if err != nil {
+					return runtime.WithKey(err, "uf32", mk)
+				}
+				x.Uf32[mk] = v
+			}
+		case "uf64":
+			// message
+			if seen&(1<<5) != 0 {
+				if err = d.Duplicate("pbopt.NumberMap", key); err != nil {
+					return err
+				}
+				x.Uf64 = nil
+			}
+			seen |= 1 << 5
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ObjectStart(); err != nil {
+				return runtime.WithField(err, "uf64")
+			}
+			if x.Uf64 == nil {
+				x.Uf64 = make(map[uint64]uint64)
+			}
+			for {
+				k, ok, err := d.ObjectNext()
+				if err != nil {
+					return runtime.WithField(err, "uf64")
+				}
+				if !ok {
+					break
+				}
+				mk, err := d.KeyUint64(k)
+				if err != nil {
+					return runtime.WithField(err, "uf64")
+				}
+				if _, dup := x.Uf64[mk]; dup {
+					if err = d.DuplicateKey(k); err != nil {
+						return runtime.WithKey(err, "uf64", mk)
+					}
+				}
+				v, err := d.ReadUint64()
+				if err != nil {
+					return runtime.WithKey(err, "uf64", mk)
+				}
+				x.Uf64[mk] = v
+			}
+		case "sf32":
+			// message
+			if seen&(1<<6) != 0 {
+				if err = d.Duplicate("pbopt.NumberMap", key); err != nil {
+					return err
+				}
+				x.Sf32 = nil
+			}
+			seen |= 1 << 6
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ObjectStart(); err != nil {
+				return runtime.WithField(err, "sf32")
+			}
+			if x.Sf32 == nil {
+				x.Sf32 = make(map[int32]int32)
+			}
+			for {
+				k, ok, err := d.ObjectNext()
+				if err != nil {
+					return runtime.WithField(err, "sf32")
+				}
+				if !ok {
+					break
+				}
+				mk, err := d.KeyInt32(k)
+				if err != nil {
+					return runtime.WithField(err, "sf32")
+				}
+				if _, dup := x.Sf32[mk]; dup {
+					if err = d.DuplicateKey(k); err != nil {
+						return runtime.WithKey(err, "sf32", mk)
+					}
+				}
+				v, err := d.ReadInt32()
+				if err != nil {
+					return runtime.WithKey(err, "sf32", mk)
+				}
+				x.Sf32[mk] = v
+			}
+		case "sf64":
+			// message
+			if seen&(1<<7) != 0 {
+				if err = d.Duplicate("pbopt.NumberMap", key); err != nil {
+					return err
+				}
+				x.Sf64 = nil
+			}
+			seen |= 1 << 7
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ObjectStart(); err != nil {
+				return runtime.WithField(err, "sf64")
+			}
+			if x.Sf64 == nil {
+				x.Sf64 = make(map[int64]int64)
+			}
+			for {
+				k, ok, err := d.ObjectNext()
+				if err != nil {
+					return runtime.WithField(err, "sf64")
+				}
+				if !ok {
+					break
+				}
+				mk, err := d.KeyInt64(k)
+				if err != nil {
+					return runtime.WithField(err, "sf64")
+				}
+				if _, dup := x.Sf64[mk]; dup {
+					if err = d.DuplicateKey(k); err != nil {
+						return runtime.WithKey(err, "sf64", mk)
+					}
+				}
+				v, err := d.ReadInt64()
+				if err != nil {
+					return runtime.WithKey(err, "sf64", mk)
+				}
+				x.Sf64[mk] = v
+			}
+		case "i32":
+			// message
+			if seen&(1<<8) != 0 {
+				if err = d.Duplicate("pbopt.NumberMap", key); err != nil {
+					return err
+				}
+				x.I32 = nil
+			}
+			seen |= 1 << 8
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ObjectStart(); err != nil {
+				return runtime.WithField(err, "i32")
+			}
+			if x.I32 == nil {
+				x.I32 = make(map[int32]int32)
+			}
+			for {
+				k, ok, err := d.ObjectNext()
+				if err != nil {
+					return runtime.WithField(err, "i32")
+				}
+				if !ok {
+					break
+				}
+				mk, err := d.KeyInt32(k)
+				if err != nil {
+					return runtime.WithField(err, "i32")
+				}
+				if _, dup := x.I32[mk]; dup {
+					if err = d.DuplicateKey(k); err != nil {
+						return runtime.WithKey(err, "i32", mk)
+					}
+				}
+				v, err := d.ReadInt32()
+				if err != nil {
+					return runtime.WithKey(err, "i32", mk)
+				}
+				x.I32[mk] = v
+			}
+		case "i64":
+			// message
+			if seen&(1<<9) != 0 {
+				if err = d.Duplicate("pbopt.NumberMap", key); err != nil {
+					return err
+				}
+				x.I64 = nil
+			}
+			seen |= 1 << 9
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ObjectStart(); err != nil {
+				return runtime.WithField(err, "i64")
+			}
+			if x.I64 == nil {
+				x.I64 = make(map[int64]int64)
+			}
+			for {
+				k, ok, err := d.ObjectNext()
+				if err != nil {
+					return runtime.WithField(err, "i64")
+				}
+				if !ok {
+					break
+				}
+				mk, err := d.KeyInt64(k)
+				if err != nil {
+					return runtime.WithField(err, "i64")
+				}
+				if _, dup := x.I64[mk]; dup {
+					if err = d.DuplicateKey(k); err != nil {
+						return runtime.WithKey(err, "i64", mk)
+					}
+				}
+				v, err := d.ReadInt64()
+				if err != nil {
+					return runtime.WithKey(err, "i64", mk)
+				}
+				x.I64[mk] = v
+			}
+		case "f64":
+			// message
+			if seen&(1<<10) != 0 {
+				if err = d.Duplicate("pbopt.NumberMap", key); err != nil {
+					return err
+				}
+				x.F64 = nil
+			}
+			seen |= 1 << 10
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ObjectStart(); err != nil {
+				return runtime.WithField(err, "f64")
+			}
+			if x.F64 == nil {
+				x.F64 = make(map[string]float64)
+			}
+			for {
+				k, ok, err := d.ObjectNext()
+				if err != nil {
+					return runtime.WithField(err, "f64")
+				}
+				if !ok {
+					break
+				}
+				mk := string(k)
+				if _, dup := x.F64[mk]; dup {
+					if err = d.DuplicateKey(k); err != nil {
+						return runtime.WithKey(err, "f64", mk)
+					}
+				}
+				v, err := d.ReadFloat64()
+				if err != nil {
+					return runtime.WithKey(err, "f64", mk)
+				}
+				x.F64[mk] = v
+			}
+		case "f32":
+			// message
+			if seen&(1<<11) != 0 {
+				if err = d.Duplicate("pbopt.NumberMap", key); err != nil {
+					return err
+				}
+				x.F32 = nil
+			}
+			seen |= 1 << 11
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ObjectStart(); err != nil {
+				return runtime.WithField(err, "f32")
+			}
+			if x.F32 == nil {
+				x.F32 = make(map[string]float32)
+			}
+			for {
+				k, ok, err := d.ObjectNext()
+				if err != nil {
+					return runtime.WithField(err, "f32")
+				}
+				if !ok {
+					break
+				}
+				mk := string(k)
+				if _, dup := x.F32[mk]; dup {
+					if err = d.DuplicateKey(k); err != nil {
+						return runtime.WithKey(err, "f32", mk)
+					}
+				}
+				v, err := d.ReadFloat32()
+				if err != nil {
+					return runtime.WithKey(err, "f32", mk)
+				}
+				x.F32[mk] = v
+			}
+		default:
+			if err = d.Unknown("pbopt.NumberMap", key); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+var jsonSizeHint_String runtime.SizeHint
+
+// pbopt.String
+func (x *String) MarshalJSON() ([]byte, error) {
+	buf := runtime.NewPooledBufferHint(&jsonSizeHint_String)
+	data, err := x.AppendJSON(buf.AvailableBuffer())
+	if err != nil {
+		buf.Release()
+		return nil, err
+	}
+	buf.Write(data)
+	return buf.Detach(), nil
+}
+
+// AppendJSON 追加 pbopt.String 的 json 编码到 dst
+func (x *String) AppendJSON(dst []byte) ([]byte, error) {
+	return x.EncodeJSON(dst, nil)
+}
+
+// WriteJSONTo 把 pbopt.String 的 json 编码分段写入 w, 返回写入的字节数
+func (x *String) WriteJSONTo(w io.Writer) (int64, error) {
+	stream := runtime.NewStream(w)
+	dst, err := x.EncodeJSON(stream.Buffer(), stream)
+	return stream.Done(dst, err)
+}
+
+// JSONSizeHint 估算 pbopt.String 的 json 编码大小
+func (x *String) JSONSizeHint() int {
+	if x == nil {
+		return 4
+	}
+	n := 22
+	n += len(x.Str)
+	n += (len(x.Bytes) + 2) / 3 * 4
+	return n
+}
+
+// EncodeJSON 追加 pbopt.String 的 json 编码到 dst, stream 不为 nil 时在 list 与 map 元素之间分段写出
+func (x *String) EncodeJSON(dst []byte, stream *runtime.Stream) ([]byte, error) {
+	if x == nil {
+		return append(dst, "null"...), nil
+	}
+	dst = append(dst, '{')
+	// go name Str : kind string
+	// number 1
+	dst = append(dst, `"str":`...)
+	if data, err := runtime.AppendString(dst, x.Str); err != nil {
+		return dst, err
+	} else {
+		dst = data
+	}
+	// go name Bytes : kind bytes
+	// number 2
+	dst = append(dst, ',')
+	dst = append(dst, `"bytes":`...)
+	dst = runtime.AppendBytes(dst, x.Bytes)
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// pbopt.String
+func (x *String) UnmarshalJSON(data []byte) error {
+	d := runtime.UnmarshalOptions{DiscardUnknown: true, AllowDuplicates: true}.NewDecoder(data)
+	if d.ReadNull() {
+		return d.End()
+	}
+	x.Reset()
+	if err := x.DecodeJSON(d); err != nil {
+		return runtime.WithMessage(err, "pbopt.String")
+	}
+	return d.End()
+}
+
+func (x *String) DecodeJSON(d *runtime.Decoder) error {
+	if err := d.ObjectStart(); err != nil {
+		return err
+	}
+	var seen uint64
+	for {
+		key, ok, err := d.ObjectNext()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch string(key) {
+		case "str":
+			// string
+			if seen&(1<<0) != 0 {
+				if err = d.Duplicate("pbopt.String", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 0
+			if d.ReadNull() {
+				continue
+			}
+			v, err := d.ReadString()
+			if err != nil {
+				return runtime.WithField(err, "str")
+			}
+			x.Str = v
+		case "bytes":
+			// bytes
+			if seen&(1<<1) != 0 {
+				if err = d.Duplicate("pbopt.String", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 1
+			if d.ReadNull() {
+				continue
+			}
+			v, err := d.ReadBytes()
+			if err != nil {
+				return runtime.WithField(err, "bytes")
+			}
+			x.Bytes = v
+		default:
+			if err = d.Unknown("pbopt.String", key); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+var jsonSizeHint_Bool runtime.SizeHint
+
+// pbopt.Bool
+func (x *Bool) MarshalJSON() ([]byte, error) {
+	buf := runtime.NewPooledBufferHint(&jsonSizeHint_Bool)
+	data, err := x.AppendJSON(buf.AvailableBuffer())
+	if err != nil {
+		buf.Release()
+		return nil, err
+	}
+	buf.Write(data)
+	return buf.Detach(), nil
+}
+
+// AppendJSON 追加 pbopt.Bool 的 json 编码到 dst
+func (x *Bool) AppendJSON(dst []byte) ([]byte, error) {
+	return x.EncodeJSON(dst, nil)
+}
+
+// WriteJSONTo 把 pbopt.Bool 的 json 编码分段写入 w, 返回写入的字节数
+func (x *Bool) WriteJSONTo(w io.Writer) (int64, error) {
+	stream := runtime.NewStream(w)
+	dst, err := x.EncodeJSON(stream.Buffer(), stream)
+	return stream.Done(dst, err)
+}
+
+// JSONSizeHint 估算 pbopt.Bool 的 json 编码大小
+func (x *Bool) JSONSizeHint() int {
+	if x == nil {
+		return 4
+	}
+	return 12
+}
+
+// EncodeJSON 追加 pbopt.Bool 的 json 编码到 dst, stream 不为 nil 时在 list 与 map 元素之间分段写出
+func (x *Bool) EncodeJSON(dst []byte, stream *runtime.Stream) ([]byte, error) {
+	if x == nil {
+		return append(dst, "null"...), nil
+	}
+	dst = append(dst, '{')
+	// go name B : kind bool
+	// number 1
+	dst = append(dst, `"b":`...)
+	dst = strconv.AppendBool(dst, x.B)
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// pbopt.Bool
+func (x *Bool) UnmarshalJSON(data []byte) error {
+	d := runtime.UnmarshalOptions{DiscardUnknown: true, AllowDuplicates: true}.NewDecoder(data)
+	if d.ReadNull() {
+		return d.End()
+	}
+	x.Reset()
+	if err := x.DecodeJSON(d); err != nil {
+		return runtime.WithMessage(err, "pbopt.Bool")
+	}
+	return d.End()
+}
+
+func (x *Bool) DecodeJSON(d *runtime.Decoder) error {
+	if err := d.ObjectStart(); err != nil {
+		return err
+	}
+	var seen uint64
+	for {
+		key, ok, err := d.ObjectNext()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch string(key) {
+		case "b":
+			// bool
+			if seen&(1<<0) != 0 {
+				if err = d.Duplicate("pbopt.Bool", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 0
+			if d.ReadNull() {
+				continue
+			}
+			v, err := d.ReadBool()
+			if err != nil {
+				return runtime.WithField(err, "b")
+			}
+			x.B = v
+		default:
+			if err = d.Unknown("pbopt.Bool", key); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+var jsonSizeHint_Enums runtime.SizeHint
+
+// pbopt.Enums
+func (x *Enums) MarshalJSON() ([]byte, error) {
+	buf := runtime.NewPooledBufferHint(&jsonSizeHint_Enums)
+	data, err := x.AppendJSON(buf.AvailableBuffer())
+	if err != nil {
+		buf.Release()
+		return nil, err
+	}
+	buf.Write(data)
+	return buf.Detach(), nil
+}
+
+// AppendJSON 追加 pbopt.Enums 的 json 编码到 dst
+func (x *Enums) AppendJSON(dst []byte) ([]byte, error) {
+	return x.EncodeJSON(dst, nil)
+}
+
+// WriteJSONTo 把 pbopt.Enums 的 json 编码分段写入 w, 返回写入的字节数
+func (x *Enums) WriteJSONTo(w io.Writer) (int64, error) {
+	stream := runtime.NewStream(w)
+	dst, err := x.EncodeJSON(stream.Buffer(), stream)
+	return stream.Done(dst, err)
+}
+
+// JSONSizeHint 估算 pbopt.Enums 的 json 编码大小
+func (x *Enums) JSONSizeHint() int {
+	if x == nil {
+		return 4
+	}
+	n := 64
+	n += len(x.Types) * 12
+	n += len(x.Map) * 15
+	for key := range x.Map {
+		n += len(key)
+	}
+	n += len(x.Nulls) * 5
+	return n
+}
+
+// EncodeJSON 追加 pbopt.Enums 的 json 编码到 dst, stream 不为 nil 时在 list 与 map 元素之间分段写出
+func (x *Enums) EncodeJSON(dst []byte, stream *runtime.Stream) ([]byte, error) {
+	if x == nil {
+		return append(dst, "null"...), nil
+	}
+	dst = append(dst, '{')
+	// go name Type : kind enum
+	// number 1
+	dst = append(dst, `"type":`...)
+	dst = strconv.AppendInt(dst, int64(x.Type), 10)
+	// go name Types : kind enum
+	// number 2
+	dst = append(dst, ',')
+	dst = append(dst, `"types":[`...)
+	for i, val := range x.Types {
+		// enum
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		dst = strconv.AppendInt(dst, int64(val), 10)
+		dst = stream.Flush(dst)
+	}
+	dst = append(dst, ']')
+	// go name Map : kind message
+	// number 3
+	{
+		dst = append(dst, ',')
+		dst = append(dst, `"map":{`...)
+		var many bool
+		var keys [16]string
+		for _, key := range runtime.SortedKeys(x.Map, keys[:0]) {
+			val := x.Map[key]
+			// message, key string, value enum
+			if many {
+				dst = append(dst, ',')
+			} else {
+				many = true
+			}
+			if data, err := runtime.AppendString(dst, key); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+			dst = append(dst, ':')
+			dst = strconv.AppendInt(dst, int64(val), 10)
+			dst = stream.Flush(dst)
+		}
+		dst = append(dst, '}')
+	}
+	// go name Null : kind enum
+	// number 4
+	dst = append(dst, ',')
+	dst = append(dst, `"null":`...)
+	_ = x.Null
+	dst = append(dst, "null"...)
+	// go name Nulls : kind enum
+	// number 5
+	dst = append(dst, ',')
+	dst = append(dst, `"nulls":[`...)
+	for i, val := range x.Nulls {
+		// enum
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		_ = val
+		dst = append(dst, "null"...)
+		dst = stream.Flush(dst)
+	}
+	dst = append(dst, ']')
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// pbopt.Enums
+func (x *Enums) UnmarshalJSON(data []byte) error {
+	d := runtime.UnmarshalOptions{DiscardUnknown: true, AllowDuplicates: true}.NewDecoder(data)
+	if d.ReadNull() {
+		return d.End()
+	}
+	x.Reset()
+	if err := x.DecodeJSON(d); err != nil {
+		return runtime.WithMessage(err, "pbopt.Enums")
+	}
+	return d.End()
+}
+
+func (x *Enums) DecodeJSON(d *runtime.Decoder) error {
+	if err := d.ObjectStart(); err != nil {
+		return err
+	}
+	var seen uint64
+	for {
+		key, ok, err := d.ObjectNext()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch string(key) {
+		case "type":
+			// enum
+			if seen&(1<<0) != 0 {
+				if err = d.Duplicate("pbopt.Enums", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 0
+			if d.ReadNull() {
+				continue
+			}
+			v, err := runtime.ReadEnum[Type](d, Type_value)
+			if err != nil {
+				return runtime.WithField(err, "type")
+			}
+			x.Type = v
+		case "types":
+			// enum
+			if seen&(1<<1) != 0 {
+				if err = d.Duplicate("pbopt.Enums", key); err != nil {
+					return err
+				}
+				x.Types = nil
+			}
+			seen |= 1 << 1
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ArrayStart(); err != nil {
+				return runtime.WithField(err, "types")
+			}
+			for i := 0; ; i++ {
+				if ok, err = d.ArrayNext(); err != nil {
+					return runtime.WithField(err, "types")
+				} else if !ok {
+					break
+				}
+				v, err := runtime.ReadEnum[Type](d, Type_value)
+				if err != nil {
+					return runtime.WithIndex(err, "types", i)
+				}
+				x.Types = append(x.Types, v)
+			}
+		case "map":
+			// message
+			if seen&(1<<2) != 0 {
+				if err = d.Duplicate("pbopt.Enums", key); err != nil {
+					return err
+				}
+				x.Map = nil
+			}
+			seen |= 1 << 2
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ObjectStart(); err != nil {
+				return runtime.WithField(err, "map")
+			}
+			if x.Map == nil {
+				x.Map = make(map[string]Type)
+			}
+			for {
+				k, ok, err := d.ObjectNext()
+				if err != nil {
+					return runtime.WithField(err, "map")
+				}
+				if !ok {
+					break
+				}
+				mk := string(k)
+				if _, dup := x.Map[mk]; dup {
+					if err = d.DuplicateKey(k); err != nil {
+						return runtime.WithKey(err, "map", mk)
+					}
+				}
+				v, err := runtime.ReadEnum[Type](d, Type_value)
+				if err != nil {
+					return runtime.WithKey(err, "map", mk)
+				}
+				x.Map[mk] = v
+			}
+		case "null":
+			// enum
+			if seen&(1<<3) != 0 {
+				if err = d.Duplicate("pbopt.Enums", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 3
+			if d.ReadNull() {
+				continue
+			}
+			v, err := runtime.ReadNullValue[structpb.NullValue](d, structpb.NullValue_value)
+			if err != nil {
+				return runtime.WithField(err, "null")
+			}
+			x.Null = v
+		case "nulls":
+			// enum
+			if seen&(1<<4) != 0 {
+				if err = d.Duplicate("pbopt.Enums", key); err != nil {
+					return err
+				}
+				x.Nulls = nil
+			}
+			seen |= 1 << 4
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ArrayStart(); err != nil {
+				return runtime.WithField(err, "nulls")
+			}
+			for i := 0; ; i++ {
+				if ok, err = d.ArrayNext(); err != nil {
+					return runtime.WithField(err, "nulls")
+				} else if !ok {
+					break
+				}
+				v, err := runtime.ReadNullValue[structpb.NullValue](d, structpb.NullValue_value)
+				if err != nil {
+					return runtime.WithIndex(err, "nulls", i)
+				}
+				x.Nulls = append(x.Nulls, v)
+			}
+		default:
+			if err = d.Unknown("pbopt.Enums", key); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+var jsonSizeHint_Message runtime.SizeHint
+
+// pbopt.Message
+func (x *Message) MarshalJSON() ([]byte, error) {
+	buf := runtime.NewPooledBufferHint(&jsonSizeHint_Message)
+	data, err := x.AppendJSON(buf.AvailableBuffer())
+	if err != nil {
+		buf.Release()
+		return nil, err
+	}
+	buf.Write(data)
+	return buf.Detach(), nil
+}
+
+// AppendJSON 追加 pbopt.Message 的 json 编码到 dst
+func (x *Message) AppendJSON(dst []byte) ([]byte, error) {
+	return x.EncodeJSON(dst, nil)
+}
+
+// WriteJSONTo 把 pbopt.Message 的 json 编码分段写入 w, 返回写入的字节数
+func (x *Message) WriteJSONTo(w io.Writer) (int64, error) {
+	stream := runtime.NewStream(w)
+	dst, err := x.EncodeJSON(stream.Buffer(), stream)
+	return stream.Done(dst, err)
+}
+
+// JSONSizeHint 估算 pbopt.Message 的 json 编码大小
+func (x *Message) JSONSizeHint() int {
+	if x == nil {
+		return 4
+	}
+	n := 49
+	n += x.Number.JSONSizeHint()
+	n += x.String_.JSONSizeHint()
+	n += x.Bool.JSONSizeHint()
+	return n
+}
+
+// EncodeJSON 追加 pbopt.Message 的 json 编码到 dst, stream 不为 nil 时在 list 与 map 元素之间分段写出
+func (x *Message) EncodeJSON(dst []byte, stream *runtime.Stream) ([]byte, error) {
+	if x == nil {
+		return append(dst, "null"...), nil
+	}
+	dst = append(dst, '{')
+	// go name Type : kind enum
+	// number 1
+	dst = append(dst, `"type":`...)
+	dst = strconv.AppendInt(dst, int64(x.Type), 10)
+	// go name Number : kind message
+	// number 2
+	dst = append(dst, ',')
+	dst = append(dst, `"number":`...)
+	if x.Number == nil {
+		dst = append(dst, "null"...)
+	} else {
+		if data, err := x.Number.EncodeJSON(dst, stream); err != nil {
+			return dst, err
+		} else {
+			dst = data
+		}
+	}
+	// go name String_ : kind message
+	// number 3
+	dst = append(dst, ',')
+	dst = append(dst, `"string":`...)
+	if x.String_ == nil {
+		dst = append(dst, "null"...)
+	} else {
+		if data, err := x.String_.EncodeJSON(dst, stream); err != nil {
+			return dst, err
+		} else {
+			dst = data
+		}
+	}
+	// go name Bool : kind message
+	// number 4
+	dst = append(dst, ',')
+	dst = append(dst, `"bool":`...)
+	if x.Bool == nil {
+		dst = append(dst, "null"...)
+	} else {
+		if data, err := x.Bool.EncodeJSON(dst, stream); err != nil {
+			return dst, err
+		} else {
+			dst = data
+		}
+	}
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// pbopt.Message
+func (x *Message) UnmarshalJSON(data []byte) error {
+	d := runtime.UnmarshalOptions{DiscardUnknown: true, AllowDuplicates: true}.NewDecoder(data)
+	if d.ReadNull() {
+		return d.End()
+	}
+	x.Reset()
+	if err := x.DecodeJSON(d); err != nil {
+		return runtime.WithMessage(err, "pbopt.Message")
+	}
+	return d.End()
+}
+
+func (x *Message) DecodeJSON(d *runtime.Decoder) error {
+	if err := d.ObjectStart(); err != nil {
+		return err
+	}
+	var seen uint64
+	for {
+		key, ok, err := d.ObjectNext()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch string(key) {
+		case "type":
+			// enum
+			if seen&(1<<0) != 0 {
+				if err = d.Duplicate("pbopt.Message", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 0
+			if d.ReadNull() {
+				continue
+			}
+			v, err := runtime.ReadEnum[Type](d, Type_value)
+			if err != nil {
+				return runtime.WithField(err, "type")
+			}
+			x.Type = v
+		case "number":
+			// message
+			if seen&(1<<1) != 0 {
+				if err = d.Duplicate("pbopt.Message", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 1
+			if d.ReadNull() {
+				continue
+			}
+			v := new(Number)
+			if err := v.DecodeJSON(d); err != nil {
+				return runtime.WithField(err, "number")
+			}
+			x.Number = v
+		case "string":
+			// message
+			if seen&(1<<2) != 0 {
+				if err = d.Duplicate("pbopt.Message", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 2
+			if d.ReadNull() {
+				continue
+			}
+			v := new(String)
+			if err := v.DecodeJSON(d); err != nil {
+				return runtime.WithField(err, "string")
+			}
+			x.String_ = v
+		case "bool":
+			// message
+			if seen&(1<<3) != 0 {
+				if err = d.Duplicate("pbopt.Message", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 3
+			if d.ReadNull() {
+				continue
+			}
+			v := new(Bool)
+			if err := v.DecodeJSON(d); err != nil {
+				return runtime.WithField(err, "bool")
+			}
+			x.Bool = v
+		default:
+			if err = d.Unknown("pbopt.Message", key); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+var jsonSizeHint_Array runtime.SizeHint
+
+// pbopt.Array
+func (x *Array) MarshalJSON() ([]byte, error) {
+	buf := runtime.NewPooledBufferHint(&jsonSizeHint_Array)
+	data, err := x.AppendJSON(buf.AvailableBuffer())
+	if err != nil {
+		buf.Release()
+		return nil, err
+	}
+	buf.Write(data)
+	return buf.Detach(), nil
+}
+
+// AppendJSON 追加 pbopt.Array 的 json 编码到 dst
+func (x *Array) AppendJSON(dst []byte) ([]byte, error) {
+	return x.EncodeJSON(dst, nil)
+}
+
+// WriteJSONTo 把 pbopt.Array 的 json 编码分段写入 w, 返回写入的字节数
+func (x *Array) WriteJSONTo(w io.Writer) (int64, error) {
+	stream := runtime.NewStream(w)
+	dst, err := x.EncodeJSON(stream.Buffer(), stream)
+	return stream.Done(dst, err)
+}
+
+// JSONSizeHint 估算 pbopt.Array 的 json 编码大小
+func (x *Array) JSONSizeHint() int {
+	if x == nil {
+		return 4
+	}
+	n := 96
+	n += len(x.Numbers) * 1
+	for _, val := range x.Numbers {
+		n += val.JSONSizeHint()
+	}
+	n += len(x.Strings) * 1
+	for _, val := range x.Strings {
+		n += val.JSONSizeHint()
+	}
+	n += len(x.Bools) * 1
+	for _, val := range x.Bools {
+		n += val.JSONSizeHint()
+	}
+	n += len(x.Messages) * 1
+	for _, val := range x.Messages {
+		n += val.JSONSizeHint()
+	}
+	n += len(x.Arrays) * 1
+	for _, val := range x.Arrays {
+		n += val.JSONSizeHint()
+	}
+	n += len(x.Types) * 12
+	n += len(x.U32S) * 12
+	n += len(x.Strs) * 3
+	for _, val := range x.Strs {
+		n += len(val)
+	}
+	return n
+}
+
+// EncodeJSON 追加 pbopt.Array 的 json 编码到 dst, stream 不为 nil 时在 list 与 map 元素之间分段写出
+func (x *Array) EncodeJSON(dst []byte, stream *runtime.Stream) ([]byte, error) {
+	if x == nil {
+		return append(dst, "null"...), nil
+	}
+	dst = append(dst, '{')
+	// go name Numbers : kind message
+	// number 1
+	dst = append(dst, `"numbers":[`...)
+	for i, val := range x.Numbers {
+		// message
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		if val == nil {
+			dst = append(dst, "{}"...)
+		} else {
+			if data, err := val.EncodeJSON(dst, stream); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+		}
+		dst = stream.Flush(dst)
+	}
+	dst = append(dst, ']')
+	// go name Strings : kind message
+	// number 2
+	dst = append(dst, ',')
+	dst = append(dst, `"strings":[`...)
+	for i, val := range x.Strings {
+		// message
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		if val == nil {
+			dst = append(dst, "{}"...)
+		} else {
+			if data, err := val.EncodeJSON(dst, stream); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+		}
+		dst = stream.Flush(dst)
+	}
+	dst = append(dst, ']')
+	// go name Bools : kind message
+	// number 3
+	dst = append(dst, ',')
+	dst = append(dst, `"bools":[`...)
+	for i, val := range x.Bools {
+		// message
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		if val == nil {
+			dst = append(dst, "{}"...)
+		} else {
+			if data, err := val.EncodeJSON(dst, stream); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+		}
+		dst = stream.Flush(dst)
+	}
+	dst = append(dst, ']')
+	// go name Messages : kind message
+	// number 4
+	dst = append(dst, ',')
+	dst = append(dst, `"messages":[`...)
+	for i, val := range x.Messages {
+		// message
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		if val == nil {
+			dst = append(dst, "{}"...)
+		} else {
+			if data, err := val.EncodeJSON(dst, stream); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+		}
+		dst = stream.Flush(dst)
+	}
+	dst = append(dst, ']')
+	// go name Arrays : kind message
+	// number 5
+	dst = append(dst, ',')
+	dst = append(dst, `"arrays":[`...)
+	for i, val := range x.Arrays {
+		// message
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		if val == nil {
+			dst = append(dst, "{}"...)
+		} else {
+			if data, err := val.EncodeJSON(dst, stream); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+		}
+		dst = stream.Flush(dst)
+	}
+	dst = append(dst, ']')
+	// go name Types : kind enum
+	// number 6
+	dst = append(dst, ',')
+	dst = append(dst, `"types":[`...)
+	for i, val := range x.Types {
+		// enum
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		dst = strconv.AppendInt(dst, int64(val), 10)
+		dst = stream.Flush(dst)
+	}
+	dst = append(dst, ']')
+	// go name U32S : kind uint32
+	// number 7
+	dst = append(dst, ',')
+	dst = append(dst, `"u32s":[`...)
+	for i, val := range x.U32S {
+		// uint32
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		dst = strconv.AppendUint(dst, uint64(val), 10)
+		dst = stream.Flush(dst)
+	}
+	dst = append(dst, ']')
+	// go name Strs : kind string
+	// number 8
+	dst = append(dst, ',')
+	dst = append(dst, `"strs":[`...)
+	for i, val := range x.Strs {
+		// string
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		if data, err := runtime.AppendString(dst, val); err != nil {
+			return dst, err
+		} else {
+			dst = data
+		}
+		dst = stream.Flush(dst)
+	}
+	dst = append(dst, ']')
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// pbopt.Array
+func (x *Array) UnmarshalJSON(data []byte) error {
+	d := runtime.UnmarshalOptions{DiscardUnknown: true, AllowDuplicates: true}.NewDecoder(data)
+	if d.ReadNull() {
+		return d.End()
+	}
+	x.Reset()
+	if err := x.DecodeJSON(d); err != nil {
+		return runtime.WithMessage(err, "pbopt.Array")
+	}
+	return d.End()
+}
+
+func (x *Array) DecodeJSON(d *runtime.Decoder) error {
+	if err := d.ObjectStart(); err != nil {
+		return err
+	}
+	var seen uint64
+	for {
+		key, ok, err := d.ObjectNext()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch string(key) {
+		case "numbers":
+			// message
+			if seen&(1<<0) != 0 {
+				if err = d.Duplicate("pbopt.Array", key); err != nil {
+					return err
+				}
+				x.Numbers = nil
+			}
+			seen |= 1 << 0
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ArrayStart(); err != nil {
+				return runtime.WithField(err, "numbers")
+			}
+			for i := 0; ; i++ {
+				if ok, err = d.ArrayNext(); err != nil {
+					return runtime.WithField(err, "numbers")
+				} else if !ok {
+					break
+				}
+				v := new(Number)
+				if err := v.DecodeJSON(d); err != nil {
+					return runtime.WithIndex(err, "numbers", i)
+				}
+				x.Numbers = append(x.Numbers, v)
+			}
+		case "strings":
+			// message
+			if seen&(1<<1) != 0 {
+				if err = d.Duplicate("pbopt.Array", key); err != nil {
+					return err
+				}
+				x.Strings = nil
+			}
+			seen |= 1 << 1
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ArrayStart(); err != nil {
+				return runtime.WithField(err, "strings")
+			}
+			for i := 0; ; i++ {
+				if ok, err = d.ArrayNext(); err != nil {
+					return runtime.WithField(err, "strings")
+				} else if !ok {
+					break
+				}
+				v := new(String)
+				if err := v.DecodeJSON(d); err != nil {
+					return runtime.WithIndex(err, "strings", i)
+				}
+				x.Strings = append(x.Strings, v)
+			}
+		case "bools":
+			// message
+			if seen&(1<<2) != 0 {
+				if err = d.Duplicate("pbopt.Array", key); err != nil {
+					return err
+				}
+				x.Bools = nil
+			}
+			seen |= 1 << 2
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ArrayStart(); err != nil {
+				return runtime.WithField(err, "bools")
+			}
+			for i := 0; ; i++ {
+				if ok, err = d.ArrayNext(); err != nil {
+					return runtime.WithField(err, "bools")
+				} else if !ok {
+					break
+				}
+				v := new(Bool)
+				if err := v.DecodeJSON(d); err != nil {
+					return runtime.WithIndex(err, "bools", i)
+				}
+				x.Bools = append(x.Bools, v)
+			}
+		case "messages":
+			// message
+			if seen&(1<<3) != 0 {
+				if err = d.Duplicate("pbopt.Array", key); err != nil {
+					return err
+				}
+				x.Messages = nil
+			}
+			seen |= 1 << 3
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ArrayStart(); err != nil {
+				return runtime.WithField(err, "messages")
+			}
+			for i := 0; ; i++ {
+				if ok, err = d.ArrayNext(); err != nil {
+					return runtime.WithField(err, "messages")
+				} else if !ok {
+					break
+				}
+				v := new(Message)
+				if err := v.DecodeJSON(d); err != nil {
+					return runtime.WithIndex(err, "messages", i)
+				}
+				x.Messages = append(x.Messages, v)
+			}
+		case "arrays":
+			// message
+			if seen&(1<<4) != 0 {
+				if err = d.Duplicate("pbopt.Array", key); err != nil {
+					return err
+				}
+				x.Arrays = nil
+			}
+			seen |= 1 << 4
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ArrayStart(); err != nil {
+				return runtime.WithField(err, "arrays")
+			}
+			for i := 0; ; i++ {
+				if ok, err = d.ArrayNext(); err != nil {
+					return runtime.WithField(err, "arrays")
+				} else if !ok {
+					break
+				}
+				v := new(Array)
+				if err := v.DecodeJSON(d); err != nil {
+					return runtime.WithIndex(err, "arrays", i)
+				}
+				x.Arrays = append(x.Arrays, v)
+			}
+		case "types":
+			// enum
+			if seen&(1<<5) != 0 {
+				if err = d.Duplicate("pbopt.Array", key); err != nil {
+					return err
+				}
+				x.Types = nil
+			}
+			seen |= 1 << 5
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ArrayStart(); err != nil {
+				return runtime.WithField(err, "types")
+			}
+			for i := 0; ; i++ {
+				if ok, err = d.ArrayNext(); err != nil {
+					return runtime.WithField(err, "types")
+				} else if !ok {
+					break
+				}
+				v, err := runtime.ReadEnum[Type](d, Type_value)
+				if err != nil {
+					return runtime.WithIndex(err, "types", i)
+				}
+				x.Types = append(x.Types, v)
+			}
+		case "u32s":
+			// uint32
+			if seen&(1<<6) != 0 {
+				if err = d.Duplicate("pbopt.Array", key); err != nil {
+					return err
+				}
+				x.U32S = nil
+			}
+			seen |= 1 << 6
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ArrayStart(); err != nil {
+				return runtime.WithField(err, "u32s")
+			}
+			for i := 0; ; i++ {
+				if ok, err = d.ArrayNext(); err != nil {
+					return runtime.WithField(err, "u32s")
+				} else if !ok {
+					break
+				}
+				v, err := d.ReadUint32()
+				if err != nil {
+					return runtime.WithIndex(err, "u32s", i)
+				}
+				x.U32S = append(x.U32S, v)
+			}
+		case "strs":
+			// string
+			if seen&(1<<7) != 0 {
+				if err = d.Duplicate("pbopt.Array", key); err != nil {
+					return err
+				}
+				x.Strs = nil
+			}
+			seen |= 1 << 7
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ArrayStart(); err != nil {
+				return runtime.WithField(err, "strs")
+			}
+			for i := 0; ; i++ {
+				if ok, err = d.ArrayNext(); err != nil {
+					return runtime.WithField(err, "strs")
+				} else if !ok {
+					break
+				}
+				v, err := d.ReadString()
+				if err != nil {
+					return runtime.WithIndex(err, "strs", i)
+				}
+				x.Strs = append(x.Strs, v)
+			}
+		default:
+			if err = d.Unknown("pbopt.Array", key); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+var jsonSizeHint_Map runtime.SizeHint
+
+// pbopt.Map
+func (x *Map) MarshalJSON() ([]byte, error) {
+	buf := runtime.NewPooledBufferHint(&jsonSizeHint_Map)
+	data, err := x.AppendJSON(buf.AvailableBuffer())
+	if err != nil {
+		buf.Release()
+		return nil, err
+	}
+	buf.Write(data)
+	return buf.Detach(), nil
+}
+
+// AppendJSON 追加 pbopt.Map 的 json 编码到 dst
+func (x *Map) AppendJSON(dst []byte) ([]byte, error) {
+	return x.EncodeJSON(dst, nil)
+}
+
+// WriteJSONTo 把 pbopt.Map 的 json 编码分段写入 w, 返回写入的字节数
+func (x *Map) WriteJSONTo(w io.Writer) (int64, error) {
+	stream := runtime.NewStream(w)
+	dst, err := x.EncodeJSON(stream.Buffer(), stream)
+	return stream.Done(dst, err)
+}
+
+// JSONSizeHint 估算 pbopt.Map 的 json 编码大小
+func (x *Map) JSONSizeHint() int {
+	if x == nil {
+		return 4
+	}
+	n := 136
+	n += len(x.Numbers) * 15
+	for _, val := range x.Numbers {
+		n += val.JSONSizeHint()
+	}
+	n += len(x.Strings) * 4
+	for key, val := range x.Strings {
+		n += len(key) + val.JSONSizeHint()
+	}
+	n += len(x.Bools) * 9
+	for _, val := range x.Bools {
+		n += val.JSONSizeHint()
+	}
+	n += len(x.Messages) * 4
+	for key, val := range x.Messages {
+		n += len(key) + val.JSONSizeHint()
+	}
+	n += len(x.Arrays) * 4
+	for key, val := range x.Arrays {
+		n += len(key) + val.JSONSizeHint()
+	}
+	n += len(x.Types) * 26
+	n += len(x.U32S) * 15
+	for key := range x.U32S {
+		n += len(key)
+	}
+	n += len(x.Strs) * 6
+	for key, val := range x.Strs {
+		n += len(key) + len(val)
+	}
+	n += len(x.Empties) * 4
+	for key, val := range x.Empties {
+		n += len(key) + val.JSONSizeHint()
+	}
+	n += len(x.Optionals) * 4
+	for key, val := range x.Optionals {
+		n += len(key) + val.JSONSizeHint()
+	}
+	n += len(x.Oneofs) * 4
+	for key, val := range x.Oneofs {
+		n += len(key) + val.JSONSizeHint()
+	}
+	return n
+}
+
+// EncodeJSON 追加 pbopt.Map 的 json 编码到 dst, stream 不为 nil 时在 list 与 map 元素之间分段写出
+func (x *Map) EncodeJSON(dst []byte, stream *runtime.Stream) ([]byte, error) {
+	if x == nil {
+		return append(dst, "null"...), nil
+	}
+	dst = append(dst, '{')
+	// go name Numbers : kind message
+	// number 1
+	{
+		dst = append(dst, `"numbers":{`...)
+		var many bool
+		var keys [16]uint32
+		for _, key := range runtime.SortedKeys(x.Numbers, keys[:0]) {
+			val := x.Numbers[key]
+			// message, key uint32, value message
+			if many {
+				dst = append(dst, ',')
+			} else {
+				many = true
+			}
+			dst = append(dst, '"')
+			dst = strconv.AppendUint(dst, uint64(key), 10)
+			dst = append(dst, '"')
+			dst = append(dst, ':')
+			if val == nil {
+				dst = append(dst, "{}"...)
+			} else {
+				if data, err := val.EncodeJSON(dst, stream); err != nil {
+					return dst, err
+				} else {
+					dst = data
+				}
+			}
+			dst = stream.Flush(dst)
+		}
+		dst = append(dst, '}')
+	}
+	// go name Strings : kind message
+	// number 2
+	{
+		dst = append(dst, ',')
+		dst = append(dst, `"strings":{`...)
+		var many bool
+		var keys [16]string
+		for _, key := range runtime.SortedKeys(x.Strings, keys[:0]) {
+			val := x.Strings[key]
+			// message, key string, value message
+			if many {
+				dst = append(dst, ',')
+			} else {
+				many = true
+			}
+			if data, err := runtime.AppendString(dst, key); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+			dst = append(dst, ':')
+			if val == nil {
+				dst = append(dst, "{}"...)
+			} else {
+				if data, err := val.EncodeJSON(dst, stream); err != nil {
+					return dst, err
+				} else {
+					dst = data
+				}
+			}
+			dst = stream.Flush(dst)
+		}
+		dst = append(dst, '}')
+	}
+	// go name Bools : kind message
+	// number 3
+	{
+		dst = append(dst, ',')
+		dst = append(dst, `"bools":{`...)
+		var many bool
+		for _, key := range [2]bool{false, true} {
+			val, ok := x.Bools[key]
+			if !ok {
+				continue
+			}
+			// message, key bool, value message
+			if many {
+				dst = append(dst, ',')
+			} else {
+				many = true
+			}
+			dst = append(dst, '"')
+			dst = strconv.AppendBool(dst, key)
+			dst = append(dst, '"')
+			dst = append(dst, ':')
+			if val == nil {
+				dst = append(dst, "{}"...)
+			} else {
+				if data, err := val.EncodeJSON(dst, stream); err != nil {
+					return dst, err
+				} else {
+					dst = data
+				}
+			}
+			dst = stream.Flush(dst)
+		}
+		dst = append(dst, '}')
+	}
+	// go name Messages : kind message
+	// number 4
+	{
+		dst = append(dst, ',')
+		dst = append(dst, `"messages":{`...)
+		var many bool
+		var keys [16]string
+		for _, key := range runtime.SortedKeys(x.Messages, keys[:0]) {
+			val := x.Messages[key]
+			// message, key string, value message
+			if many {
+				dst = append(dst, ',')
+			} else {
+				many = true
+			}
+			if data, err := runtime.AppendString(dst, key); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+			dst = append(dst, ':')
+			if val == nil {
+				dst = append(dst, "{}"...)
+			} else {
+				if data, err := val.EncodeJSON(dst, stream); err != nil {
+					return dst, err
+				} else {
+					dst = data
+				}
+			}
+			dst = stream.Flush(dst)
+		}
+		dst = append(dst, '}')
+	}
+	// go name Arrays : kind message
+	// number 5
+	{
+		dst = append(dst, ',')
+		dst = append(dst, `"arrays":{`...)
+		var many bool
+		var keys [16]string
+		for _, key := range runtime.SortedKeys(x.Arrays, keys[:0]) {
+			val := x.Arrays[key]
+			// message, key string, value message
+			if many {
+				dst = append(dst, ',')
+			} else {
+				many = true
+			}
+			if data, err := runtime.AppendString(dst, key); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+			dst = append(dst, ':')
+			if val == nil {
+				dst = append(dst, "{}"...)
+			} else {
+				if data, err := val.EncodeJSON(dst, stream); err != nil {
+					return dst, err
+				} else {
+					dst = data
+				}
+			}
+			dst = stream.Flush(dst)
+		}
+		dst = append(dst, '}')
+	}
+	// go name Types : kind message
+	// number 6
+	{
+		dst = append(dst, ',')
+		dst = append(dst, `"types":{`...)
+		var many bool
+		var keys [16]int32
+		for _, key := range runtime.SortedKeys(x.Types, keys[:0]) {
+			val := x.Types[key]
+			// message, key int32, value enum
+			if many {
+				dst = append(dst, ',')
+			} else {
+				many = true
+			}
+			dst = append(dst, '"')
+			dst = strconv.AppendInt(dst, int64(key), 10)
+			dst = append(dst, '"')
+			dst = append(dst, ':')
+			dst = strconv.AppendInt(dst, int64(val), 10)
+			dst = stream.Flush(dst)
+		}
+		dst = append(dst, '}')
+	}
+	// go name U32S : kind message
+	// number 7
+	{
+		dst = append(dst, ',')
+		dst = append(dst, `"u32s":{`...)
+		var many bool
+		var keys [16]string
+		for _, key := range runtime.SortedKeys(x.U32S, keys[:0]) {
+			val := x.U32S[key]
+			// message, key string, value uint32
+			if many {
+				dst = append(dst, ',')
+			} else {
+				many = true
+			}
+			if data, err := runtime.AppendString(dst, key); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+			dst = append(dst, ':')
+			dst = strconv.AppendUint(dst, uint64(val), 10)
+			dst = stream.Flush(dst)
+		}
+		dst = append(dst, '}')
+	}
+	// go name Strs : kind message
+	// number 8
+	{
+		dst = append(dst, ',')
+		dst = append(dst, `"strs":{`...)
+		var many bool
+		var keys [16]string
+		for _, key := range runtime.SortedKeys(x.Strs, keys[:0]) {
+			val := x.Strs[key]
+			// message, key string, value string
+			if many {
+				dst = append(dst, ',')
+			} else {
+				many = true
+			}
+			if data, err := runtime.AppendString(dst, key); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+			dst = append(dst, ':')
+			if data, err := runtime.AppendString(dst, val); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+			dst = stream.Flush(dst)
+		}
+		dst = append(dst, '}')
+	}
+	// go name Empties : kind message
+	// number 9
+	{
+		dst = append(dst, ',')
+		dst = append(dst, `"empties":{`...)
+		var many bool
+		var keys [16]string
+		for _, key := range runtime.SortedKeys(x.Empties, keys[:0]) {
+			val := x.Empties[key]
+			// message, key string, value message
+			if many {
+				dst = append(dst, ',')
+			} else {
+				many = true
+			}
+			if data, err := runtime.AppendString(dst, key); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+			dst = append(dst, ':')
+			if val == nil {
+				dst = append(dst, "{}"...)
+			} else {
+				if data, err := val.EncodeJSON(dst, stream); err != nil {
+					return dst, err
+				} else {
+					dst = data
+				}
+			}
+			dst = stream.Flush(dst)
+		}
+		dst = append(dst, '}')
+	}
+	// go name Optionals : kind message
+	// number 10
+	{
+		dst = append(dst, ',')
+		dst = append(dst, `"optionals":{`...)
+		var many bool
+		var keys [16]string
+		for _, key := range runtime.SortedKeys(x.Optionals, keys[:0]) {
+			val := x.Optionals[key]
+			// message, key string, value message
+			if many {
+				dst = append(dst, ',')
+			} else {
+				many = true
+			}
+			if data, err := runtime.AppendString(dst, key); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+			dst = append(dst, ':')
+			if val == nil {
+				dst = append(dst, "{}"...)
+			} else {
+				if data, err := val.EncodeJSON(dst, stream); err != nil {
+					return dst, err
+				} else {
+					dst = data
+				}
+			}
+			dst = stream.Flush(dst)
+		}
+		dst = append(dst, '}')
+	}
+	// go name Oneofs : kind message
+	// number 11
+	{
+		dst = append(dst, ',')
+		dst = append(dst, `"oneofs":{`...)
+		var many bool
+		var keys [16]string
+		for _, key := range runtime.SortedKeys(x.Oneofs, keys[:0]) {
+			val := x.Oneofs[key]
+			// message, key string, value message
+			if many {
+				dst = append(dst, ',')
+			} else {
+				many = true
+			}
+			if data, err := runtime.AppendString(dst, key); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+			dst = append(dst, ':')
+			if val == nil {
+				dst = append(dst, "{}"...)
+			} else {
+				if data, err := val.EncodeJSON(dst, stream); err != nil {
+					return dst, err
+				} else {
+					dst = data
+				}
+			}
+			dst = stream.Flush(dst)
+		}
+		dst = append(dst, '}')
+	}
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// pbopt.Map
+func (x *Map) UnmarshalJSON(data []byte) error {
+	d := runtime.UnmarshalOptions{DiscardUnknown: true, AllowDuplicates: true}.NewDecoder(data)
+	if d.ReadNull() {
+		return d.End()
+	}
+	x.Reset()
+	if err := x.DecodeJSON(d); err != nil {
+		return runtime.WithMessage(err, "pbopt.Map")
+	}
+	return d.End()
+}
+
+func (x *Map) DecodeJSON(d *runtime.Decoder) error {
+	if err := d.ObjectStart(); err != nil {
+		return err
+	}
+	var seen uint64
+	for {
+		key, ok, err := d.ObjectNext()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch string(key) {
+		case "numbers":
+			// message
+			if seen&(1<<0) != 0 {
+				if err = d.Duplicate("pbopt.Map", key); err != nil {
+					return err
+				}
+				x.Numbers = nil
+			}
+			seen |= 1 << 0
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ObjectStart(); err != nil {
+				return runtime.WithField(err, "numbers")
+			}
+			if x.Numbers == nil {
+				x.Numbers = make(map[uint32]*Number)
+			}
+			for {
+				k, ok, err := d.ObjectNext()
+				if err != nil {
+					return runtime.WithField(err, "numbers")
+				}
+				if !ok {
+					break
+				}
+				mk, err := d.KeyUint32(k)
+				if err != nil {
+					return runtime.WithField(err, "numbers")
+				}
+				if _, dup := x.Numbers[mk]; dup {
+					if err = d.DuplicateKey(k); err != nil {
+						return runtime.WithKey(err, "numbers", mk)
+					}
+				}
+				v := new(Number)
+				if err := v.DecodeJSON(d); err != nil {
+					return runtime.WithKey(err, "numbers", mk)
+				}
+				x.Numbers[mk] = v
+			}
+		case "strings":
+			// message
+			if seen&(1<<1) != 0 {
+				if err = d.Duplicate("pbopt.Map", key); err != nil {
+					return err
+				}
+				x.Strings = nil
+			}
+			seen |= 1 << 1
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ObjectStart(); err != nil {
+				return runtime.WithField(err, "strings")
+			}
+			if x.Strings == nil {
+				x.Strings = make(map[string]*String)
+			}
+			for {
+				k, ok, err := d.ObjectNext()
+				if err != nil {
+					return runtime.WithField(err, "strings")
+				}
+				if !ok {
+					break
+				}
+				mk := string(k)
+				if _, dup := x.Strings[mk]; dup {
+					if err = d.DuplicateKey(k); err != nil {
+						return runtime.WithKey(err, "strings", mk)
+					}
+				}
+				v := new(String)
+				if err := v.DecodeJSON(d); err != nil {
+					return runtime.WithKey(err, "strings", mk)
+				}
+				x.Strings[mk] = v
+			}
+		case "bools":
+			// message
+			if seen&(1<<2) != 0 {
+				if err = d.Duplicate("pbopt.Map", key); err != nil {
+					return err
+				}
+				x.Bools = nil
+			}
+			seen |= 1 << 2
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ObjectStart(); err != nil {
+				return runtime.WithField(err, "bools")
+			}
+			if x.Bools == nil {
+				x.Bools = make(map[bool]*Bool)
+			}
+			for {
+				k, ok, err := d.ObjectNext()
+				if err != nil {
+					return runtime.WithField(err, "bools")
+				}
+				if !ok {
+					break
+				}
+				mk, err := d.KeyBool(k)
+				if err != nil {
+					return runtime.WithField(err, "bools")
+				}
+				if _, dup := x.Bools[mk]; dup {
+					if err = d.DuplicateKey(k); err != nil {
+						return runtime.WithKey(err, "bools", mk)
+					}
+				}
+				v := new(Bool)
+				if err := v.DecodeJSON(d); err != nil {
+					return runtime.WithKey(err, "bools", mk)
+				}
+				x.Bools[mk] = v
+			}
+		case "messages":
+			// message
+			if seen&(1<<3) != 0 {
+				if err = d.Duplicate("pbopt.Map", key); err != nil {
+					return err
+				}
+				x.Messages = nil
+			}
+			seen |= 1 << 3
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ObjectStart(); err != nil {
+				return runtime.WithField(err, "messages")
+			}
+			if x.Messages == nil {
+				x.Messages = make(map[string]*Message)
+			}
+			for {
+				k, ok, err := d.ObjectNext()
+				if err != nil {
+					return runtime.WithField(err, "messages")
+				}
+				if !ok {
+					break
+				}
+				mk := string(k)
+				if _, dup := x.Messages[mk]; dup {
+					if err = d.DuplicateKey(k); err != nil {
+						return runtime.WithKey(err, "messages", mk)
+					}
+				}
+				v := new(Message)
+				if err := v.DecodeJSON(d); err != nil {
+					return runtime.WithKey(err, "messages", mk)
+				}
+				x.Messages[mk] = v
+			}
+		case "arrays":
+			// message
+			if seen&(1<<4) != 0 {
+				if err = d.Duplicate("pbopt.Map", key); err != nil {
+					return err
+				}
+				x.Arrays = nil
+			}
+			seen |= 1 << 4
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ObjectStart(); err != nil {
+				return runtime.WithField(err, "arrays")
+			}
+			if x.Arrays == nil {
+				x.Arrays = make(map[string]*Array)
+			}
+			for {
+				k, ok, err := d.ObjectNext()
+				if err != nil {
+					return runtime.WithField(err, "arrays")
+				}
+				if !ok {
+					break
+				}
+				mk := string(k)
+				if _, dup := x.Arrays[mk]; dup {
+					if err = d.DuplicateKey(k); err != nil {
+						return runtime.WithKey(err, "arrays", mk)
+					}
+				}
+				v := new(Array)
+				if err := v.DecodeJSON(d); err != nil {
+					return runtime.WithKey(err, "arrays", mk)
+				}
+				x.Arrays[mk] = v
+			}
+		case "types":
+			// message
+			if seen&(1<<5) != 0 {
+				if err = d.Duplicate("pbopt.Map", key); err != nil {
+					return err
+				}
+				x.Types = nil
+			}
+			seen |= 1 << 5
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ObjectStart(); err != nil {
+				return runtime.WithField(err, "types")
+			}
+			if x.Types == nil {
+				x.Types = make(map[int32]Type)
+			}
+			for {
+				k, ok, err := d.ObjectNext()
+				if err != nil {
+					return runtime.WithField(err, "types")
+				}
+				if !ok {
+					break
+				}
+				mk, err := d.KeyInt32(k)
+				if err != nil {
+					return runtime.WithField(err, "types")
+				}
+				if _, dup := x.Types[mk]; dup {
+					if err = d.DuplicateKey(k); err != nil {
+						return runtime.WithKey(err, "types", mk)
+					}
+				}
+				v, err := runtime.ReadEnum[Type](d, Type_value)
+				if err != nil {
+					return runtime.WithKey(err, "types", mk)
+				}
+				x.Types[mk] = v
+			}
+		case "u32s":
+			// message
+			if seen&(1<<6) != 0 {
+				if err = d.Duplicate("pbopt.Map", key); err != nil {
+					return err
+				}
+				x.U32S = nil
+			}
+			seen |= 1 << 6
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ObjectStart(); err != nil {
+				return runtime.WithField(err, "u32s")
+			}
+			if x.U32S == nil {
+				x.U32S = make(map[string]uint32)
+			}
+			for {
+				k, ok, err := d.ObjectNext()
+				if err != nil {
+					return runtime.WithField(err, "u32s")
+				}
+				if !ok {
+					break
+				}
+				mk := string(k)
+				if _, dup := x.U32S[mk]; dup {
+					if err = d.DuplicateKey(k); err != nil {
+						return runtime.WithKey(err, "u32s", mk)
+					}
+				}
+				v, err := d.ReadUint32()
+				if err != nil {
+					return runtime.WithKey(err, "u32s", mk)
+				}
+				x.U32S[mk] = v
+			}
+		case "strs":
+			// message
+			if seen&(1<<7) != 0 {
+				if err = d.Duplicate("pbopt.Map", key); err != nil {
+					return err
+				}
+				x.Strs = nil
+			}
+			seen |= 1 << 7
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ObjectStart(); err != nil {
+				return runtime.WithField(err, "strs")
+			}
+			if x.Strs == nil {
+				x.Strs = make(map[string]string)
+			}
+			for {
+				k, ok, err := d.ObjectNext()
+				if err != nil {
+					return runtime.WithField(err, "strs")
+				}
+				if !ok {
+					break
+				}
+				mk := string(k)
+				if _, dup := x.Strs[mk]; dup {
+					if err = d.DuplicateKey(k); err != nil {
+						return runtime.WithKey(err, "strs", mk)
+					}
+				}
+				v, err := d.ReadString()
+				if err != nil {
+					return runtime.WithKey(err, "strs", mk)
+				}
+				x.Strs[mk] = v
+			}
+		case "empties":
+			// message
+			if seen&(1<<8) != 0 {
+				if err = d.Duplicate("pbopt.Map", key); err != nil {
+					return err
+				}
+				x.Empties = nil
+			}
+			seen |= 1 << 8
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ObjectStart(); err != nil {
+				return runtime.WithField(err, "empties")
+			}
+			if x.Empties == nil {
+				x.Empties = make(map[string]*Empty)
+			}
+			for {
+				k, ok, err := d.ObjectNext()
+				if err != nil {
+					return runtime.WithField(err, "empties")
+				}
+				if !ok {
+					break
+				}
+				mk := string(k)
+				if _, dup := x.Empties[mk]; dup {
+					if err = d.DuplicateKey(k); err != nil {
+						return runtime.WithKey(err, "empties", mk)
+					}
+				}
+				v := new(Empty)
+				if err := v.DecodeJSON(d); err != nil {
+					return runtime.WithKey(err, "empties", mk)
+				}
+				x.Empties[mk] = v
+			}
+		case "optionals":
+			// message
+			if seen&(1<<9) != 0 {
+				if err = d.Duplicate("pbopt.Map", key); err != nil {
+					return err
+				}
+				x.Optionals = nil
+			}
+			seen |= 1 << 9
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ObjectStart(); err != nil {
+				return runtime.WithField(err, "optionals")
+			}
+			if x.Optionals == nil {
+				x.Optionals = make(map[string]*Optional)
+			}
+			for {
+				k, ok, err := d.ObjectNext()
+				if err != nil {
+					return runtime.WithField(err, "optionals")
+				}
+				if !ok {
+					break
+				}
+				mk := string(k)
+				if _, dup := x.Optionals[mk]; dup {
+					if err = d.DuplicateKey(k); err != nil {
+						return runtime.WithKey(err, "optionals", mk)
+					}
+				}
+				v := new(Optional)
+				if err := v.DecodeJSON(d); err != nil {
+					return runtime.WithKey(err, "optionals", mk)
+				}
+				x.Optionals[mk] = v
+			}
+		case "oneofs":
+			// message
+			if seen&(1<<10) != 0 {
+				if err = d.Duplicate("pbopt.Map", key); err != nil {
+					return err
+				}
+				x.Oneofs = nil
+			}
+			seen |= 1 << 10
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ObjectStart(); err != nil {
+				return runtime.WithField(err, "oneofs")
+			}
+			if x.Oneofs == nil {
+				x.Oneofs = make(map[string]*Oneof)
+			}
+			for {
+				k, ok, err := d.ObjectNext()
+				if err != nil {
+					return runtime.WithField(err, "oneofs")
+				}
+				if !ok {
+					break
+				}
+				mk := string(k)
+				if _, dup := x.Oneofs[mk]; dup {
+					if err = d.DuplicateKey(k); err != nil {
+						return runtime.WithKey(err, "oneofs", mk)
+					}
+				}
+				v := new(Oneof)
+				if err := v.DecodeJSON(d); err != nil {
+					return runtime.WithKey(err, "oneofs", mk)
+				}
+				x.Oneofs[mk] = v
+			}
+		default:
+			if err = d.Unknown("pbopt.Map", key); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+var jsonSizeHint_Empty runtime.SizeHint
+
+// pbopt.Empty
+func (x *Empty) MarshalJSON() ([]byte, error) {
+	buf := runtime.NewPooledBufferHint(&jsonSizeHint_Empty)
+	data, err := x.AppendJSON(buf.AvailableBuffer())
+	if err != nil {
+		buf.Release()
+		return nil, err
+	}
+	buf.Write(data)
+	return buf.Detach(), nil
+}
+
+// AppendJSON 追加 pbopt.Empty 的 json 编码到 dst
+func (x *Empty) AppendJSON(dst []byte) ([]byte, error) {
+	return x.EncodeJSON(dst, nil)
+}
+
+// WriteJSONTo 把 pbopt.Empty 的 json 编码分段写入 w, 返回写入的字节数
+func (x *Empty) WriteJSONTo(w io.Writer) (int64, error) {
+	stream := runtime.NewStream(w)
+	dst, err := x.EncodeJSON(stream.Buffer(), stream)
+	return stream.Done(dst, err)
+}
+
+// JSONSizeHint 估算 pbopt.Empty 的 json 编码大小
+func (x *Empty) JSONSizeHint() int {
+	if x == nil {
+		return 4
+	}
+	return 2
+}
+
+// EncodeJSON 追加 pbopt.Empty 的 json 编码到 dst, stream 不为 nil 时在 list 与 map 元素之间分段写出
+func (x *Empty) EncodeJSON(dst []byte, stream *runtime.Stream) ([]byte, error) {
+	if x == nil {
+		return append(dst, "null"...), nil
+	}
+	return append(dst, "{}"...), nil
+}
+
+// pbopt.Empty
+func (x *Empty) UnmarshalJSON(data []byte) error {
+	d := runtime.UnmarshalOptions{DiscardUnknown: true, AllowDuplicates: true}.NewDecoder(data)
+	if d.ReadNull() {
+		return d.End()
+	}
+	x.Reset()
+	if err := x.DecodeJSON(d); err != nil {
+		return runtime.WithMessage(err, "pbopt.Empty")
+	}
+	return d.End()
+}
+
+func (x *Empty) DecodeJSON(d *runtime.Decoder) error {
+	if err := d.ObjectStart(); err != nil {
+		return err
+	}
+	for {
+		key, ok, err := d.ObjectNext()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch string(key) {
+		default:
+			if err = d.Unknown("pbopt.Empty", key); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+var jsonSizeHint_Optional runtime.SizeHint
+
+// pbopt.Optional
+func (x *Optional) MarshalJSON() ([]byte, error) {
+	buf := runtime.NewPooledBufferHint(&jsonSizeHint_Optional)
+	data, err := x.AppendJSON(buf.AvailableBuffer())
+	if err != nil {
+		buf.Release()
+		return nil, err
+	}
+	buf.Write(data)
+	return buf.Detach(), nil
+}
+
+// AppendJSON 追加 pbopt.Optional 的 json 编码到 dst
+func (x *Optional) AppendJSON(dst []byte) ([]byte, error) {
+	return x.EncodeJSON(dst, nil)
+}
+
+// WriteJSONTo 把 pbopt.Optional 的 json 编码分段写入 w, 返回写入的字节数
+func (x *Optional) WriteJSONTo(w io.Writer) (int64, error) {
+	stream := runtime.NewStream(w)
+	dst, err := x.EncodeJSON(stream.Buffer(), stream)
+	return stream.Done(dst, err)
+}
+
+// JSONSizeHint 估算 pbopt.Optional 的 json 编码大小
+func (x *Optional) JSONSizeHint() int {
+	if x == nil {
+		return 4
+	}
+	n := 96
+	n += x.Number.JSONSizeHint()
+	n += x.String_.JSONSizeHint()
+	n += x.Bool.JSONSizeHint()
+	n += x.Message.JSONSizeHint()
+	n += x.Array.JSONSizeHint()
+	if x.Str != nil {
+		n += len(*x.Str)
+	}
+	return n
+}
+
+// EncodeJSON 追加 pbopt.Optional 的 json 编码到 dst, stream 不为 nil 时在 list 与 map 元素之间分段写出
+func (x *Optional) EncodeJSON(dst []byte, stream *runtime.Stream) ([]byte, error) {
+	if x == nil {
+		return append(dst, "null"...), nil
+	}
+	dst = append(dst, '{')
+	var writeComma bool
+	// go name Number : kind message
+	// number 1
+	if x.Number != nil {
+		writeComma = true
+		dst = append(dst, `"number":`...)
+		if data, err := x.Number.EncodeJSON(dst, stream); err != nil {
+			return dst, err
+		} else {
+			dst = data
+		}
+	}
+	// go name String_ : kind message
+	// number 2
+	if x.String_ != nil {
+		if writeComma {
+			dst = append(dst, ',')
+		} else {
+			writeComma = true
+		}
+		dst = append(dst, `"string":`...)
+		if data, err := x.String_.EncodeJSON(dst, stream); err != nil {
+			return dst, err
+		} else {
+			dst = data
+		}
+	}
+	// go name Bool : kind message
+	// number 3
+	if x.Bool != nil {
+		if writeComma {
+			dst = append(dst, ',')
+		} else {
+			writeComma = true
+		}
+		dst = append(dst, `"bool":`...)
+		if data, err := x.Bool.EncodeJSON(dst, stream); err != nil {
+			return dst, err
+		} else {
+			dst = data
+		}
+	}
+	// go name Message : kind message
+	// number 4
+	if x.Message != nil {
+		if writeComma {
+			dst = append(dst, ',')
+		} else {
+			writeComma = true
+		}
+		dst = append(dst, `"message":`...)
+		if data, err := x.Message.EncodeJSON(dst, stream); err != nil {
+			return dst, err
+		} else {
+			dst = data
+		}
+	}
+	// go name Array : kind message
+	// number 5
+	if x.Array != nil {
+		if writeComma {
+			dst = append(dst, ',')
+		} else {
+			writeComma = true
+		}
+		dst = append(dst, `"array":`...)
+		if data, err := x.Array.EncodeJSON(dst, stream); err != nil {
+			return dst, err
+		} else {
+			dst = data
+		}
+	}
+	// go name Type : kind enum
+	// number 6
+	if x.Type != nil {
+		if writeComma {
+			dst = append(dst, ',')
+		} else {
+			writeComma = true
+		}
+		dst = append(dst, `"type":`...)
+		dst = strconv.AppendInt(dst, int64(*x.Type), 10)
+	}
+	// go name U32 : kind uint32
+	// number 7
+	if x.U32 != nil {
+		if writeComma {
+			dst = append(dst, ',')
+		} else {
+			writeComma = true
+		}
+		dst = append(dst, `"u32":`...)
+		dst = strconv.AppendUint(dst, uint64(*x.U32), 10)
+	}
+	// go name Str : kind string
+	// number 8
+	if x.Str != nil {
+		if writeComma {
+			dst = append(dst, ',')
+		} else {
+			writeComma = true
+		}
+		dst = append(dst, `"str":`...)
+		if data, err := runtime.AppendString(dst, *x.Str); err != nil {
+			return dst, err
+		} else {
+			dst = data
+		}
+	}
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// pbopt.Optional
+func (x *Optional) UnmarshalJSON(data []byte) error {
+	d := runtime.UnmarshalOptions{DiscardUnknown: true, AllowDuplicates: true}.NewDecoder(data)
+	if d.ReadNull() {
+		return d.End()
+	}
+	x.Reset()
+	if err := x.DecodeJSON(d); err != nil {
+		return runtime.WithMessage(err, "pbopt.Optional")
+	}
+	return d.End()
+}
+
+func (x *Optional) DecodeJSON(d *runtime.Decoder) error {
+	if err := d.ObjectStart(); err != nil {
+		return err
+	}
+	var seen uint64
+	for {
+		key, ok, err := d.ObjectNext()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch string(key) {
+		case "number":
+			// message
+			if seen&(1<<0) != 0 {
+				if err = d.Duplicate("pbopt.Optional", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 0
+			if d.ReadNull() {
+				continue
+			}
+			v := new(Number)
+			if err := v.DecodeJSON(d); err != nil {
+				return runtime.WithField(err, "number")
+			}
+			x.Number = v
+		case "string":
+			// message
+			if seen&(1<<1) != 0 {
+				if err = d.Duplicate("pbopt.Optional", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 1
+			if d.ReadNull() {
+				continue
+			}
+			v := new(String)
+			if err := v.DecodeJSON(d); err != nil {
+				return runtime.WithField(err, "string")
+			}
+			x.String_ = v
+		case "bool":
+			// message
+			if seen&(1<<2) != 0 {
+				if err = d.Duplicate("pbopt.Optional", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 2
+			if d.ReadNull() {
+				continue
+			}
+			v := new(Bool)
+			if err := v.DecodeJSON(d); err != nil {
+				return runtime.WithField(err, "bool")
+			}
+			x.Bool = v
+		case "message":
+			// message
+			if seen&(1<<3) != 0 {
+				if err = d.Duplicate("pbopt.Optional", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 3
+			if d.ReadNull() {
+				continue
+			}
+			v := new(Message)
+			if err := v.DecodeJSON(d); err != nil {
+				return runtime.WithField(err, "message")
+			}
+			x.Message = v
+		case "array":
+			// message
+			if seen&(1<<4) != 0 {
+				if err = d.Duplicate("pbopt.Optional", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 4
+			if d.ReadNull() {
+				continue
+			}
+			v := new(Array)
+			if err := v.DecodeJSON(d); err != nil {
+				return runtime.WithField(err, "array")
+			}
+			x.Array = v
+		case "type":
+			// enum
+			if seen&(1<<5) != 0 {
+				if err = d.Duplicate("pbopt.Optional", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 5
+			if d.ReadNull() {
+				continue
+			}
+			v, err := runtime.ReadEnum[Type](d, Type_value)
+			if err != nil {
+				return runtime.WithField(err, "type")
+			}
+			x.Type = &v
+		case "u32":
+			// uint32
+			if seen&(1<<6) != 0 {
+				if err = d.Duplicate("pbopt.Optional", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 6
+			if d.ReadNull() {
+				continue
+			}
+			v, err := d.ReadUint32()
+			if err != nil {
+				return runtime.WithField(err, "u32")
+			}
+			x.U32 = &v
+		case "str":
+			// string
+			if seen&(1<<7) != 0 {
+				if err = d.Duplicate("pbopt.Optional", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 7
+			if d.ReadNull() {
+				continue
+			}
+			v, err := d.ReadString()
+			if err != nil {
+				return runtime.WithField(err, "str")
+			}
+			x.Str = &v
+		default:
+			if err = d.Unknown("pbopt.Optional", key); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+var jsonSizeHint_Oneof runtime.SizeHint
+
+// pbopt.Oneof
+func (x *Oneof) MarshalJSON() ([]byte, error) {
+	buf := runtime.NewPooledBufferHint(&jsonSizeHint_Oneof)
+	data, err := x.AppendJSON(buf.AvailableBuffer())
+	if err != nil {
+		buf.Release()
+		return nil, err
+	}
+	buf.Write(data)
+	return buf.Detach(), nil
+}
+
+// AppendJSON 追加 pbopt.Oneof 的 json 编码到 dst
+func (x *Oneof) AppendJSON(dst []byte) ([]byte, error) {
+	return x.EncodeJSON(dst, nil)
+}
+
+// WriteJSONTo 把 pbopt.Oneof 的 json 编码分段写入 w, 返回写入的字节数
+func (x *Oneof) WriteJSONTo(w io.Writer) (int64, error) {
+	stream := runtime.NewStream(w)
+	dst, err := x.EncodeJSON(stream.Buffer(), stream)
+	return stream.Done(dst, err)
+}
+
+// JSONSizeHint 估算 pbopt.Oneof 的 json 编码大小
+func (x *Oneof) JSONSizeHint() int {
+	if x == nil {
+		return 4
+	}
+	n := 36
+	n += x.Number.JSONSizeHint()
+	switch x := x.Oneof.(type) {
+	case *Oneof_String_:
+		n += 10
+		n += x.String_.JSONSizeHint()
+	case *Oneof_Bool:
+		n += 8
+		n += x.Bool.JSONSizeHint()
+	case *Oneof_Message:
+		n += 11
+		n += x.Message.JSONSizeHint()
+	case *Oneof_Array:
+		n += 9
+		n += x.Array.JSONSizeHint()
+	case *Oneof_Type:
+		n += 19
+	case *Oneof_U32:
+		n += 18
+	case *Oneof_Str:
+		n += 9
+		n += len(x.Str)
+	}
+	n += x.NumberX.JSONSizeHint()
+	n += x.StringX.JSONSizeHint()
+	return n
+}
+
+// EncodeJSON 追加 pbopt.Oneof 的 json 编码到 dst, stream 不为 nil 时在 list 与 map 元素之间分段写出
+func (x *Oneof) EncodeJSON(dst []byte, stream *runtime.Stream) ([]byte, error) {
+	if x == nil {
+		return append(dst, "null"...), nil
+	}
+	dst = append(dst, '{')
+	// go name Number : kind message
+	// number 1
+	dst = append(dst, `"number":`...)
+	if x.Number == nil {
+		dst = append(dst, "null"...)
+	} else {
+		if data, err := x.Number.EncodeJSON(dst, stream); err != nil {
+			return dst, err
+		} else {
+			dst = data
+		}
+	}
+	// go name String_ : kind message
+	// Oneof String_
+	if x.Oneof != nil {
+		switch x := x.Oneof.(type) {
+		// String_ Oneof_String_ 2
+		case *Oneof_String_:
+			dst = append(dst, ',')
+			dst = append(dst, `"string":`...)
+			if x.String_ == nil {
+				dst = append(dst, "{}"...)
+			} else {
+				if data, err := x.String_.EncodeJSON(dst, stream); err != nil {
+					return dst, err
+				} else {
+					dst = data
+				}
+			}
+		// Bool Oneof_Bool 3
+		case *Oneof_Bool:
+			dst = append(dst, ',')
+			dst = append(dst, `"bool":`...)
+			if x.Bool == nil {
+				dst = append(dst, "{}"...)
+			} else {
+				if data, err := x.Bool.EncodeJSON(dst, stream); err != nil {
+					return dst, err
+				} else {
+					dst = data
+				}
+			}
+		// Message Oneof_Message 4
+		case *Oneof_Message:
+			dst = append(dst, ',')
+			dst = append(dst, `"message":`...)
+			if x.Message == nil {
+				dst = append(dst, "{}"...)
+			} else {
+				if data, err := x.Message.EncodeJSON(dst, stream); err != nil {
+					return dst, err
+				} else {
+					dst = data
+				}
+			}
+		// Array Oneof_Array 5
+		case *Oneof_Array:
+			dst = append(dst, ',')
+			dst = append(dst, `"array":`...)
+			if x.Array == nil {
+				dst = append(dst, "{}"...)
+			} else {
+				if data, err := x.Array.EncodeJSON(dst, stream); err != nil {
+					return dst, err
+				} else {
+					dst = data
+				}
+			}
+		// Type Oneof_Type 6
+		case *Oneof_Type:
+			dst = append(dst, ',')
+			dst = append(dst, `"type":`...)
+			dst = strconv.AppendInt(dst, int64(x.Type), 10)
+		// U32 Oneof_U32 7
+		case *Oneof_U32:
+			dst = append(dst, ',')
+			dst = append(dst, `"u32":`...)
+			dst = strconv.AppendUint(dst, uint64(x.U32), 10)
+		// Str Oneof_Str 8
+		case *Oneof_Str:
+			dst = append(dst, ',')
+			dst = append(dst, `"str":`...)
+			if data, err := runtime.AppendString(dst, x.Str); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+		}
+	}
+	// go name Bool : kind message
+	// go name Message : kind message
+	// go name Array : kind message
+	// go name Type : kind enum
+	// go name U32 : kind uint32
+	// go name Str : kind string
+	// go name NumberX : kind message
+	// number 9
+	dst = append(dst, ',')
+	dst = append(dst, `"number_x":`...)
+	if x.NumberX == nil {
+		dst = append(dst, "null"...)
+	} else {
+		if data, err := x.NumberX.EncodeJSON(dst, stream); err != nil {
+			return dst, err
+		} else {
+			dst = data
+		}
+	}
+	// go name StringX : kind message
+	// number 10
+	dst = append(dst, ',')
+	dst = append(dst, `"string_x":`...)
+	if x.StringX == nil {
+		dst = append(dst, "null"...)
+	} else {
+		if data, err := x.StringX.EncodeJSON(dst, stream); err != nil {
+			return dst, err
+		} else {
+			dst = data
+		}
+	}
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// pbopt.Oneof
+func (x *Oneof) UnmarshalJSON(data []byte) error {
+	d := runtime.UnmarshalOptions{DiscardUnknown: true, AllowDuplicates: true}.NewDecoder(data)
+	if d.ReadNull() {
+		return d.End()
+	}
+	x.Reset()
+	if err := x.DecodeJSON(d); err != nil {
+		return runtime.WithMessage(err, "pbopt.Oneof")
+	}
+	return d.End()
+}
+
+func (x *Oneof) DecodeJSON(d *runtime.Decoder) error {
+	if err := d.ObjectStart(); err != nil {
+		return err
+	}
+	var seen uint64
+	for {
+		key, ok, err := d.ObjectNext()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch string(key) {
+		case "number":
+			// message
+			if seen&(1<<0) != 0 {
+				if err = d.Duplicate("pbopt.Oneof", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 0
+			if d.ReadNull() {
+				continue
+			}
+			v := new(Number)
+			if err := v.DecodeJSON(d); err != nil {
+				return runtime.WithField(err, "number")
+			}
+			x.Number = v
+		case "string":
+			// message
+			if seen&(1<<1) != 0 {
+				if err = d.Duplicate("pbopt.Oneof", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 1
+			if d.ReadNull() {
+				continue
+			}
+			if seen&(1<<10) != 0 {
+				if err = d.OneofConflict("pbopt.Oneof.oneof", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 10
+			v := new(String)
+			if err := v.DecodeJSON(d); err != nil {
+				return runtime.WithField(err, "string")
+			}
+			x.Oneof = &Oneof_String_{String_: v}
+		case "bool":
+			// message
+			if seen&(1<<2) != 0 {
+				if err = d.Duplicate("pbopt.Oneof", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 2
+			if d.ReadNull() {
+				continue
+			}
+			if seen&(1<<10) != 0 {
+				if err = d.OneofConflict("pbopt.Oneof.oneof", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 10
+			v := new(Bool)
+			if err := v.DecodeJSON(d); err != nil {
+				return runtime.WithField(err, "bool")
+			}
+			x.Oneof = &Oneof_Bool{Bool: v}
+		case "message":
+			// message
+			if seen&(1<<3) != 0 {
+				if err = d.Duplicate("pbopt.Oneof", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 3
+			if d.ReadNull() {
+				continue
+			}
+			if seen&(1<<10) != 0 {
+				if err = d.OneofConflict("pbopt.Oneof.oneof", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 10
+			v := new(Message)
+			if err := v.DecodeJSON(d); err != nil {
+				return runtime.WithField(err, "message")
+			}
+			x.Oneof = &Oneof_Message{Message: v}
+		case "array":
+			// message
+			if seen&(1<<4) != 0 {
+				if err = d.Duplicate("pbopt.Oneof", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 4
+			if d.ReadNull() {
+				continue
+			}
+			if seen&(1<<10) != 0 {
+				if err = d.OneofConflict("pbopt.Oneof.oneof", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 10
+			v := new(Array)
+			if err := v.DecodeJSON(d); err != nil {
+				return runtime.WithField(err, "array")
+			}
+			x.Oneof = &Oneof_Array{Array: v}
+		case "type":
+			// enum
+			if seen&(1<<5) != 0 {
+				if err = d.Duplicate("pbopt.Oneof", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 5
+			if d.ReadNull() {
+				continue
+			}
+			if seen&(1<<10) != 0 {
+				if err = d.OneofConflict("pbopt.Oneof.oneof", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 10
+			v, err := runtime.ReadEnum[Type](d, Type_value)
+			if err != nil {
+				return runtime.WithField(err, "type")
+			}
+			x.Oneof = &Oneof_Type{Type: v}
+		case "u32":
+			// uint32
+			if seen&(1<<6) != 0 {
+				if err = d.Duplicate("pbopt.Oneof", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 6
+			if d.ReadNull() {
+				continue
+			}
+			if seen&(1<<10) != 0 {
+				if err = d.OneofConflict("pbopt.Oneof.oneof", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 10
+			v, err := d.ReadUint32()
+			if err != nil {
+				return runtime.WithField(err, "u32")
+			}
+			x.Oneof = &Oneof_U32{U32: v}
+		case "str":
+			// string
+			if seen&(1<<7) != 0 {
+				if err = d.Duplicate("pbopt.Oneof", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 7
+			if d.ReadNull() {
+				continue
+			}
+			if seen&(1<<10) != 0 {
+				if err = d.OneofConflict("pbopt.Oneof.oneof", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 10
+			v, err := d.ReadString()
+			if err != nil {
+				return runtime.WithField(err, "str")
+			}
+			x.Oneof = &Oneof_Str{Str: v}
+		case "numberX", "number_x":
+			// message
+			if seen&(1<<8) != 0 {
+				if err = d.Duplicate("pbopt.Oneof", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 8
+			if d.ReadNull() {
+				continue
+			}
+			v := new(Number)
+			if err := v.DecodeJSON(d); err != nil {
+				return runtime.WithField(err, "number_x")
+			}
+			x.NumberX = v
+		case "stringX", "string_x":
+			// message
+			if seen&(1<<9) != 0 {
+				if err = d.Duplicate("pbopt.Oneof", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 9
+			if d.ReadNull() {
+				continue
+			}
+			v := new(String)
+			if err := v.DecodeJSON(d); err != nil {
+				return runtime.WithField(err, "string_x")
+			}
+			x.StringX = v
+		default:
+			if err = d.Unknown("pbopt.Oneof", key); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+var jsonSizeHint_FieldOrder runtime.SizeHint
+
+// pbopt.FieldOrder
+func (x *FieldOrder) MarshalJSON() ([]byte, error) {
+	buf := runtime.NewPooledBufferHint(&jsonSizeHint_FieldOrder)
+	data, err := x.AppendJSON(buf.AvailableBuffer())
+	if err != nil {
+		buf.Release()
+		return nil, err
+	}
+	buf.Write(data)
+	return buf.Detach(), nil
+}
+
+// AppendJSON 追加 pbopt.FieldOrder 的 json 编码到 dst
+func (x *FieldOrder) AppendJSON(dst []byte) ([]byte, error) {
+	return x.EncodeJSON(dst, nil)
+}
+
+// WriteJSONTo 把 pbopt.FieldOrder 的 json 编码分段写入 w, 返回写入的字节数
+func (x *FieldOrder) WriteJSONTo(w io.Writer) (int64, error) {
+	stream := runtime.NewStream(w)
+	dst, err := x.EncodeJSON(stream.Buffer(), stream)
+	return stream.Done(dst, err)
+}
+
+// JSONSizeHint 估算 pbopt.FieldOrder 的 json 编码大小
+func (x *FieldOrder) JSONSizeHint() int {
+	if x == nil {
+		return 4
+	}
+	n := 72
+	n += len(x.C)
+	n += len(x.List) * 12
+	return n
+}
+
+// EncodeJSON 追加 pbopt.FieldOrder 的 json 编码到 dst, stream 不为 nil 时在 list 与 map 元素之间分段写出
+func (x *FieldOrder) EncodeJSON(dst []byte, stream *runtime.Stream) ([]byte, error) {
+	if x == nil {
+		return append(dst, "null"...), nil
+	}
+	dst = append(dst, '{')
+	// go name E : kind uint32
+	// number 5
+	dst = append(dst, `"e":`...)
+	dst = strconv.AppendUint(dst, uint64(x.E), 10)
+	// go name C : kind string
+	// number 3
+	dst = append(dst, ',')
+	dst = append(dst, `"c":`...)
+	if data, err := runtime.AppendString(dst, x.C); err != nil {
+		return dst, err
+	} else {
+		dst = data
+	}
+	// go name D : kind bool
+	// number 9
+	if x.D != nil {
+		dst = append(dst, ',')
+		dst = append(dst, `"d":`...)
+		dst = strconv.AppendBool(dst, *x.D)
+	}
+	// go name List : kind uint32
+	// number 2
+	dst = append(dst, ',')
+	dst = append(dst, `"list":[`...)
+	for i, val := range x.List {
+		// uint32
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		dst = strconv.AppendUint(dst, uint64(val), 10)
+		dst = stream.Flush(dst)
+	}
+	dst = append(dst, ']')
+	// go name A : kind uint64
+	// number 1
+	dst = append(dst, ',')
+	dst = append(dst, `"a":`...)
+	dst = append(dst, '"')
+	dst = strconv.AppendUint(dst, uint64(x.A), 10)
+	dst = append(dst, '"')
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// pbopt.FieldOrder
+func (x *FieldOrder) UnmarshalJSON(data []byte) error {
+	d := runtime.UnmarshalOptions{DiscardUnknown: true, AllowDuplicates: true}.NewDecoder(data)
+	if d.ReadNull() {
+		return d.End()
+	}
+	x.Reset()
+	if err := x.DecodeJSON(d); err != nil {
+		return runtime.WithMessage(err, "pbopt.FieldOrder")
+	}
+	return d.End()
+}
+
+func (x *FieldOrder) DecodeJSON(d *runtime.Decoder) error {
+	if err := d.ObjectStart(); err != nil {
+		return err
+	}
+	var seen uint64
+	for {
+		key, ok, err := d.ObjectNext()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch string(key) {
+		case "e":
+			// uint32
+			if seen&(1<<0) != 0 {
+				if err = d.Duplicate("pbopt.FieldOrder", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 0
+			if d.ReadNull() {
+				continue
+			}
+			v, err := d.ReadUint32()
+			if err != nil {
+				return runtime.WithField(err, "e")
+			}
+			x.E = v
+		case "c":
+			// string
+			if seen&(1<<1) != 0 {
+				if err = d.Duplicate("pbopt.FieldOrder", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 1
+			if d.ReadNull() {
+				continue
+			}
+			v, err := d.ReadString()
+			if err != nil {
+				return runtime.WithField(err, "c")
+			}
+			x.C = v
+		case "d":
+			// bool
+			if seen&(1<<2) != 0 {
+				if err = d.Duplicate("pbopt.FieldOrder", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 2
+			if d.ReadNull() {
+				continue
+			}
+			v, err := d.ReadBool()
+			if err != nil {
+				return runtime.WithField(err, "d")
+			}
+			x.D = &v
+		case "list":
+			// uint32
+			if seen&(1<<3) != 0 {
+				if err = d.Duplicate("pbopt.FieldOrder", key); err != nil {
+					return err
+				}
+				x.List = nil
+			}
+			seen |= 1 << 3
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ArrayStart(); err != nil {
+				return runtime.WithField(err, "list")
+			}
+			for i := 0; ; i++ {
+				if ok, err = d.ArrayNext(); err != nil {
+					return runtime.WithField(err, "list")
+				} else if !ok {
+					break
+				}
+				v, err := d.ReadUint32()
+				if err != nil {
+					return runtime.WithIndex(err, "list", i)
+				}
+				x.List = append(x.List, v)
+			}
+		case "a":
+			// uint64
+			if seen&(1<<4) != 0 {
+				if err = d.Duplicate("pbopt.FieldOrder", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 4
+			if d.ReadNull() {
+				continue
+			}
+			v, err := d.ReadUint64()
+			if err != nil {
+				return runtime.WithField(err, "a")
+			}
+			x.A = v
+		default:
+			if err = d.Unknown("pbopt.FieldOrder", key); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+var jsonSizeHint_OneofFirst runtime.SizeHint
+
+// pbopt.OneofFirst
+func (x *OneofFirst) MarshalJSON() ([]byte, error) {
+	buf := runtime.NewPooledBufferHint(&jsonSizeHint_OneofFirst)
+	data, err := x.AppendJSON(buf.AvailableBuffer())
+	if err != nil {
+		buf.Release()
+		return nil, err
+	}
+	buf.Write(data)
+	return buf.Detach(), nil
+}
+
+// AppendJSON 追加 pbopt.OneofFirst 的 json 编码到 dst
+func (x *OneofFirst) AppendJSON(dst []byte) ([]byte, error) {
+	return x.EncodeJSON(dst, nil)
+}
+
+// WriteJSONTo 把 pbopt.OneofFirst 的 json 编码分段写入 w, 返回写入的字节数
+func (x *OneofFirst) WriteJSONTo(w io.Writer) (int64, error) {
+	stream := runtime.NewStream(w)
+	dst, err := x.EncodeJSON(stream.Buffer(), stream)
+	return stream.Done(dst, err)
+}
+
+// JSONSizeHint 估算 pbopt.OneofFirst 的 json 编码大小
+func (x *OneofFirst) JSONSizeHint() int {
+	if x == nil {
+		return 4
+	}
+	n := 19
+	switch x := x.First.(type) {
+	case *OneofFirst_S:
+		n += 7
+		n += len(x.S)
+	case *OneofFirst_U:
+		n += 16
+	}
+	n += len(x.Map) * 15
+	for key := range x.Map {
+		n += len(key)
+	}
+	n += x.Bool.JSONSizeHint()
+	switch x := x.Second.(type) {
+	case *OneofFirst_T:
+		n += 7
+		n += len(x.T)
+	case *OneofFirst_B:
+		n += 5
+		n += x.B.JSONSizeHint()
+	}
+	return n
+}
+
+// EncodeJSON 追加 pbopt.OneofFirst 的 json 编码到 dst, stream 不为 nil 时在 list 与 map 元素之间分段写出
+func (x *OneofFirst) EncodeJSON(dst []byte, stream *runtime.Stream) ([]byte, error) {
+	if x == nil {
+		return append(dst, "null"...), nil
+	}
+	dst = append(dst, '{')
+	var writeComma bool
+	// go name S : kind string
+	// First S
+	if x.First != nil {
+		switch x := x.First.(type) {
+		// S OneofFirst_S 3
+		case *OneofFirst_S:
+			writeComma = true
+			dst = append(dst, `"s":`...)
+			if data, err := runtime.AppendString(dst, x.S); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+		// U OneofFirst_U 4
+		case *OneofFirst_U:
+			writeComma = true
+			dst = append(dst, `"u":`...)
+			dst = strconv.AppendUint(dst, uint64(x.U), 10)
+		}
+	}
+	// go name U : kind uint32
+	// go name Map : kind message
+	// number 2
+	{
+		if writeComma {
+			dst = append(dst, ',')
+		}
+		dst = append(dst, `"map":{`...)
+		var many bool
+		var keys [16]string
+		for _, key := range runtime.SortedKeys(x.Map, keys[:0]) {
+			val := x.Map[key]
+			// message, key string, value uint32
+			if many {
+				dst = append(dst, ',')
+			} else {
+				many = true
+			}
+			if data, err := runtime.AppendString(dst, key); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+			dst = append(dst, ':')
+			dst = strconv.AppendUint(dst, uint64(val), 10)
+			dst = stream.Flush(dst)
+		}
+		dst = append(dst, '}')
+	}
+	// go name Bool : kind message
+	// number 7
+	dst = append(dst, ',')
+	dst = append(dst, `"bool":`...)
+	if x.Bool == nil {
+		dst = append(dst, "null"...)
+	} else {
+		if data, err := x.Bool.EncodeJSON(dst, stream); err != nil {
+			return dst, err
+		} else {
+			dst = data
+		}
+	}
+	// go name T : kind string
+	// Second T
+	if x.Second != nil {
+		switch x := x.Second.(type) {
+		// T OneofFirst_T 8
+		case *OneofFirst_T:
+			dst = append(dst, ',')
+			dst = append(dst, `"t":`...)
+			if data, err := runtime.AppendString(dst, x.T); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+		// B OneofFirst_B 9
+		case *OneofFirst_B:
+			dst = append(dst, ',')
+			dst = append(dst, `"b":`...)
+			if x.B == nil {
+				dst = append(dst, "{}"...)
+			} else {
+				if data, err := x.B.EncodeJSON(dst, stream); err != nil {
+					return dst, err
+				} else {
+					dst = data
+				}
+			}
+		}
+	}
+	// go name B : kind message
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// pbopt.OneofFirst
+func (x *OneofFirst) UnmarshalJSON(data []byte) error {
+	d := runtime.UnmarshalOptions{DiscardUnknown: true, AllowDuplicates: true}.NewDecoder(data)
+	if d.ReadNull() {
+		return d.End()
+	}
+	x.Reset()
+	if err := x.DecodeJSON(d); err != nil {
+		return runtime.WithMessage(err, "pbopt.OneofFirst")
+	}
+	return d.End()
+}
+
+func (x *OneofFirst) DecodeJSON(d *runtime.Decoder) error {
+	if err := d.ObjectStart(); err != nil {
+		return err
+	}
+	var seen uint64
+	for {
+		key, ok, err := d.ObjectNext()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch string(key) {
+		case "s":
+			// string
+			if seen&(1<<0) != 0 {
+				if err = d.Duplicate("pbopt.OneofFirst", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 0
+			if d.ReadNull() {
+				continue
+			}
+			if seen&(1<<6) != 0 {
+				if err = d.OneofConflict("pbopt.OneofFirst.first", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 6
+			v, err := d.ReadString()
+			if err != nil {
+				return runtime.WithField(err, "s")
+			}
+			x.First = &OneofFirst_S{S: v}
+		case "u":
+			// uint32
+			if seen&(1<<1) != 0 {
+				if err = d.Duplicate("pbopt.OneofFirst", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 1
+			if d.ReadNull() {
+				continue
+			}
+			if seen&(1<<6) != 0 {
+				if err = d.OneofConflict("pbopt.OneofFirst.first", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 6
+			v, err := d.ReadUint32()
+			if err != nil {
+				return runtime.WithField(err, "u")
+			}
+			x.First = &OneofFirst_U{U: v}
+		case "map":
+			// message
+			if seen&(1<<2) != 0 {
+				if err = d.Duplicate("pbopt.OneofFirst", key); err != nil {
+					return err
+				}
+				x.Map = nil
+			}
+			seen |= 1 << 2
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ObjectStart(); err != nil {
+				return runtime.WithField(err, "map")
+			}
+			if x.Map == nil {
+				x.Map = make(map[string]uint32)
+			}
+			for {
+				k, ok, err := d.ObjectNext()
+				if err != nil {
+					return runtime.WithField(err, "map")
+				}
+				if !ok {
+					break
+				}
+				mk := string(k)
+				if _, dup := x.Map[mk]; dup {
+					if err = d.DuplicateKey(k); err != nil {
+						return runtime.WithKey(err, "map", mk)
+					}
+				}
+				v, err := d.ReadUint32()
+				if err != nil {
+					return runtime.WithKey(err, "map", mk)
+				}
+				x.Map[mk] = v
+			}
+		case "bool":
+			// message
+			if seen&(1<<3) != 0 {
+				if err = d.Duplicate("pbopt.OneofFirst", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 3
+			if d.ReadNull() {
+				continue
+			}
+			v := new(Bool)
+			if err := v.DecodeJSON(d); err != nil {
+				return runtime.WithField(err, "bool")
+			}
+			x.Bool = v
+		case "t":
+			// string
+			if seen&(1<<4) != 0 {
+				if err = d.Duplicate("pbopt.OneofFirst", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 4
+			if d.ReadNull() {
+				continue
+			}
+			if seen&(1<<7) != 0 {
+				if err = d.OneofConflict("pbopt.OneofFirst.second", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 7
+			v, err := d.ReadString()
+			if err != nil {
+				return runtime.WithField(err, "t")
+			}
+			x.Second = &OneofFirst_T{T: v}
+		case "b":
+			// message
+			if seen&(1<<5) != 0 {
+				if err = d.Duplicate("pbopt.OneofFirst", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 5
+			if d.ReadNull() {
+				continue
+			}
+			if seen&(1<<7) != 0 {
+				if err = d.OneofConflict("pbopt.OneofFirst.second", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 7
+			v := new(Bool)
+			if err := v.DecodeJSON(d); err != nil {
+				return runtime.WithField(err, "b")
+			}
+			x.Second = &OneofFirst_B{B: v}
+		default:
+			if err = d.Unknown("pbopt.OneofFirst", key); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+var jsonSizeHint_Single runtime.SizeHint
+
+// pbopt.Single
+func (x *Single) MarshalJSON() ([]byte, error) {
+	buf := runtime.NewPooledBufferHint(&jsonSizeHint_Single)
+	data, err := x.AppendJSON(buf.AvailableBuffer())
+	if err != nil {
+		buf.Release()
+		return nil, err
+	}
+	buf.Write(data)
+	return buf.Detach(), nil
+}
+
+// AppendJSON 追加 pbopt.Single 的 json 编码到 dst
+func (x *Single) AppendJSON(dst []byte) ([]byte, error) {
+	return x.EncodeJSON(dst, nil)
+}
+
+// WriteJSONTo 把 pbopt.Single 的 json 编码分段写入 w, 返回写入的字节数
+func (x *Single) WriteJSONTo(w io.Writer) (int64, error) {
+	stream := runtime.NewStream(w)
+	dst, err := x.EncodeJSON(stream.Buffer(), stream)
+	return stream.Done(dst, err)
+}
+
+// JSONSizeHint 估算 pbopt.Single 的 json 编码大小
+func (x *Single) JSONSizeHint() int {
+	if x == nil {
+		return 4
+	}
+	n := 9
+	n += len(x.S)
+	return n
+}
+
+// EncodeJSON 追加 pbopt.Single 的 json 编码到 dst, stream 不为 nil 时在 list 与 map 元素之间分段写出
+func (x *Single) EncodeJSON(dst []byte, stream *runtime.Stream) ([]byte, error) {
+	if x == nil {
+		return append(dst, "null"...), nil
+	}
+	dst = append(dst, '{')
+	// go name S : kind string
+	// number 2
+	dst = append(dst, `"s":`...)
+	if data, err := runtime.AppendString(dst, x.S); err != nil {
+		return dst, err
+	} else {
+		dst = data
+	}
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// pbopt.Single
+func (x *Single) UnmarshalJSON(data []byte) error {
+	d := runtime.UnmarshalOptions{DiscardUnknown: true, AllowDuplicates: true}.NewDecoder(data)
+	if d.ReadNull() {
+		return d.End()
+	}
+	x.Reset()
+	if err := x.DecodeJSON(d); err != nil {
+		return runtime.WithMessage(err, "pbopt.Single")
+	}
+	return d.End()
+}
+
+func (x *Single) DecodeJSON(d *runtime.Decoder) error {
+	if err := d.ObjectStart(); err != nil {
+		return err
+	}
+	var seen uint64
+	for {
+		key, ok, err := d.ObjectNext()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch string(key) {
+		case "s":
+			// string
+			if seen&(1<<0) != 0 {
+				if err = d.Duplicate("pbopt.Single", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 0
+			if d.ReadNull() {
+				continue
+			}
+			v, err := d.ReadString()
+			if err != nil {
+				return runtime.WithField(err, "s")
+			}
+			x.S = v
+		default:
+			if err = d.Unknown("pbopt.Single", key); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+var jsonSizeHint_WellKnown runtime.SizeHint
+
+// pbopt.WellKnown
+func (x *WellKnown) MarshalJSON() ([]byte, error) {
+	buf := runtime.NewPooledBufferHint(&jsonSizeHint_WellKnown)
+	data, err := x.AppendJSON(buf.AvailableBuffer())
+	if err != nil {
+		buf.Release()
+		return nil, err
+	}
+	buf.Write(data)
+	return buf.Detach(), nil
+}
+
+// AppendJSON 追加 pbopt.WellKnown 的 json 编码到 dst
+func (x *WellKnown) AppendJSON(dst []byte) ([]byte, error) {
+	return x.EncodeJSON(dst, nil)
+}
+
+// WriteJSONTo 把 pbopt.WellKnown 的 json 编码分段写入 w, 返回写入的字节数
+func (x *WellKnown) WriteJSONTo(w io.Writer) (int64, error) {
+	stream := runtime.NewStream(w)
+	dst, err := x.EncodeJSON(stream.Buffer(), stream)
+	return stream.Done(dst, err)
+}
+
+// JSONSizeHint 估算 pbopt.WellKnown 的 json 编码大小
+func (x *WellKnown) JSONSizeHint() int {
+	if x == nil {
+		return 4
+	}
+	n := 223
+	n += len(x.Timestamps) * 33
+	n += len(x.Durations) * 30
+	for key := range x.Durations {
+		n += len(key)
+	}
+	switch x.Time.(type) {
+	case *WellKnown_At:
+		n += 38
+	case *WellKnown_After:
+		n += 35
+	}
+	n += len(x.Masks) * 65
+	n += len(x.Empties) * 6
+	for key := range x.Empties {
+		n += len(key)
+	}
+	return n
+}
+
+// EncodeJSON 追加 pbopt.WellKnown 的 json 编码到 dst, stream 不为 nil 时在 list 与 map 元素之间分段写出
+func (x *WellKnown) EncodeJSON(dst []byte, stream *runtime.Stream) ([]byte, error) {
+	if x == nil {
+		return append(dst, "null"...), nil
+	}
+	dst = append(dst, '{')
+	// go name Timestamp : kind message
+	// number 1
+	dst = append(dst, `"timestamp":`...)
+	if x.Timestamp == nil {
+		dst = append(dst, "null"...)
+	} else {
+		if data, err := runtime.AppendTimestamp(dst, x.Timestamp); err != nil {
+			return dst, err
+		} else {
+			dst = data
+		}
+	}
+	// go name Duration : kind message
+	// number 2
+	dst = append(dst, ',')
+	dst = append(dst, `"duration":`...)
+	if x.Duration == nil {
+		dst = append(dst, "null"...)
+	} else {
+		if data, err := runtime.AppendDuration(dst, x.Duration); err != nil {
+			return dst, err
+		} else {
+			dst = data
+		}
+	}
+	// go name Timestamps : kind message
+	// number 3
+	dst = append(dst, ',')
+	dst = append(dst, `"timestamps":[`...)
+	for i, val := range x.Timestamps {
+		// message
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		if data, err := runtime.AppendTimestamp(dst, val); err != nil {
+			return dst, err
+		} else {
+			dst = data
+		}
+		dst = stream.Flush(dst)
+	}
+	dst = append(dst, ']')
+	// go name Durations : kind message
+	// number 4
+	{
+		dst = append(dst, ',')
+		dst = append(dst, `"durations":{`...)
+		var many bool
+		var keys [16]string
+		for _, key := range runtime.SortedKeys(x.Durations, keys[:0]) {
+			val := x.Durations[key]
+			// message, key string, value message
+			if many {
+				dst = append(dst, ',')
+			} else {
+				many = true
+			}
+			if data, err := runtime.AppendString(dst, key); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+			dst = append(dst, ':')
+			if data, err := runtime.AppendDuration(dst, val); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+			dst = stream.Flush(dst)
+		}
+		dst = append(dst, '}')
+	}
+	// go name At : kind message
+	// Time At
+	if x.Time != nil {
+		switch x := x.Time.(type) {
+		// At WellKnown_At 5
+		case *WellKnown_At:
+			dst = append(dst, ',')
+			dst = append(dst, `"at":`...)
+			if data, err := runtime.AppendTimestamp(dst, x.At); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+		// After WellKnown_After 6
+		case *WellKnown_After:
+			dst = append(dst, ',')
+			dst = append(dst, `"after":`...)
+			if data, err := runtime.AppendDuration(dst, x.After); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+		}
+	}
+	// go name After : kind message
+	// go name Mask : kind message
+	// number 7
+	dst = append(dst, ',')
+	dst = append(dst, `"mask":`...)
+	if x.Mask == nil {
+		dst = append(dst, "null"...)
+	} else {
+		if data, err := runtime.AppendFieldMask(dst, x.Mask); err != nil {
+			return dst, err
+		} else {
+			dst = data
+		}
+	}
+	// go name Empty : kind message
+	// number 8
+	dst = append(dst, ',')
+	dst = append(dst, `"empty":`...)
+	if x.Empty == nil {
+		dst = append(dst, "null"...)
+	} else {
+		if data, err := runtime.AppendEmpty(dst, x.Empty); err != nil {
+			return dst, err
+		} else {
+			dst = data
+		}
+	}
+	// go name Masks : kind message
+	// number 9
+	dst = append(dst, ',')
+	dst = append(dst, `"masks":[`...)
+	for i, val := range x.Masks {
+		// message
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		if data, err := runtime.AppendFieldMask(dst, val); err != nil {
+			return dst, err
+		} else {
+			dst = data
+		}
+		dst = stream.Flush(dst)
+	}
+	dst = append(dst, ']')
+	// go name Empties : kind message
+	// number 10
+	{
+		dst = append(dst, ',')
+		dst = append(dst, `"empties":{`...)
+		var many bool
+		var keys [16]string
+		for _, key := range runtime.SortedKeys(x.Empties, keys[:0]) {
+			val := x.Empties[key]
+			// message, key string, value message
+			if many {
+				dst = append(dst, ',')
+			} else {
+				many = true
+			}
+			if data, err := runtime.AppendString(dst, key); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+			dst = append(dst, ':')
+			if data, err := runtime.AppendEmpty(dst, val); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+			dst = stream.Flush(dst)
+		}
+		dst = append(dst, '}')
+	}
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// pbopt.WellKnown
+func (x *WellKnown) UnmarshalJSON(data []byte) error {
+	d := runtime.UnmarshalOptions{DiscardUnknown: true, AllowDuplicates: true}.NewDecoder(data)
+	if d.ReadNull() {
+		return d.End()
+	}
+	x.Reset()
+	if err := x.DecodeJSON(d); err != nil {
+		return runtime.WithMessage(err, "pbopt.WellKnown")
+	}
+	return d.End()
+}
+
+func (x *WellKnown) DecodeJSON(d *runtime.Decoder) error {
+	if err := d.ObjectStart(); err != nil {
+		return err
+	}
+	var seen uint64
+	for {
+		key, ok, err := d.ObjectNext()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch string(key) {
+		case "timestamp":
+			// message
+			if seen&(1<<0) != 0 {
+				if err = d.Duplicate("pbopt.WellKnown", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 0
+			if d.ReadNull() {
+				continue
+			}
+			v, err := d.ReadTimestamp()
+			if err != nil {
+				return runtime.WithField(err, "timestamp")
+			}
+			x.Timestamp = v
+		case "duration":
+			// message
+			if seen&(1<<1) != 0 {
+				if err = d.Duplicate("pbopt.WellKnown", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 1
+			if d.ReadNull() {
+				continue
+			}
+			v, err := d.ReadDuration()
+			if err != nil {
+				return runtime.WithField(err, "duration")
+			}
+			x.Duration = v
+		case "timestamps":
+			// message
+			if seen&(1<<2) != 0 {
+				if err = d.Duplicate("pbopt.WellKnown", key); err != nil {
+					return err
+				}
+				x.Timestamps = nil
+			}
+			seen |= 1 << 2
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ArrayStart(); err != nil {
+				return runtime.WithField(err, "timestamps")
+			}
+			for i := 0; ; i++ {
+				if ok, err = d.ArrayNext(); err != nil {
+					return runtime.WithField(err, "timestamps")
+				} else if !ok {
+					break
+				}
+				v, err := d.ReadTimestamp()
+				if err != nil {
+					return runtime.WithIndex(err, "timestamps", i)
+				}
+				x.Timestamps = append(x.Timestamps, v)
+			}
+		case "durations":
+			// message
+			if seen&(1<<3) != 0 {
+				if err = d.Duplicate("pbopt.WellKnown", key); err != nil {
+					return err
+				}
+				x.Durations = nil
+			}
+			seen |= 1 << 3
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ObjectStart(); err != nil {
+				return runtime.WithField(err, "durations")
+			}
+			if x.Durations == nil {
+				x.Durations = make(map[string]*durationpb.Duration)
+			}
+			for {
+				k, ok, err := d.ObjectNext()
+				if err != nil {
+					return runtime.WithField(err, "durations")
+				}
+				if !ok {
+					break
+				}
+				mk := string(k)
+				if _, dup := x.Durations[mk]; dup {
+					if err = d.DuplicateKey(k); err != nil {
+						return runtime.WithKey(err, "durations", mk)
+					}
+				}
+				v, err := d.ReadDuration()
+				if err != nil {
+					return runtime.WithKey(err, "durations", mk)
+				}
+				x.Durations[mk] = v
+			}
+		case "at":
+			// message
+			if seen&(1<<4) != 0 {
+				if err = d.Duplicate("pbopt.WellKnown", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 4
+			if d.ReadNull() {
+				continue
+			}
+			if seen&(1<<10) != 0 {
+				if err = d.OneofConflict("pbopt.WellKnown.time", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 10
+			v, err := d.ReadTimestamp()
+			if err != nil {
+				return runtime.WithField(err, "at")
+			}
+			x.Time = &WellKnown_At{At: v}
+		case "after":
+			// message
+			if seen&(1<<5) != 0 {
+				if err = d.Duplicate("pbopt.WellKnown", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 5
+			if d.ReadNull() {
+				continue
+			}
+			if seen&(1<<10) != 0 {
+				if err = d.OneofConflict("pbopt.WellKnown.time", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 10
+			v, err := d.ReadDuration()
+			if err != nil {
+				return runtime.WithField(err, "after")
+			}
+			x.Time = &WellKnown_After{After: v}
+		case "mask":
+			// message
+			if seen&(1<<6) != 0 {
+				if err = d.Duplicate("pbopt.WellKnown", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 6
+			if d.ReadNull() {
+				continue
+			}
+			v, err := d.ReadFieldMask()
+			if err != nil {
+				return runtime.WithField(err, "mask")
+			}
+			x.Mask = v
+		case "empty":
+			// message
+			if seen&(1<<7) != 0 {
+				if err = d.Duplicate("pbopt.WellKnown", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 7
+			if d.ReadNull() {
+				continue
+			}
+			v, err := d.ReadEmpty()
+			if err != nil {
+				return runtime.WithField(err, "empty")
+			}
+			x.Empty = v
+		case "masks":
+			// message
+			if seen&(1<<8) != 0 {
+				if err = d.Duplicate("pbopt.WellKnown", key); err != nil {
+					return err
+				}
+				x.Masks = nil
+			}
+			seen |= 1 << 8
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ArrayStart(); err != nil {
+				return runtime.WithField(err, "masks")
+			}
+			for i := 0; ; i++ {
+				if ok, err = d.ArrayNext(); err != nil {
+					return runtime.WithField(err, "masks")
+				} else if !ok {
+					break
+				}
+				v, err := d.ReadFieldMask()
+				if err != nil {
+					return runtime.WithIndex(err, "masks", i)
+				}
+				x.Masks = append(x.Masks, v)
+			}
+		case "empties":
+			// message
+			if seen&(1<<9) != 0 {
+				if err = d.Duplicate("pbopt.WellKnown", key); err != nil {
+					return err
+				}
+				x.Empties = nil
+			}
+			seen |= 1 << 9
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ObjectStart(); err != nil {
+				return runtime.WithField(err, "empties")
+			}
+			if x.Empties == nil {
+				x.Empties = make(map[string]*emptypb.Empty)
+			}
+			for {
+				k, ok, err := d.ObjectNext()
+				if err != nil {
+					return runtime.WithField(err, "empties")
+				}
+				if !ok {
+					break
+				}
+				mk := string(k)
+				if _, dup := x.Empties[mk]; dup {
+					if err = d.DuplicateKey(k); err != nil {
+						return runtime.WithKey(err, "empties", mk)
+					}
+				}
+				v, err := d.ReadEmpty()
+				if err != nil {
+					return runtime.WithKey(err, "empties", mk)
+				}
+				x.Empties[mk] = v
+			}
+		default:
+			if err = d.Unknown("pbopt.WellKnown", key); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+var jsonSizeHint_Structs runtime.SizeHint
+
+// pbopt.Structs
+func (x *Structs) MarshalJSON() ([]byte, error) {
+	buf := runtime.NewPooledBufferHint(&jsonSizeHint_Structs)
+	data, err := x.AppendJSON(buf.AvailableBuffer())
+	if err != nil {
+		buf.Release()
+		return nil, err
+	}
+	buf.Write(data)
+	return buf.Detach(), nil
+}
+
+// AppendJSON 追加 pbopt.Structs 的 json 编码到 dst
+func (x *Structs) AppendJSON(dst []byte) ([]byte, error) {
+	return x.EncodeJSON(dst, nil)
+}
+
+// WriteJSONTo 把 pbopt.Structs 的 json 编码分段写入 w, 返回写入的字节数
+func (x *Structs) WriteJSONTo(w io.Writer) (int64, error) {
+	stream := runtime.NewStream(w)
+	dst, err := x.EncodeJSON(stream.Buffer(), stream)
+	return stream.Done(dst, err)
+}
+
+// JSONSizeHint 估算 pbopt.Structs 的 json 编码大小
+func (x *Structs) JSONSizeHint() int {
+	if x == nil {
+		return 4
+	}
+	n := 246
+	n += len(x.Values) * 65
+	n += len(x.Structs) * 68
+	for key := range x.Structs {
+		n += len(key)
+	}
+	switch x.Oneof.(type) {
+	case *Structs_Ov:
+		n += 70
+	case *Structs_Os:
+		n += 70
+	}
+	return n
+}
+
+// EncodeJSON 追加 pbopt.Structs 的 json 编码到 dst, stream 不为 nil 时在 list 与 map 元素之间分段写出
+func (x *Structs) EncodeJSON(dst []byte, stream *runtime.Stream) ([]byte, error) {
+	if x == nil {
+		return append(dst, "null"...), nil
+	}
+	dst = append(dst, '{')
+	// go name Struct : kind message
+	// number 1
+	dst = append(dst, `"struct":`...)
+	if x.Struct == nil {
+		dst = append(dst, "null"...)
+	} else {
+		if data, err := runtime.AppendStruct(dst, x.Struct, runtime.Deterministic); err != nil {
+			return dst, err
+		} else {
+			dst = data
+		}
+	}
+	// go name Value : kind message
+	// number 2
+	dst = append(dst, ',')
+	dst = append(dst, `"value":`...)
+	if x.Value == nil {
+		dst = append(dst, "null"...)
+	} else {
+		if data, err := runtime.AppendValue(dst, x.Value, runtime.Deterministic); err != nil {
+			return dst, err
+		} else {
+			dst = data
+		}
+	}
+	// go name List : kind message
+	// number 3
+	dst = append(dst, ',')
+	dst = append(dst, `"list":`...)
+	if x.List == nil {
+		dst = append(dst, "null"...)
+	} else {
+		if data, err := runtime.AppendListValue(dst, x.List, runtime.Deterministic); err != nil {
+			return dst, err
+		} else {
+			dst = data
+		}
+	}
+	// go name Values : kind message
+	// number 4
+	dst = append(dst, ',')
+	dst = append(dst, `"values":[`...)
+	for i, val := range x.Values {
+		// message
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		if data, err := runtime.AppendValue(dst, val, runtime.Deterministic); err != nil {
+			return dst, err
+		} else {
+			dst = data
+		}
+		dst = stream.Flush(dst)
+	}
+	dst = append(dst, ']')
+	// go name Structs : kind message
+	// number 5
+	{
+		dst = append(dst, ',')
+		dst = append(dst, `"structs":{`...)
+		var many bool
+		var keys [16]string
+		for _, key := range runtime.SortedKeys(x.Structs, keys[:0]) {
+			val := x.Structs[key]
+			// message, key string, value message
+			if many {
+				dst = append(dst, ',')
+			} else {
+				many = true
+			}
+			if data, err := runtime.AppendString(dst, key); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+			dst = append(dst, ':')
+			if data, err := runtime.AppendStruct(dst, val, runtime.Deterministic); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+			dst = stream.Flush(dst)
+		}
+		dst = append(dst, '}')
+	}
+	// go name Ov : kind message
+	// Oneof Ov
+	if x.Oneof != nil {
+		switch x := x.Oneof.(type) {
+		// Ov Structs_Ov 6
+		case *Structs_Ov:
+			dst = append(dst, ',')
+			dst = append(dst, `"ov":`...)
+			if data, err := runtime.AppendValue(dst, x.Ov, runtime.Deterministic); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+		// Os Structs_Os 7
+		case *Structs_Os:
+			dst = append(dst, ',')
+			dst = append(dst, `"os":`...)
+			if data, err := runtime.AppendStruct(dst, x.Os, runtime.Deterministic); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+		}
+	}
+	// go name Os : kind message
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// pbopt.Structs
+func (x *Structs) UnmarshalJSON(data []byte) error {
+	d := runtime.UnmarshalOptions{DiscardUnknown: true, AllowDuplicates: true}.NewDecoder(data)
+	if d.ReadNull() {
+		return d.End()
+	}
+	x.Reset()
+	if err := x.DecodeJSON(d); err != nil {
+		return runtime.WithMessage(err, "pbopt.Structs")
+	}
+	return d.End()
+}
+
+func (x *Structs) DecodeJSON(d *runtime.Decoder) error {
+	if err := d.ObjectStart(); err != nil {
+		return err
+	}
+	var seen uint64
+	for {
+		key, ok, err := d.ObjectNext()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch string(key) {
+		case "struct":
+			// message
+			if seen&(1<<0) != 0 {
+				if err = d.Duplicate("pbopt.Structs", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 0
+			if d.ReadNull() {
+				continue
+			}
+			v, err := d.ReadStruct()
+			if err != nil {
+				return runtime.WithField(err, "struct")
+			}
+			x.Struct = v
+		case "value":
+			// message
+			if seen&(1<<1) != 0 {
+				if err = d.Duplicate("pbopt.Structs", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 1
+			v, err := d.ReadValue()
+			if err != nil {
+				return runtime.WithField(err, "value")
+			}
+			x.Value = v
+		case "list":
+			// message
+			if seen&(1<<2) != 0 {
+				if err = d.Duplicate("pbopt.Structs", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 2
+			if d.ReadNull() {
+				continue
+			}
+			v, err := d.ReadListValue()
+			if err != nil {
+				return runtime.WithField(err, "list")
+			}
+			x.List = v
+		case "values":
+			// message
+			if seen&(1<<3) != 0 {
+				if err = d.Duplicate("pbopt.Structs", key); err != nil {
+					return err
+				}
+				x.Values = nil
+			}
+			seen |= 1 << 3
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ArrayStart(); err != nil {
+				return runtime.WithField(err, "values")
+			}
+			for i := 0; ; i++ {
+				if ok, err = d.ArrayNext(); err != nil {
+					return runtime.WithField(err, "values")
+				} else if !ok {
+					break
+				}
+				v, err := d.ReadValue()
+				if err != nil {
+					return runtime.WithIndex(err, "values", i)
+				}
+				x.Values = append(x.Values, v)
+			}
+		case "structs":
+			// message
+			if seen&(1<<4) != 0 {
+				if err = d.Duplicate("pbopt.Structs", key); err != nil {
+					return err
+				}
+				x.Structs = nil
+			}
+			seen |= 1 << 4
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ObjectStart(); err != nil {
+				return runtime.WithField(err, "structs")
+			}
+			if x.Structs == nil {
+				x.Structs = make(map[string]*structpb.Struct)
+			}
+			for {
+				k, ok, err := d.ObjectNext()
+				if err != nil {
+					return runtime.WithField(err, "structs")
+				}
+				if !ok {
+					break
+				}
+				mk := string(k)
+				if _, dup := x.Structs[mk]; dup {
+					if err = d.DuplicateKey(k); err != nil {
+						return runtime.WithKey(err, "structs", mk)
+					}
+				}
+				v, err := d.ReadStruct()
+				if err != nil {
+					return runtime.WithKey(err, "structs", mk)
+				}
+				x.Structs[mk] = v
+			}
+		case "ov":
+			// message
+			if seen&(1<<5) != 0 {
+				if err = d.Duplicate("pbopt.Structs", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 5
+			if seen&(1<<7) != 0 {
+				if err = d.OneofConflict("pbopt.Structs.oneof", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 7
+			v, err := d.ReadValue()
+			if err != nil {
+				return runtime.WithField(err, "ov")
+			}
+			x.Oneof = &Structs_Ov{Ov: v}
+		case "os":
+			// message
+			if seen&(1<<6) != 0 {
+				if err = d.Duplicate("pbopt.Structs", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 6
+			if d.ReadNull() {
+				continue
+			}
+			if seen&(1<<7) != 0 {
+				if err = d.OneofConflict("pbopt.Structs.oneof", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 7
+			v, err := d.ReadStruct()
+			if err != nil {
+				return runtime.WithField(err, "os")
+			}
+			x.Oneof = &Structs_Os{Os: v}
+		default:
+			if err = d.Unknown("pbopt.Structs", key); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+var jsonSizeHint_Anys runtime.SizeHint
+
+// pbopt.Anys
+func (x *Anys) MarshalJSON() ([]byte, error) {
+	buf := runtime.NewPooledBufferHint(&jsonSizeHint_Anys)
+	data, err := x.AppendJSON(buf.AvailableBuffer())
+	if err != nil {
+		buf.Release()
+		return nil, err
+	}
+	buf.Write(data)
+	return buf.Detach(), nil
+}
+
+// AppendJSON 追加 pbopt.Anys 的 json 编码到 dst
+func (x *Anys) AppendJSON(dst []byte) ([]byte, error) {
+	return x.EncodeJSON(dst, nil)
+}
+
+// WriteJSONTo 把 pbopt.Anys 的 json 编码分段写入 w, 返回写入的字节数
+func (x *Anys) WriteJSONTo(w io.Writer) (int64, error) {
+	stream := runtime.NewStream(w)
+	dst, err := x.EncodeJSON(stream.Buffer(), stream)
+	return stream.Done(dst, err)
+}
+
+// JSONSizeHint 估算 pbopt.Anys 的 json 编码大小
+func (x *Anys) JSONSizeHint() int {
+	if x == nil {
+		return 4
+	}
+	n := 92
+	n += len(x.Anys) * 65
+	n += len(x.Map) * 68
+	for key := range x.Map {
+		n += len(key)
+	}
+	return n
+}
+
+// EncodeJSON 追加 pbopt.Anys 的 json 编码到 dst, stream 不为 nil 时在 list 与 map 元素之间分段写出
+func (x *Anys) EncodeJSON(dst []byte, stream *runtime.Stream) ([]byte, error) {
+	if x == nil {
+		return append(dst, "null"...), nil
+	}
+	dst = append(dst, '{')
+	// go name Any : kind message
+	// number 1
+	dst = append(dst, `"any":`...)
+	if x.Any == nil {
+		dst = append(dst, "null"...)
+	} else {
+		if data, err := runtime.AppendAny(dst, x.Any, runtime.Deterministic); err != nil {
+			return dst, err
+		} else {
+			dst = data
+		}
+	}
+	// go name Anys : kind message
+	// number 2
+	dst = append(dst, ',')
+	dst = append(dst, `"anys":[`...)
+	for i, val := range x.Anys {
+		// message
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		if data, err := runtime.AppendAny(dst, val, runtime.Deterministic); err != nil {
+			return dst, err
+		} else {
+			dst = data
+		}
+		dst = stream.Flush(dst)
+	}
+	dst = append(dst, ']')
+	// go name Map : kind message
+	// number 3
+	{
+		dst = append(dst, ',')
+		dst = append(dst, `"map":{`...)
+		var many bool
+		var keys [16]string
+		for _, key := range runtime.SortedKeys(x.Map, keys[:0]) {
+			val := x.Map[key]
+			// message, key string, value message
+			if many {
+				dst = append(dst, ',')
+			} else {
+				many = true
+			}
+			if data, err := runtime.AppendString(dst, key); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+			dst = append(dst, ':')
+			if data, err := runtime.AppendAny(dst, val, runtime.Deterministic); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+			dst = stream.Flush(dst)
+		}
+		dst = append(dst, '}')
+	}
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// pbopt.Anys
+func (x *Anys) UnmarshalJSON(data []byte) error {
+	d := runtime.UnmarshalOptions{DiscardUnknown: true, AllowDuplicates: true}.NewDecoder(data)
+	if d.ReadNull() {
+		return d.End()
+	}
+	x.Reset()
+	if err := x.DecodeJSON(d); err != nil {
+		return runtime.WithMessage(err, "pbopt.Anys")
+	}
+	return d.End()
+}
+
+func (x *Anys) DecodeJSON(d *runtime.Decoder) error {
+	if err := d.ObjectStart(); err != nil {
+		return err
+	}
+	var seen uint64
+	for {
+		key, ok, err := d.ObjectNext()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch string(key) {
+		case "any":
+			// message
+			if seen&(1<<0) != 0 {
+				if err = d.Duplicate("pbopt.Anys", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 0
+			if d.ReadNull() {
+				continue
+			}
+			v, err := d.ReadAny()
+			if err != nil {
+				return runtime.WithField(err, "any")
+			}
+			x.Any = v
+		case "anys":
+			// message
+			if seen&(1<<1) != 0 {
+				if err = d.Duplicate("pbopt.Anys", key); err != nil {
+					return err
+				}
+				x.Anys = nil
+			}
+			seen |= 1 << 1
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ArrayStart(); err != nil {
+				return runtime.WithField(err, "anys")
+			}
+			for i := 0; ; i++ {
+				if ok, err = d.ArrayNext(); err != nil {
+					return runtime.WithField(err, "anys")
+				} else if !ok {
+					break
+				}
+				v, err := d.ReadAny()
+				if err != nil {
+					return runtime.WithIndex(err, "anys", i)
+				}
+				x.Anys = append(x.Anys, v)
+			}
+		case "map":
+			// message
+			if seen&(1<<2) != 0 {
+				if err = d.Duplicate("pbopt.Anys", key); err != nil {
+					return err
+				}
+				x.Map = nil
+			}
+			seen |= 1 << 2
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ObjectStart(); err != nil {
+				return runtime.WithField(err, "map")
+			}
+			if x.Map == nil {
+				x.Map = make(map[string]*anypb.Any)
+			}
+			for {
+				k, ok, err := d.ObjectNext()
+				if err != nil {
+					return runtime.WithField(err, "map")
+				}
+				if !ok {
+					break
+				}
+				mk := string(k)
+				if _, dup := x.Map[mk]; dup {
+					if err = d.DuplicateKey(k); err != nil {
+						return runtime.WithKey(err, "map", mk)
+					}
+				}
+				v, err := d.ReadAny()
+				if err != nil {
+					return runtime.WithKey(err, "map", mk)
+				}
+				x.Map[mk] = v
+			}
+		default:
+			if err = d.Unknown("pbopt.Anys", key); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+var jsonSizeHint_Wrappers runtime.SizeHint
+
+// pbopt.Wrappers
+func (x *Wrappers) MarshalJSON() ([]byte, error) {
+	buf := runtime.NewPooledBufferHint(&jsonSizeHint_Wrappers)
+	data, err := x.AppendJSON(buf.AvailableBuffer())
+	if err != nil {
+		buf.Release()
+		return nil, err
+	}
+	buf.Write(data)
+	return buf.Detach(), nil
+}
+
+// AppendJSON 追加 pbopt.Wrappers 的 json 编码到 dst
+func (x *Wrappers) AppendJSON(dst []byte) ([]byte, error) {
+	return x.EncodeJSON(dst, nil)
+}
+
+// WriteJSONTo 把 pbopt.Wrappers 的 json 编码分段写入 w, 返回写入的字节数
+func (x *Wrappers) WriteJSONTo(w io.Writer) (int64, error) {
+	stream := runtime.NewStream(w)
+	dst, err := x.EncodeJSON(stream.Buffer(), stream)
+	return stream.Done(dst, err)
+}
+
+// JSONSizeHint 估算 pbopt.Wrappers 的 json 编码大小
+func (x *Wrappers) JSONSizeHint() int {
+	if x == nil {
+		return 4
+	}
+	n := 208
+	n += len(x.Str.GetValue())
+	n += (len(x.Bytes.GetValue()) + 2) / 3 * 4
+	n += len(x.I64S) * 23
+	n += len(x.Strs) * 6
+	for key, val := range x.Strs {
+		n += len(key) + len(val.GetValue())
+	}
+	switch x.Oneof.(type) {
+	case *Wrappers_Ob:
+		n += 11
+	case *Wrappers_Of64:
+		n += 32
+	}
+	return n
+}
+
+// EncodeJSON 追加 pbopt.Wrappers 的 json 编码到 dst, stream 不为 nil 时在 list 与 map 元素之间分段写出
+func (x *Wrappers) EncodeJSON(dst []byte, stream *runtime.Stream) ([]byte, error) {
+	if x == nil {
+		return append(dst, "null"...), nil
+	}
+	dst = append(dst, '{')
+	// go name F64 : kind message
+	// number 1
+	dst = append(dst, `"f64":`...)
+	if x.F64 == nil {
+		dst = append(dst, "null"...)
+	} else {
+		dst = runtime.AppendFloat(dst, float64(x.F64.GetValue()), 64)
+	}
+	// go name F32 : kind message
+	// number 2
+	dst = append(dst, ',')
+	dst = append(dst, `"f32":`...)
+	if x.F32 == nil {
+		dst = append(dst, "null"...)
+	} else {
+		dst = runtime.AppendFloat(dst, float64(x.F32.GetValue()), 32)
+	}
+	// go name I64 : kind message
+	// number 3
+	dst = append(dst, ',')
+	dst = append(dst, `"i64":`...)
+	if x.I64 == nil {
+		dst = append(dst, "null"...)
+	} else {
+		dst = append(dst, '"')
+		dst = strconv.AppendInt(dst, int64(x.I64.GetValue()), 10)
+		dst = append(dst, '"')
+	}
+	// go name U64 : kind message
+	// number 4
+	dst = append(dst, ',')
+	dst = append(dst, `"u64":`...)
+	if x.U64 == nil {
+		dst = append(dst, "null"...)
+	} else {
+		dst = append(dst, '"')
+		dst = strconv.AppendUint(dst, uint64(x.U64.GetValue()), 10)
+		dst = append(dst, '"')
+	}
+	// go name I32 : kind message
+	// number 5
+	dst = append(dst, ',')
+	dst = append(dst, `"i32":`...)
+	if x.I32 == nil {
+		dst = append(dst, "null"...)
+	} else {
+		dst = strconv.AppendInt(dst, int64(x.I32.GetValue()), 10)
+	}
+	// go name U32 : kind message
+	// number 6
+	dst = append(dst, ',')
+	dst = append(dst, `"u32":`...)
+	if x.U32 == nil {
+		dst = append(dst, "null"...)
+	} else {
+		dst = strconv.AppendUint(dst, uint64(x.U32.GetValue()), 10)
+	}
+	// go name B : kind message
+	// number 7
+	dst = append(dst, ',')
+	dst = append(dst, `"b":`...)
+	if x.B == nil {
+		dst = append(dst, "null"...)
+	} else {
+		dst = strconv.AppendBool(dst, x.B.GetValue())
+	}
+	// go name Str : kind message
+	// number 8
+	dst = append(dst, ',')
+	dst = append(dst, `"str":`...)
+	if x.Str == nil {
+		dst = append(dst, "null"...)
+	} else {
+		if data, err := runtime.AppendString(dst, x.Str.GetValue()); err != nil {
+			return dst, err
+		} else {
+			dst = data
+		}
+	}
+	// go name Bytes : kind message
+	// number 9
+	dst = append(dst, ',')
+	dst = append(dst, `"bytes":`...)
+	if x.Bytes == nil {
+		dst = append(dst, "null"...)
+	} else {
+		dst = runtime.AppendBytes(dst, x.Bytes.GetValue())
+	}
+	// go name I64S : kind message
+	// number 10
+	dst = append(dst, ',')
+	dst = append(dst, `"i64s":[`...)
+	for i, val := range x.I64S {
+		// message
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		dst = append(dst, '"')
+		dst = strconv.AppendInt(dst, int64(val.GetValue()), 10)
+		dst = append(dst, '"')
+		dst = stream.Flush(dst)
+	}
+	dst = append(dst, ']')
+	// go name Strs : kind message
+	// number 11
+	{
+		dst = append(dst, ',')
+		dst = append(dst, `"strs":{`...)
+		var many bool
+		var keys [16]string
+		for _, key := range runtime.SortedKeys(x.Strs, keys[:0]) {
+			val := x.Strs[key]
+			// message, key string, value message
+			if many {
+				dst = append(dst, ',')
+			} else {
+				many = true
+			}
+			if data, err := runtime.AppendString(dst, key); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+			dst = append(dst, ':')
+			if data, err := runtime.AppendString(dst, val.GetValue()); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+			dst = stream.Flush(dst)
+		}
+		dst = append(dst, '}')
+	}
+	// go name Ob : kind message
+	// Oneof Ob
+	if x.Oneof != nil {
+		switch x := x.Oneof.(type) {
+		// Ob Wrappers_Ob 12
+		case *Wrappers_Ob:
+			dst = append(dst, ',')
+			dst = append(dst, `"ob":`...)
+			dst = strconv.AppendBool(dst, x.Ob.GetValue())
+		// Of64 Wrappers_Of64 13
+		case *Wrappers_Of64:
+			dst = append(dst, ',')
+			dst = append(dst, `"of64":`...)
+			dst = runtime.AppendFloat(dst, float64(x.Of64.GetValue()), 64)
+		}
+	}
+	// go name Of64 : kind message
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// pbopt.Wrappers
+func (x *Wrappers) UnmarshalJSON(data []byte) error {
+	d := runtime.UnmarshalOptions{DiscardUnknown: true, AllowDuplicates: true}.NewDecoder(data)
+	if d.ReadNull() {
+		return d.End()
+	}
+	x.Reset()
+	if err := x.DecodeJSON(d); err != nil {
+		return runtime.WithMessage(err, "pbopt.Wrappers")
+	}
+	return d.End()
+}
+
+func (x *Wrappers) DecodeJSON(d *runtime.Decoder) error {
+	if err := d.ObjectStart(); err != nil {
+		return err
+	}
+	var seen uint64
+	for {
+		key, ok, err := d.ObjectNext()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch string(key) {
+		case "f64":
+			// message
+			if seen&(1<<0) != 0 {
+				if err = d.Duplicate("pbopt.Wrappers", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 0
+			if d.ReadNull() {
+				continue
+			}
+			vValue, err := d.ReadFloat64()
+			if err != nil {
+				return runtime.WithField(err, "f64")
+			}
+			v := &wrapperspb.DoubleValue{Value: vValue}
+			x.F64 = v
+		case "f32":
+			// message
+			if seen&(1<<1) != 0 {
+				if err = d.Duplicate("pbopt.Wrappers", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 1
+			if d.ReadNull() {
+				continue
+			}
+			vValue, err := d.ReadFloat32()
+			if err != nil {
+				return runtime.WithField(err, "f32")
+			}
+			v := &wrapperspb.FloatValue{Value: vValue}
+			x.F32 = v
+		case "i64":
+			// message
+			if seen&(1<<2) != 0 {
+				if err = d.Duplicate("pbopt.Wrappers", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 2
+			if d.ReadNull() {
+				continue
+			}
+			vValue, err := d.ReadInt64()
+			if err != nil {
+				return runtime.WithField(err, "i64")
+			}
+			v := &wrapperspb.Int64Value{Value: vValue}
+			x.I64 = v
+		case "u64":
+			// message
+			if seen&(1<<3) != 0 {
+				if err = d.Duplicate("pbopt.Wrappers", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 3
+			if d.ReadNull() {
+				continue
+			}
+			vValue, err := d.ReadUint64()
+			if err != nil {
+				return runtime.WithField(err, "u64")
+			}
+			v := &wrapperspb.UInt64Value{Value: vValue}
+			x.U64 = v
+		case "i32":
+			// message
+			if seen&(1<<4) != 0 {
+				if err = d.Duplicate("pbopt.Wrappers", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 4
+			if d.ReadNull() {
+				continue
+			}
+			vValue, err := d.ReadInt32()
+			if err != nil {
+				return runtime.WithField(err, "i32")
+			}
+			v := &wrapperspb.Int32Value{Value: vValue}
+			x.I32 = v
+		case "u32":
+			// message
+			if seen&(1<<5) != 0 {
+				if err = d.Duplicate("pbopt.Wrappers", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 5
+			if d.ReadNull() {
+				continue
+			}
+			vValue, err := d.ReadUint32()
+			if err != nil {
+				return runtime.WithField(err, "u32")
+			}
+			v := &wrapperspb.UInt32Value{Value: vValue}
+			x.U32 = v
+		case "b":
+			// message
+			if seen&(1<<6) != 0 {
+				if err = d.Duplicate("pbopt.Wrappers", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 6
+			if d.ReadNull() {
+				continue
+			}
+			vValue, err := d.ReadBool()
+			if err != nil {
+				return runtime.WithField(err, "b")
+			}
+			v := &wrapperspb.BoolValue{Value: vValue}
+			x.B = v
+		case "str":
+			// message
+			if seen&(1<<7) != 0 {
+				if err = d.Duplicate("pbopt.Wrappers", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 7
+			if d.ReadNull() {
+				continue
+			}
+			vValue, err := d.ReadString()
+			if err != nil {
+				return runtime.WithField(err, "str")
+			}
+			v := &wrapperspb.StringValue{Value: vValue}
+			x.Str = v
+		case "bytes":
+			// message
+			if seen&(1<<8) != 0 {
+				if err = d.Duplicate("pbopt.Wrappers", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 8
+			if d.ReadNull() {
+				continue
+			}
+			vValue, err := d.ReadBytes()
+			if err != nil {
+				return runtime.WithField(err, "bytes")
+			}
+			v := &wrapperspb.BytesValue{Value: vValue}
+			x.Bytes = v
+		case "i64s":
+			// message
+			if seen&(1<<9) != 0 {
+				if err = d.Duplicate("pbopt.Wrappers", key); err != nil {
+					return err
+				}
+				x.I64S = nil
+			}
+			seen |= 1 << 9
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ArrayStart(); err != nil {
+				return runtime.WithField(err, "i64s")
+			}
+			for i := 0; ; i++ {
+				if ok, err = d.ArrayNext(); err != nil {
+					return runtime.WithField(err, "i64s")
+				} else if !ok {
+					break
+				}
+				vValue, err := d.ReadInt64()
+				if err != nil {
+					return runtime.WithIndex(err, "i64s", i)
+				}
+				v := &wrapperspb.Int64Value{Value: vValue}
+				x.I64S = append(x.I64S, v)
+			}
+		case "strs":
+			// message
+			if seen&(1<<10) != 0 {
+				if err = d.Duplicate("pbopt.Wrappers", key); err != nil {
+					return err
+				}
+				x.Strs = nil
+			}
+			seen |= 1 << 10
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ObjectStart(); err != nil {
+				return runtime.WithField(err, "strs")
+			}
+			if x.Strs == nil {
+				x.Strs = make(map[string]*wrapperspb.StringValue)
+			}
+			for {
+				k, ok, err := d.ObjectNext()
+				if err != nil {
+					return runtime.WithField(err, "strs")
+				}
+				if !ok {
+					break
+				}
+				mk := string(k)
+				if _, dup := x.Strs[mk]; dup {
+					if err = d.DuplicateKey(k); err != nil {
+						return runtime.WithKey(err, "strs", mk)
+					}
+				}
+				vValue, err := d.ReadString()
+				if err != nil {
+					return runtime.WithKey(err, "strs", mk)
+				}
+				v := &wrapperspb.StringValue{Value: vValue}
+				x.Strs[mk] = v
+			}
+		case "ob":
+			// message
+			if seen&(1<<11) != 0 {
+				if err = d.Duplicate("pbopt.Wrappers", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 11
+			if d.ReadNull() {
+				continue
+			}
+			if seen&(1<<13) != 0 {
+				if err = d.OneofConflict("pbopt.Wrappers.oneof", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 13
+			vValue, err := d.ReadBool()
+			if err != nil {
+				return runtime.WithField(err, "ob")
+			}
+			v := &wrapperspb.BoolValue{Value: vValue}
+			x.Oneof = &Wrappers_Ob{Ob: v}
+		case "of64":
+			// message
+			if seen&(1<<12) != 0 {
+				if err = d.Duplicate("pbopt.Wrappers", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 12
+			if d.ReadNull() {
+				continue
+			}
+			if seen&(1<<13) != 0 {
+				if err = d.OneofConflict("pbopt.Wrappers.oneof", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 13
+			vValue, err := d.ReadFloat64()
+			if err != nil {
+				return runtime.WithField(err, "of64")
+			}
+			v := &wrapperspb.DoubleValue{Value: vValue}
+			x.Oneof = &Wrappers_Of64{Of64: v}
+		default:
+			if err = d.Unknown("pbopt.Wrappers", key); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+var jsonSizeHint_UnsafeTest_Sub1 runtime.SizeHint
+
+// pbopt.UnsafeTest.Sub1
+func (x *UnsafeTest_Sub1) MarshalJSON() ([]byte, error) {
+	buf := runtime.NewPooledBufferHint(&jsonSizeHint_UnsafeTest_Sub1)
+	data, err := x.AppendJSON(buf.AvailableBuffer())
+	if err != nil {
+		buf.Release()
+		return nil, err
+	}
+	buf.Write(data)
+	return buf.Detach(), nil
+}
+
+// AppendJSON 追加 pbopt.UnsafeTest.Sub1 的 json 编码到 dst
+func (x *UnsafeTest_Sub1) AppendJSON(dst []byte) ([]byte, error) {
+	return x.EncodeJSON(dst, nil)
+}
+
+// WriteJSONTo 把 pbopt.UnsafeTest.Sub1 的 json 编码分段写入 w, 返回写入的字节数
+func (x *UnsafeTest_Sub1) WriteJSONTo(w io.Writer) (int64, error) {
+	stream := runtime.NewStream(w)
+	dst, err := x.EncodeJSON(stream.Buffer(), stream)
+	return stream.Done(dst, err)
+}
+
+// JSONSizeHint 估算 pbopt.UnsafeTest.Sub1 的 json 编码大小
+func (x *UnsafeTest_Sub1) JSONSizeHint() int {
+	if x == nil {
+		return 4
+	}
+	n := 16
+	n += len(x.S)
+	n += (len(x.B) + 2) / 3 * 4
+	return n
+}
+
+// EncodeJSON 追加 pbopt.UnsafeTest.Sub1 的 json 编码到 dst, stream 不为 nil 时在 list 与 map 元素之间分段写出
+func (x *UnsafeTest_Sub1) EncodeJSON(dst []byte, stream *runtime.Stream) ([]byte, error) {
+	if x == nil {
+		return append(dst, "null"...), nil
+	}
+	dst = append(dst, '{')
+	// go name S : kind string
+	// number 1
+	dst = append(dst, `"s":`...)
+	if data, err := runtime.AppendString(dst, x.S); err != nil {
+		return dst, err
+	} else {
+		dst = data
+	}
+	// go name B : kind bytes
+	// number 2
+	dst = append(dst, ',')
+	dst = append(dst, `"b":`...)
+	dst = runtime.AppendBytes(dst, x.B)
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// pbopt.UnsafeTest.Sub1
+func (x *UnsafeTest_Sub1) UnmarshalJSON(data []byte) error {
+	d := runtime.UnmarshalOptions{DiscardUnknown: true, AllowDuplicates: true}.NewDecoder(data)
+	if d.ReadNull() {
+		return d.End()
+	}
+	x.Reset()
+	if err := x.DecodeJSON(d); err != nil {
+		return runtime.WithMessage(err, "pbopt.UnsafeTest.Sub1")
+	}
+	return d.End()
+}
+
+func (x *UnsafeTest_Sub1) DecodeJSON(d *runtime.Decoder) error {
+	if err := d.ObjectStart(); err != nil {
+		return err
+	}
+	var seen uint64
+	for {
+		key, ok, err := d.ObjectNext()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch string(key) {
+		case "s":
+			// string
+			if seen&(1<<0) != 0 {
+				if err = d.Duplicate("pbopt.UnsafeTest.Sub1", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 0
+			if d.ReadNull() {
+				continue
+			}
+			v, err := d.ReadString()
+			if err != nil {
+				return runtime.WithField(err, "s")
+			}
+			x.S = v
+		case "b":
+			// bytes
+			if seen&(1<<1) != 0 {
+				if err = d.Duplicate("pbopt.UnsafeTest.Sub1", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 1
+			if d.ReadNull() {
+				continue
+			}
+			v, err := d.ReadBytes()
+			if err != nil {
+				return runtime.WithField(err, "b")
+			}
+			x.B = v
+		default:
+			if err = d.Unknown("pbopt.UnsafeTest.Sub1", key); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+var jsonSizeHint_UnsafeTest_Sub2 runtime.SizeHint
+
+// pbopt.UnsafeTest.Sub2
+func (x *UnsafeTest_Sub2) MarshalJSON() ([]byte, error) {
+	buf := runtime.NewPooledBufferHint(&jsonSizeHint_UnsafeTest_Sub2)
+	data, err := x.AppendJSON(buf.AvailableBuffer())
+	if err != nil {
+		buf.Release()
+		return nil, err
+	}
+	buf.Write(data)
+	return buf.Detach(), nil
+}
+
+// AppendJSON 追加 pbopt.UnsafeTest.Sub2 的 json 编码到 dst
+func (x *UnsafeTest_Sub2) AppendJSON(dst []byte) ([]byte, error) {
+	return x.EncodeJSON(dst, nil)
+}
+
+// WriteJSONTo 把 pbopt.UnsafeTest.Sub2 的 json 编码分段写入 w, 返回写入的字节数
+func (x *UnsafeTest_Sub2) WriteJSONTo(w io.Writer) (int64, error) {
+	stream := runtime.NewStream(w)
+	dst, err := x.EncodeJSON(stream.Buffer(), stream)
+	return stream.Done(dst, err)
+}
+
+// JSONSizeHint 估算 pbopt.UnsafeTest.Sub2 的 json 编码大小
+func (x *UnsafeTest_Sub2) JSONSizeHint() int {
+	if x == nil {
+		return 4
+	}
+	n := 16
+	n += len(x.S) * 3
+	for _, val := range x.S {
+		n += len(val)
+	}
+	n += len(x.B) * 3
+	for _, val := range x.B {
+		n += (len(val) + 2) / 3 * 4
+	}
+	return n
+}
+
+// EncodeJSON 追加 pbopt.UnsafeTest.Sub2 的 json 编码到 dst, stream 不为 nil 时在 list 与 map 元素之间分段写出
+func (x *UnsafeTest_Sub2) EncodeJSON(dst []byte, stream *runtime.Stream) ([]byte, error) {
+	if x == nil {
+		return append(dst, "null"...), nil
+	}
+	dst = append(dst, '{')
+	// go name S : kind string
+	// number 1
+	dst = append(dst, `"s":[`...)
+	for i, val := range x.S {
+		// string
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		if data, err := runtime.AppendString(dst, val); err != nil {
+			return dst, err
+		} else {
+			dst = data
+		}
+		dst = stream.Flush(dst)
+	}
+	dst = append(dst, ']')
+	// go name B : kind bytes
+	// number 2
+	dst = append(dst, ',')
+	dst = append(dst, `"b":[`...)
+	for i, val := range x.B {
+		// bytes
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		dst = runtime.AppendBytes(dst, val)
+		dst = stream.Flush(dst)
+	}
+	dst = append(dst, ']')
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// pbopt.UnsafeTest.Sub2
+func (x *UnsafeTest_Sub2) UnmarshalJSON(data []byte) error {
+	d := runtime.UnmarshalOptions{DiscardUnknown: true, AllowDuplicates: true}.NewDecoder(data)
+	if d.ReadNull() {
+		return d.End()
+	}
+	x.Reset()
+	if err := x.DecodeJSON(d); err != nil {
+		return runtime.WithMessage(err, "pbopt.UnsafeTest.Sub2")
+	}
+	return d.End()
+}
+
+func (x *UnsafeTest_Sub2) DecodeJSON(d *runtime.Decoder) error {
+	if err := d.ObjectStart(); err != nil {
+		return err
+	}
+	var seen uint64
+	for {
+		key, ok, err := d.ObjectNext()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch string(key) {
+		case "s":
+			// string
+			if seen&(1<<0) != 0 {
+				if err = d.Duplicate("pbopt.UnsafeTest.Sub2", key); err != nil {
+					return err
+				}
+				x.S = nil
+			}
+			seen |= 1 << 0
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ArrayStart(); err != nil {
+				return runtime.WithField(err, "s")
+			}
+			for i := 0; ; i++ {
+				if ok, err = d.ArrayNext(); err != nil {
+					return runtime.WithField(err, "s")
+				} else if !ok {
+					break
+				}
+				v, err := d.ReadString()
+				if err != nil {
+					return runtime.WithIndex(err, "s", i)
+				}
+				x.S = append(x.S, v)
+			}
+		case "b":
+			// bytes
+			if seen&(1<<1) != 0 {
+				if err = d.Duplicate("pbopt.UnsafeTest.Sub2", key); err != nil {
+					return err
+				}
+				x.B = nil
+			}
+			seen |= 1 << 1
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ArrayStart(); err != nil {
+				return runtime.WithField(err, "b")
+			}
+			for i := 0; ; i++ {
+				if ok, err = d.ArrayNext(); err != nil {
+					return runtime.WithField(err, "b")
+				} else if !ok {
+					break
+				}
+				v, err := d.ReadBytes()
+				if err != nil {
+					return runtime.WithIndex(err, "b", i)
+				}
+				x.B = append(x.B, v)
+			}
+		default:
+			if err = d.Unknown("pbopt.UnsafeTest.Sub2", key); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+var jsonSizeHint_UnsafeTest_Sub3 runtime.SizeHint
+
+// pbopt.UnsafeTest.Sub3
+func (x *UnsafeTest_Sub3) MarshalJSON() ([]byte, error) {
+	buf := runtime.NewPooledBufferHint(&jsonSizeHint_UnsafeTest_Sub3)
+	data, err := x.AppendJSON(buf.AvailableBuffer())
+	if err != nil {
+		buf.Release()
+		return nil, err
+	}
+	buf.Write(data)
+	return buf.Detach(), nil
+}
+
+// AppendJSON 追加 pbopt.UnsafeTest.Sub3 的 json 编码到 dst
+func (x *UnsafeTest_Sub3) AppendJSON(dst []byte) ([]byte, error) {
+	return x.EncodeJSON(dst, nil)
+}
+
+// WriteJSONTo 把 pbopt.UnsafeTest.Sub3 的 json 编码分段写入 w, 返回写入的字节数
+func (x *UnsafeTest_Sub3) WriteJSONTo(w io.Writer) (int64, error) {
+	stream := runtime.NewStream(w)
+	dst, err := x.EncodeJSON(stream.Buffer(), stream)
+	return stream.Done(dst, err)
+}
+
+// JSONSizeHint 估算 pbopt.UnsafeTest.Sub3 的 json 编码大小
+func (x *UnsafeTest_Sub3) JSONSizeHint() int {
+	if x == nil {
+		return 4
+	}
+	n := 11
+	n += len(x.Foo) * 4
+	for key, val := range x.Foo {
+		n += len(key) + val.JSONSizeHint()
+	}
+	return n
+}
+
+// EncodeJSON 追加 pbopt.UnsafeTest.Sub3 的 json 编码到 dst, stream 不为 nil 时在 list 与 map 元素之间分段写出
+func (x *UnsafeTest_Sub3) EncodeJSON(dst []byte, stream *runtime.Stream) ([]byte, error) {
+	if x == nil {
+		return append(dst, "null"...), nil
+	}
+	dst = append(dst, '{')
+	// go name Foo : kind message
+	// number 1
+	{
+		dst = append(dst, `"foo":{`...)
+		var many bool
+		var keys [16]string
+		for _, key := range runtime.SortedKeys(x.Foo, keys[:0]) {
+			val := x.Foo[key]
+			// message, key string, value message
+			if many {
+				dst = append(dst, ',')
+			} else {
+				many = true
+			}
+			if data, err := runtime.AppendString(dst, key); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+			dst = append(dst, ':')
+			if val == nil {
+				dst = append(dst, "{}"...)
+			} else {
+				if data, err := val.EncodeJSON(dst, stream); err != nil {
+					return dst, err
+				} else {
+					dst = data
+				}
+			}
+			dst = stream.Flush(dst)
+		}
+		dst = append(dst, '}')
+	}
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// pbopt.UnsafeTest.Sub3
+func (x *UnsafeTest_Sub3) UnmarshalJSON(data []byte) error {
+	d := runtime.UnmarshalOptions{DiscardUnknown: true, AllowDuplicates: true}.NewDecoder(data)
+	if d.ReadNull() {
+		return d.End()
+	}
+	x.Reset()
+	if err := x.DecodeJSON(d); err != nil {
+		return runtime.WithMessage(err, "pbopt.UnsafeTest.Sub3")
+	}
+	return d.End()
+}
+
+func (x *UnsafeTest_Sub3) DecodeJSON(d *runtime.Decoder) error {
+	if err := d.ObjectStart(); err != nil {
+		return err
+	}
+	var seen uint64
+	for {
+		key, ok, err := d.ObjectNext()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch string(key) {
+		case "foo":
+			// message
+			if seen&(1<<0) != 0 {
+				if err = d.Duplicate("pbopt.UnsafeTest.Sub3", key); err != nil {
+					return err
+				}
+				x.Foo = nil
+			}
+			seen |= 1 << 0
+			if d.ReadNull() {
+				continue
+			}
+			if err = d.ObjectStart(); err != nil {
+				return runtime.WithField(err, "foo")
+			}
+			if x.Foo == nil {
+				x.Foo = make(map[string]*UnsafeTest_Sub2)
+			}
+			for {
+				k, ok, err := d.ObjectNext()
+				if err != nil {
+					return runtime.WithField(err, "foo")
+				}
+				if !ok {
+					break
+				}
+				mk := string(k)
+				if _, dup := x.Foo[mk]; dup {
+					if err = d.DuplicateKey(k); err != nil {
+						return runtime.WithKey(err, "foo", mk)
+					}
+				}
+				v := new(UnsafeTest_Sub2)
+				if err := v.DecodeJSON(d); err != nil {
+					return runtime.WithKey(err, "foo", mk)
+				}
+				x.Foo[mk] = v
+			}
+		default:
+			if err = d.Unknown("pbopt.UnsafeTest.Sub3", key); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+var jsonSizeHint_UnsafeTest_Sub4 runtime.SizeHint
+
+// pbopt.UnsafeTest.Sub4
+func (x *UnsafeTest_Sub4) MarshalJSON() ([]byte, error) {
+	buf := runtime.NewPooledBufferHint(&jsonSizeHint_UnsafeTest_Sub4)
+	data, err := x.AppendJSON(buf.AvailableBuffer())
+	if err != nil {
+		buf.Release()
+		return nil, err
+	}
+	buf.Write(data)
+	return buf.Detach(), nil
+}
+
+// AppendJSON 追加 pbopt.UnsafeTest.Sub4 的 json 编码到 dst
+func (x *UnsafeTest_Sub4) AppendJSON(dst []byte) ([]byte, error) {
+	return x.EncodeJSON(dst, nil)
+}
+
+// WriteJSONTo 把 pbopt.UnsafeTest.Sub4 的 json 编码分段写入 w, 返回写入的字节数
+func (x *UnsafeTest_Sub4) WriteJSONTo(w io.Writer) (int64, error) {
+	stream := runtime.NewStream(w)
+	dst, err := x.EncodeJSON(stream.Buffer(), stream)
+	return stream.Done(dst, err)
+}
+
+// JSONSizeHint 估算 pbopt.UnsafeTest.Sub4 的 json 编码大小
+func (x *UnsafeTest_Sub4) JSONSizeHint() int {
+	if x == nil {
+		return 4
+	}
+	n := 2
+	switch x := x.Foo.(type) {
+	case *UnsafeTest_Sub4_S:
+		n += 7
+		n += len(x.S)
+	case *UnsafeTest_Sub4_B:
+		n += 7
+		n += (len(x.B) + 2) / 3 * 4
+	}
+	return n
+}
+
+// EncodeJSON 追加 pbopt.UnsafeTest.Sub4 的 json 编码到 dst, stream 不为 nil 时在 list 与 map 元素之间分段写出
+func (x *UnsafeTest_Sub4) EncodeJSON(dst []byte, stream *runtime.Stream) ([]byte, error) {
+	if x == nil {
+		return append(dst, "null"...), nil
+	}
+	dst = append(dst, '{')
+	// go name S : kind string
+	// Foo S
+	if x.Foo != nil {
+		switch x := x.Foo.(type) {
+		// S UnsafeTest_Sub4_S 1
+		case *UnsafeTest_Sub4_S:
+			dst = append(dst, `"s":`...)
+			if data, err := runtime.AppendString(dst, x.S); err != nil {
+				return dst, err
+			} else {
+				dst = data
+			}
+		// B UnsafeTest_Sub4_B 2
+		case *UnsafeTest_Sub4_B:
+			dst = append(dst, `"b":`...)
+			dst = runtime.AppendBytes(dst, x.B)
+		}
+	}
+	// go name B : kind bytes
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// pbopt.UnsafeTest.Sub4
+func (x *UnsafeTest_Sub4) UnmarshalJSON(data []byte) error {
+	d := runtime.UnmarshalOptions{DiscardUnknown: true, AllowDuplicates: true}.NewDecoder(data)
+	if d.ReadNull() {
+		return d.End()
+	}
+	x.Reset()
+	if err := x.DecodeJSON(d); err != nil {
+		return runtime.WithMessage(err, "pbopt.UnsafeTest.Sub4")
+	}
+	return d.End()
+}
+
+func (x *UnsafeTest_Sub4) DecodeJSON(d *runtime.Decoder) error {
+	if err := d.ObjectStart(); err != nil {
+		return err
+	}
+	var seen uint64
+	for {
+		key, ok, err := d.ObjectNext()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch string(key) {
+		case "s":
+			// string
+			if seen&(1<<0) != 0 {
+				if err = d.Duplicate("pbopt.UnsafeTest.Sub4", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 0
+			if d.ReadNull() {
+				continue
+			}
+			if seen&(1<<2) != 0 {
+				if err = d.OneofConflict("pbopt.UnsafeTest.Sub4.foo", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 2
+			v, err := d.ReadString()
+			if err != nil {
+				return runtime.WithField(err, "s")
+			}
+			x.Foo = &UnsafeTest_Sub4_S{S: v}
+		case "b":
+			// bytes
+			if seen&(1<<1) != 0 {
+				if err = d.Duplicate("pbopt.UnsafeTest.Sub4", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 1
+			if d.ReadNull() {
+				continue
+			}
+			if seen&(1<<2) != 0 {
+				if err = d.OneofConflict("pbopt.UnsafeTest.Sub4.foo", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 2
+			v, err := d.ReadBytes()
+			if err != nil {
+				return runtime.WithField(err, "b")
+			}
+			x.Foo = &UnsafeTest_Sub4_B{B: v}
+		default:
+			if err = d.Unknown("pbopt.UnsafeTest.Sub4", key); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+var jsonSizeHint_UnsafeTest runtime.SizeHint
+
+// pbopt.UnsafeTest
+func (x *UnsafeTest) MarshalJSON() ([]byte, error) {
+	buf := runtime.NewPooledBufferHint(&jsonSizeHint_UnsafeTest)
+	data, err := x.AppendJSON(buf.AvailableBuffer())
+	if err != nil {
+		buf.Release()
+		return nil, err
+	}
+	buf.Write(data)
+	return buf.Detach(), nil
+}
+
+// AppendJSON 追加 pbopt.UnsafeTest 的 json 编码到 dst
+func (x *UnsafeTest) AppendJSON(dst []byte) ([]byte, error) {
+	return x.EncodeJSON(dst, nil)
+}
+
+// WriteJSONTo 把 pbopt.UnsafeTest 的 json 编码分段写入 w, 返回写入的字节数
+func (x *UnsafeTest) WriteJSONTo(w io.Writer) (int64, error) {
+	stream := runtime.NewStream(w)
+	dst, err := x.EncodeJSON(stream.Buffer(), stream)
+	return stream.Done(dst, err)
+}
+
+// JSONSizeHint 估算 pbopt.UnsafeTest 的 json 编码大小
+func (x *UnsafeTest) JSONSizeHint() int {
+	if x == nil {
+		return 4
+	}
+	n := 2
+	switch x := x.Sub.(type) {
+	case *UnsafeTest_Sub1_:
+		n += 8
+		n += x.Sub1.JSONSizeHint()
+	case *UnsafeTest_Sub2_:
+		n += 8
+		n += x.Sub2.JSONSizeHint()
+	case *UnsafeTest_Sub3_:
+		n += 8
+		n += x.Sub3.JSONSizeHint()
+	case *UnsafeTest_Sub4_:
+		n += 8
+		n += x.Sub4.JSONSizeHint()
+	}
+	return n
+}
+
+// EncodeJSON 追加 pbopt.UnsafeTest 的 json 编码到 dst, stream 不为 nil 时在 list 与 map 元素之间分段写出
+func (x *UnsafeTest) EncodeJSON(dst []byte, stream *runtime.Stream) ([]byte, error) {
+	if x == nil {
+		return append(dst, "null"...), nil
+	}
+	dst = append(dst, '{')
+	// go name Sub1 : kind message
+	// Sub Sub1
+	if x.Sub != nil {
+		switch x := x.Sub.(type) {
+		// Sub1 UnsafeTest_Sub1_ 1
+		case *UnsafeTest_Sub1_:
+			dst = append(dst, `"sub1":`...)
+			if x.Sub1 == nil {
+				dst = append(dst, "{}"...)
+			} else {
+				if data, err := x.Sub1.EncodeJSON(dst, stream); err != nil {
+					return dst, err
+				} else {
+					dst = data
+				}
+			}
+		// Sub2 UnsafeTest_Sub2_ 2
+		case *UnsafeTest_Sub2_:
+			dst = append(dst, `"sub2":`...)
+			if x.Sub2 == nil {
+				dst = append(dst, "{}"...)
+			} else {
+				if data, err := x.Sub2.EncodeJSON(dst, stream); err != nil {
+					return dst, err
+				} else {
+					dst = data
+				}
+			}
+		// Sub3 UnsafeTest_Sub3_ 3
+		case *UnsafeTest_Sub3_:
+			dst = append(dst, `"sub3":`...)
+			if x.Sub3 == nil {
+				dst = append(dst, "{}"...)
+			} else {
+				if data, err := x.Sub3.EncodeJSON(dst, stream); err != nil {
+					return dst, err
+				} else {
+					dst = data
+				}
+			}
+		// Sub4 UnsafeTest_Sub4_ 4
+		case *UnsafeTest_Sub4_:
+			dst = append(dst, `"sub4":`...)
+			if x.Sub4 == nil {
+				dst = append(dst, "{}"...)
+			} else {
+				if data, err := x.Sub4.EncodeJSON(dst, stream); err != nil {
+					return dst, err
+				} else {
+					dst = data
+				}
+			}
+		}
+	}
+	// go name Sub2 : kind message
+	// go name Sub3 : kind message
+	// go name Sub4 : kind message
+	dst = append(dst, '}')
+	return dst, nil
+}
+
+// pbopt.UnsafeTest
+func (x *UnsafeTest) UnmarshalJSON(data []byte) error {
+	d := runtime.UnmarshalOptions{DiscardUnknown: true, AllowDuplicates: true}.NewDecoder(data)
+	if d.ReadNull() {
+		return d.End()
+	}
+	x.Reset()
+	if err := x.DecodeJSON(d); err != nil {
+		return runtime.WithMessage(err, "pbopt.UnsafeTest")
+	}
+	return d.End()
+}
+
+func (x *UnsafeTest) DecodeJSON(d *runtime.Decoder) error {
+	if err := d.ObjectStart(); err != nil {
+		return err
+	}
+	var seen uint64
+	for {
+		key, ok, err := d.ObjectNext()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch string(key) {
+		case "sub1":
+			// message
+			if seen&(1<<0) != 0 {
+				if err = d.Duplicate("pbopt.UnsafeTest", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 0
+			if d.ReadNull() {
+				continue
+			}
+			if seen&(1<<4) != 0 {
+				if err = d.OneofConflict("pbopt.UnsafeTest.sub", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 4
+			v := new(UnsafeTest_Sub1)
+			if err := v.DecodeJSON(d); err != nil {
+				return runtime.WithField(err, "sub1")
+			}
+			x.Sub = &UnsafeTest_Sub1_{Sub1: v}
+		case "sub2":
+			// message
+			if seen&(1<<1) != 0 {
+				if err = d.Duplicate("pbopt.UnsafeTest", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 1
+			if d.ReadNull() {
+				continue
+			}
+			if seen&(1<<4) != 0 {
+				if err = d.OneofConflict("pbopt.UnsafeTest.sub", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 4
+			v := new(UnsafeTest_Sub2)
+			if err := v.DecodeJSON(d); err != nil {
+				return runtime.WithField(err, "sub2")
+			}
+			x.Sub = &UnsafeTest_Sub2_{Sub2: v}
+		case "sub3":
+			// message
+			if seen&(1<<2) != 0 {
+				if err = d.Duplicate("pbopt.UnsafeTest", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 2
+			if d.ReadNull() {
+				continue
+			}
+			if seen&(1<<4) != 0 {
+				if err = d.OneofConflict("pbopt.UnsafeTest.sub", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 4
+			v := new(UnsafeTest_Sub3)
+			if err := v.DecodeJSON(d); err != nil {
+				return runtime.WithField(err, "sub3")
+			}
+			x.Sub = &UnsafeTest_Sub3_{Sub3: v}
+		case "sub4":
+			// message
+			if seen&(1<<3) != 0 {
+				if err = d.Duplicate("pbopt.UnsafeTest", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 3
+			if d.ReadNull() {
+				continue
+			}
+			if seen&(1<<4) != 0 {
+				if err = d.OneofConflict("pbopt.UnsafeTest.sub", key); err != nil {
+					return err
+				}
+			}
+			seen |= 1 << 4
+			v := new(UnsafeTest_Sub4)
+			if err := v.DecodeJSON(d); err != nil {
+				return runtime.WithField(err, "sub4")
+			}
+			x.Sub = &UnsafeTest_Sub4_{Sub4: v}
+		default:
+			if err = d.Unknown("pbopt.UnsafeTest", key); err != nil {
+				return err
+			}
+		}
+	}
+}
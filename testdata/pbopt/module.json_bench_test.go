@@ -0,0 +1,41 @@
+package pbopt_test
+
+import (
+	"protoc-gen-go-json/testdata/pbopt"
+	"strconv"
+	"testing"
+)
+
+// pbopt 使用 Writer=pool 生成, MarshalJSON 从按类型记录大小的 pool 借出 buffer, 每次调用只分配返回的结果
+func BenchmarkMessage_MarshalJSON(b *testing.B) {
+	m := &pbopt.Message{
+		Type:    pbopt.Type_BOOL,
+		Number:  &pbopt.Number{U32: 1, I64: -2, F64: 3.5},
+		String_: &pbopt.String{Str: "hello", Bytes: []byte("world")},
+		Bool:    &pbopt.Bool{B: true},
+	}
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := m.MarshalJSON(); err != nil {
+				b.Error(err)
+				return
+			}
+		}
+	})
+}
+
+func BenchmarkMap_MarshalJSON(b *testing.B) {
+	m := &pbopt.Map{U32S: map[string]uint32{}, Strs: map[string]string{}}
+	for i := 0; i < 64; i++ {
+		key := strconv.Itoa(i)
+		m.U32S[key] = uint32(i)
+		m.Strs[key] = key
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := m.MarshalJSON(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
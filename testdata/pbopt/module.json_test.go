@@ -0,0 +1,154 @@
+package pbopt_test
+
+import (
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"protoc-gen-go-json/testdata/pb"
+	"protoc-gen-go-json/testdata/pbopt"
+	"testing"
+)
+
+type Message interface {
+	proto.Message
+	MarshalJSON() ([]byte, error)
+	UnmarshalJSON([]byte) error
+}
+
+// opts 与 build.sh 中 pbopt 的生成选项对应
+var opts = protojson.MarshalOptions{EmitUnpopulated: true, UseProtoNames: true, UseEnumNumbers: true}
+
+// AssertProtojson 输出与 want 一致, 且与 protojson 相同选项的输出语义一致
+func AssertProtojson(t *testing.T, args Message, want string) {
+	t.Helper()
+	raw, err := args.MarshalJSON()
+	require.NoError(t, err)
+	require.Equal(t, want, string(raw))
+
+	expect, err := opts.Marshal(args)
+	require.NoError(t, err)
+	require.JSONEq(t, string(expect), string(raw))
+
+	// 解码结果与 protojson 一致, 未设置的 google.protobuf.Value 写成 null 后读取为 NullValue, 不能直接与 args 比较
+	got := args.ProtoReflect().New().Interface().(Message)
+	require.NoError(t, got.UnmarshalJSON(raw))
+	decoded := args.ProtoReflect().New().Interface()
+	require.NoError(t, protojson.Unmarshal(raw, decoded))
+	require.True(t, proto.Equal(decoded, got), "round trip %s", raw)
+}
+
+func TestEmitUnpopulated_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		args Message
+		want string
+	}{
+		{
+			name: "number",
+			args: &pbopt.Number{},
+			want: `{"u32":0,"u64":"0","s32":0,"s64":"0","uf32":0,"uf64":"0","sf32":0,"sf64":"0","i32":0,"i64":"0","f64":0,"f32":0}`,
+		},
+		{name: "string", args: &pbopt.String{}, want: `{"str":"","bytes":""}`},
+		{name: "message", args: &pbopt.Message{}, want: `{"type":0,"number":null,"string":null,"bool":null}`},
+		{
+			name: "message set",
+			args: &pbopt.Message{Number: &pbopt.Number{}},
+			want: `{"type":0,"number":{"u32":0,"u64":"0","s32":0,"s64":"0","uf32":0,"uf64":"0","sf32":0,"sf64":"0","i32":0,"i64":"0","f64":0,"f32":0},"string":null,"bool":null}`,
+		},
+		{
+			name: "array",
+			args: &pbopt.Array{},
+			want: `{"numbers":[],"strings":[],"bools":[],"messages":[],"arrays":[],"types":[],"u32s":[],"strs":[]}`,
+		},
+		{
+			name: "map",
+			args: &pbopt.Map{},
+			want: `{"numbers":{},"strings":{},"bools":{},"messages":{},"arrays":{},"types":{},"u32s":{},"strs":{},"empties":{},"optionals":{},"oneofs":{}}`,
+		},
+		{name: "optional", args: &pbopt.Optional{}, want: `{}`},
+		{name: "optional set", args: &pbopt.Optional{U32: proto.Uint32(0)}, want: `{"u32":0}`},
+		{name: "oneof", args: &pbopt.Oneof{}, want: `{"number":null,"number_x":null,"string_x":null}`},
+		{
+			name: "oneof set",
+			args: &pbopt.Oneof{Oneof: &pbopt.Oneof_U32{}},
+			want: `{"number":null,"u32":0,"number_x":null,"string_x":null}`,
+		},
+		{name: "empty", args: &pbopt.Empty{}, want: `{}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			AssertProtojson(t, tt.args, tt.want)
+		})
+	}
+}
+
+func TestUseProtoNames_MarshalJSON(t *testing.T) {
+	AssertProtojson(t, &pbopt.Oneof{
+		Number:  &pbopt.Number{U32: 1},
+		Oneof:   &pbopt.Oneof_Str{Str: "a"},
+		NumberX: &pbopt.Number{U32: 2},
+	}, `{"number":{"u32":1,"u64":"0","s32":0,"s64":"0","uf32":0,"uf64":"0","sf32":0,"sf64":"0","i32":0,"i64":"0","f64":0,"f32":0},`+
+		`"str":"a","number_x":{"u32":2,"u64":"0","s32":0,"s64":"0","uf32":0,"uf64":"0","sf32":0,"sf64":"0","i32":0,"i64":"0","f64":0,"f32":0},"string_x":null}`)
+}
+
+func TestUseProtoNames_UnmarshalJSON(t *testing.T) {
+	for _, data := range []string{`{"number_x":{"u32":2}}`, `{"numberX":{"u32":2}}`} {
+		var got pbopt.Oneof
+		require.NoError(t, got.UnmarshalJSON([]byte(data)))
+		require.True(t, proto.Equal(&pbopt.Oneof{NumberX: &pbopt.Number{U32: 2}}, &got), data)
+	}
+}
+
+func TestDiscardUnknown_UnmarshalJSON(t *testing.T) {
+	var got pbopt.Oneof
+	require.NoError(t, got.UnmarshalJSON([]byte(`{"x":[{"y":"}"}],"number_x":{"u32":2,"u":{}}}`)))
+	require.True(t, proto.Equal(&pbopt.Oneof{NumberX: &pbopt.Number{U32: 2}}, &got))
+	require.Error(t, got.UnmarshalJSON([]byte(`{"x":[}`)))
+}
+
+func TestAllowDuplicates_UnmarshalJSON(t *testing.T) {
+	var got pbopt.Oneof
+	require.NoError(t, got.UnmarshalJSON([]byte(`{"str":"a","number_x":{"u32":1},"u32":2,"numberX":{"u32":3,"u32":4}}`)))
+	require.True(t, proto.Equal(&pbopt.Oneof{Oneof: &pbopt.Oneof_U32{U32: 2}, NumberX: &pbopt.Number{U32: 4}}, &got))
+}
+
+func TestUseEnumNumbers_MarshalJSON(t *testing.T) {
+	AssertProtojson(t, &pbopt.Enums{
+		Type:  pbopt.Type_BOOL,
+		Types: []pbopt.Type{pbopt.Type_STRING, 7},
+		Map:   map[string]pbopt.Type{"a": pbopt.Type_BOOL},
+		Nulls: []structpb.NullValue{structpb.NullValue_NULL_VALUE},
+	}, `{"type":2,"types":[1,7],"map":{"a":2},"null":null,"nulls":[null]}`)
+}
+
+func TestFieldOrder_MarshalJSON(t *testing.T) {
+	AssertProtojson(t, &pbopt.FieldOrder{}, `{"e":0,"c":"","list":[],"a":"0"}`)
+	AssertProtojson(t, &pbopt.OneofFirst{}, `{"map":{},"bool":null}`)
+	AssertProtojson(t, &pbopt.OneofFirst{Second: &pbopt.OneofFirst_T{T: "t"}}, `{"map":{},"bool":null,"t":"t"}`)
+	AssertProtojson(t, &pbopt.Single{}, `{"s":""}`)
+}
+
+func TestWrappers_MarshalJSON(t *testing.T) {
+	AssertProtojson(t, &pbopt.Wrappers{}, `{"f64":null,"f32":null,"i64":null,"u64":null,"i32":null,"u32":null,"b":null,"str":null,"bytes":null,"i64s":[],"strs":{}}`)
+	AssertProtojson(t, &pbopt.Wrappers{I64: wrapperspb.Int64(0)}, `{"f64":null,"f32":null,"i64":"0","u64":null,"i32":null,"u32":null,"b":null,"str":null,"bytes":null,"i64s":[],"strs":{}}`)
+}
+
+func TestStructs_MarshalJSON(t *testing.T) {
+	AssertProtojson(t, &pbopt.Structs{}, `{"struct":null,"value":null,"list":null,"values":[],"structs":{}}`)
+	AssertProtojson(t, &pbopt.Structs{Value: structpb.NewNullValue()}, `{"struct":null,"value":null,"list":null,"values":[],"structs":{}}`)
+}
+
+// pb 与 pbopt 注册不同的 proto 文件与包, 同一个程序可以同时链接
+func TestRegistry(t *testing.T) {
+	for _, m := range []proto.Message{&pb.Number{}, &pbopt.Number{}} {
+		name := m.ProtoReflect().Descriptor().FullName()
+		mt, err := protoregistry.GlobalTypes.FindMessageByName(name)
+		require.NoError(t, err, name)
+		require.Equal(t, m.ProtoReflect().Type(), mt)
+	}
+	_, err := protoregistry.GlobalFiles.FindFileByPath("pbopt/module.proto")
+	require.NoError(t, err)
+}
@@ -0,0 +1,3445 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.21.9
+// source: pbopt/module.proto
+
+package pbopt
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Type int32
+
+const (
+	Type_NUMBER Type = 0
+	Type_STRING Type = 1
+	Type_BOOL   Type = 2
+)
+
+// Enum value maps for Type.
+var (
+	Type_name = map[int32]string{
+		0: "NUMBER",
+		1: "STRING",
+		2: "BOOL",
+	}
+	Type_value = map[string]int32{
+		"NUMBER": 0,
+		"STRING": 1,
+		"BOOL":   2,
+	}
+)
+
+func (x Type) Enum() *Type {
+	p := new(Type)
+	*p = x
+	return p
+}
+
+func (x Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_pbopt_module_proto_enumTypes[0].Descriptor()
+}
+
+func (Type) Type() protoreflect.EnumType {
+	return &file_pbopt_module_proto_enumTypes[0]
+}
+
+func (x Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Type.Descriptor instead.
+func (Type) EnumDescriptor() ([]byte, []int) {
+	return file_pbopt_module_proto_rawDescGZIP(), []int{0}
+}
+
+type Number struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	U32  uint32  `protobuf:"varint,1,opt,name=u32,proto3" json:"u32,omitempty"`
+	U64  uint64  `protobuf:"varint,2,opt,name=u64,proto3" json:"u64,omitempty"`
+	S32  int32   `protobuf:"zigzag32,3,opt,name=s32,proto3" json:"s32,omitempty"`
+	S64  int64   `protobuf:"zigzag64,4,opt,name=s64,proto3" json:"s64,omitempty"`
+	Uf32 uint32  `protobuf:"fixed32,5,opt,name=uf32,proto3" json:"uf32,omitempty"`
+	Uf64 uint64  `protobuf:"fixed64,6,opt,name=uf64,proto3" json:"uf64,omitempty"`
+	Sf32 int32   `protobuf:"fixed32,7,opt,name=sf32,proto3" json:"sf32,omitempty"`
+	Sf64 int64   `protobuf:"fixed64,8,opt,name=sf64,proto3" json:"sf64,omitempty"`
+	I32  int32   `protobuf:"varint,9,opt,name=i32,proto3" json:"i32,omitempty"`
+	I64  int64   `protobuf:"varint,10,opt,name=i64,proto3" json:"i64,omitempty"`
+	F64  float64 `protobuf:"fixed64,11,opt,name=f64,proto3" json:"f64,omitempty"`
+	F32  float32 `protobuf:"fixed32,12,opt,name=f32,proto3" json:"f32,omitempty"`
+}
+
+func (x *Number) Reset() {
+	*x = Number{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pbopt_module_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Number) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Number) ProtoMessage() {}
+
+func (x *Number) ProtoReflect() protoreflect.Message {
+	mi := &file_pbopt_module_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Number.ProtoReflect.Descriptor instead.
+func (*Number) Descriptor() ([]byte, []int) {
+	return file_pbopt_module_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Number) GetU32() uint32 {
+	if x != nil {
+		return x.U32
+	}
+	return 0
+}
+
+func (x *Number) GetU64() uint64 {
+	if x != nil {
+		return x.U64
+	}
+	return 0
+}
+
+func (x *Number) GetS32() int32 {
+	if x != nil {
+		return x.S32
+	}
+	return 0
+}
+
+func (x *Number) GetS64() int64 {
+	if x != nil {
+		return x.S64
+	}
+	return 0
+}
+
+func (x *Number) GetUf32() uint32 {
+	if x != nil {
+		return x.Uf32
+	}
+	return 0
+}
+
+func (x *Number) GetUf64() uint64 {
+	if x != nil {
+		return x.Uf64
+	}
+	return 0
+}
+
+func (x *Number) GetSf32() int32 {
+	if x != nil {
+		return x.Sf32
+	}
+	return 0
+}
+
+func (x *Number) GetSf64() int64 {
+	if x != nil {
+		return x.Sf64
+	}
+	return 0
+}
+
+func (x *Number) GetI32() int32 {
+	if x != nil {
+		return x.I32
+	}
+	return 0
+}
+
+func (x *Number) GetI64() int64 {
+	if x != nil {
+		return x.I64
+	}
+	return 0
+}
+
+func (x *Number) GetF64() float64 {
+	if x != nil {
+		return x.F64
+	}
+	return 0
+}
+
+func (x *Number) GetF32() float32 {
+	if x != nil {
+		return x.F32
+	}
+	return 0
+}
+
+type NumberList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	U32  []uint32  `protobuf:"varint,1,rep,packed,name=u32,proto3" json:"u32,omitempty"`
+	U64  []uint64  `protobuf:"varint,2,rep,packed,name=u64,proto3" json:"u64,omitempty"`
+	S32  []int32   `protobuf:"zigzag32,3,rep,packed,name=s32,proto3" json:"s32,omitempty"`
+	S64  []int64   `protobuf:"zigzag64,4,rep,packed,name=s64,proto3" json:"s64,omitempty"`
+	Uf32 []uint32  `protobuf:"fixed32,5,rep,packed,name=uf32,proto3" json:"uf32,omitempty"`
+	Uf64 []uint64  `protobuf:"fixed64,6,rep,packed,name=uf64,proto3" json:"uf64,omitempty"`
+	Sf32 []int32   `protobuf:"fixed32,7,rep,packed,name=sf32,proto3" json:"sf32,omitempty"`
+	Sf64 []int64   `protobuf:"fixed64,8,rep,packed,name=sf64,proto3" json:"sf64,omitempty"`
+	I32  []int32   `protobuf:"varint,9,rep,packed,name=i32,proto3" json:"i32,omitempty"`
+	I64  []int64   `protobuf:"varint,10,rep,packed,name=i64,proto3" json:"i64,omitempty"`
+	F64  []float64 `protobuf:"fixed64,11,rep,packed,name=f64,proto3" json:"f64,omitempty"`
+	F32  []float32 `protobuf:"fixed32,12,rep,packed,name=f32,proto3" json:"f32,omitempty"`
+}
+
+func (x *NumberList) Reset() {
+	*x = NumberList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pbopt_module_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NumberList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NumberList) ProtoMessage() {}
+
+func (x *NumberList) ProtoReflect() protoreflect.Message {
+	mi := &file_pbopt_module_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NumberList.ProtoReflect.Descriptor instead.
+func (*NumberList) Descriptor() ([]byte, []int) {
+	return file_pbopt_module_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NumberList) GetU32() []uint32 {
+	if x != nil {
+		return x.U32
+	}
+	return nil
+}
+
+func (x *NumberList) GetU64() []uint64 {
+	if x != nil {
+		return x.U64
+	}
+	return nil
+}
+
+func (x *NumberList) GetS32() []int32 {
+	if x != nil {
+		return x.S32
+	}
+	return nil
+}
+
+func (x *NumberList) GetS64() []int64 {
+	if x != nil {
+		return x.S64
+	}
+	return nil
+}
+
+func (x *NumberList) GetUf32() []uint32 {
+	if x != nil {
+		return x.Uf32
+	}
+	return nil
+}
+
+func (x *NumberList) GetUf64() []uint64 {
+	if x != nil {
+		return x.Uf64
+	}
+	return nil
+}
+
+func (x *NumberList) GetSf32() []int32 {
+	if x != nil {
+		return x.Sf32
+	}
+	return nil
+}
+
+func (x *NumberList) GetSf64() []int64 {
+	if x != nil {
+		return x.Sf64
+	}
+	return nil
+}
+
+func (x *NumberList) GetI32() []int32 {
+	if x != nil {
+		return x.I32
+	}
+	return nil
+}
+
+func (x *NumberList) GetI64() []int64 {
+	if x != nil {
+		return x.I64
+	}
+	return nil
+}
+
+func (x *NumberList) GetF64() []float64 {
+	if x != nil {
+		return x.F64
+	}
+	return nil
+}
+
+func (x *NumberList) GetF32() []float32 {
+	if x != nil {
+		return x.F32
+	}
+	return nil
+}
+
+type NumberMap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	U32  map[uint32]uint32  `protobuf:"bytes,1,rep,name=u32,proto3" json:"u32,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	U64  map[uint64]uint64  `protobuf:"bytes,2,rep,name=u64,proto3" json:"u64,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	S32  map[int32]int32    `protobuf:"bytes,3,rep,name=s32,proto3" json:"s32,omitempty" protobuf_key:"zigzag32,1,opt,name=key,proto3" protobuf_val:"zigzag32,2,opt,name=value,proto3"`
+	S64  map[int64]int64    `protobuf:"bytes,4,rep,name=s64,proto3" json:"s64,omitempty" protobuf_key:"zigzag64,1,opt,name=key,proto3" protobuf_val:"zigzag64,2,opt,name=value,proto3"`
+	Uf32 map[uint32]uint32  `protobuf:"bytes,5,rep,name=uf32,proto3" json:"uf32,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	Uf64 map[uint64]uint64  `protobuf:"bytes,6,rep,name=uf64,proto3" json:"uf64,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Sf32 map[int32]int32    `protobuf:"bytes,7,rep,name=sf32,proto3" json:"sf32,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	Sf64 map[int64]int64    `protobuf:"bytes,8,rep,name=sf64,proto3" json:"sf64,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	I32  map[int32]int32    `protobuf:"bytes,9,rep,name=i32,proto3" json:"i32,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	I64  map[int64]int64    `protobuf:"bytes,10,rep,name=i64,proto3" json:"i64,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	F64  map[string]float64 `protobuf:"bytes,11,rep,name=f64,proto3" json:"f64,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	F32  map[string]float32 `protobuf:"bytes,12,rep,name=f32,proto3" json:"f32,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+}
+
+func (x *NumberMap) Reset() {
+	*x = NumberMap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pbopt_module_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NumberMap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NumberMap) ProtoMessage() {}
+
+func (x *NumberMap) ProtoReflect() protoreflect.Message {
+	mi := &file_pbopt_module_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NumberMap.ProtoReflect.Descriptor instead.
+func (*NumberMap) Descriptor() ([]byte, []int) {
+	return file_pbopt_module_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NumberMap) GetU32() map[uint32]uint32 {
+	if x != nil {
+		return x.U32
+	}
+	return nil
+}
+
+func (x *NumberMap) GetU64() map[uint64]uint64 {
+	if x != nil {
+		return x.U64
+	}
+	return nil
+}
+
+func (x *NumberMap) GetS32() map[int32]int32 {
+	if x != nil {
+		return x.S32
+	}
+	return nil
+}
+
+func (x *NumberMap) GetS64() map[int64]int64 {
+	if x != nil {
+		return x.S64
+	}
+	return nil
+}
+
+func (x *NumberMap) GetUf32() map[uint32]uint32 {
+	if x != nil {
+		return x.Uf32
+	}
+	return nil
+}
+
+func (x *NumberMap) GetUf64() map[uint64]uint64 {
+	if x != nil {
+		return x.Uf64
+	}
+	return nil
+}
+
+func (x *NumberMap) GetSf32() map[int32]int32 {
+	if x != nil {
+		return x.Sf32
+	}
+	return nil
+}
+
+func (x *NumberMap) GetSf64() map[int64]int64 {
+	if x != nil {
+		return x.Sf64
+	}
+	return nil
+}
+
+func (x *NumberMap) GetI32() map[int32]int32 {
+	if x != nil {
+		return x.I32
+	}
+	return nil
+}
+
+func (x *NumberMap) GetI64() map[int64]int64 {
+	if x != nil {
+		return x.I64
+	}
+	return nil
+}
+
+func (x *NumberMap) GetF64() map[string]float64 {
+	if x != nil {
+		return x.F64
+	}
+	return nil
+}
+
+func (x *NumberMap) GetF32() map[string]float32 {
+	if x != nil {
+		return x.F32
+	}
+	return nil
+}
+
+type String struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Str   string `protobuf:"bytes,1,opt,name=str,proto3" json:"str,omitempty"`
+	Bytes []byte `protobuf:"bytes,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
+}
+
+func (x *String) Reset() {
+	*x = String{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pbopt_module_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *String) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*String) ProtoMessage() {}
+
+func (x *String) ProtoReflect() protoreflect.Message {
+	mi := &file_pbopt_module_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use String.ProtoReflect.Descriptor instead.
+func (*String) Descriptor() ([]byte, []int) {
+	return file_pbopt_module_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *String) GetStr() string {
+	if x != nil {
+		return x.Str
+	}
+	return ""
+}
+
+func (x *String) GetBytes() []byte {
+	if x != nil {
+		return x.Bytes
+	}
+	return nil
+}
+
+type Bool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	B bool `protobuf:"varint,1,opt,name=b,proto3" json:"b,omitempty"`
+}
+
+func (x *Bool) Reset() {
+	*x = Bool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pbopt_module_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Bool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bool) ProtoMessage() {}
+
+func (x *Bool) ProtoReflect() protoreflect.Message {
+	mi := &file_pbopt_module_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bool.ProtoReflect.Descriptor instead.
+func (*Bool) Descriptor() ([]byte, []int) {
+	return file_pbopt_module_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Bool) GetB() bool {
+	if x != nil {
+		return x.B
+	}
+	return false
+}
+
+type Enums struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type  Type                 `protobuf:"varint,1,opt,name=type,proto3,enum=pbopt.Type" json:"type,omitempty"`
+	Types []Type               `protobuf:"varint,2,rep,packed,name=types,proto3,enum=pbopt.Type" json:"types,omitempty"`
+	Map   map[string]Type      `protobuf:"bytes,3,rep,name=map,proto3" json:"map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=pbopt.Type"`
+	Null  structpb.NullValue   `protobuf:"varint,4,opt,name=null,proto3,enum=google.protobuf.NullValue" json:"null,omitempty"`
+	Nulls []structpb.NullValue `protobuf:"varint,5,rep,packed,name=nulls,proto3,enum=google.protobuf.NullValue" json:"nulls,omitempty"`
+}
+
+func (x *Enums) Reset() {
+	*x = Enums{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pbopt_module_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Enums) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Enums) ProtoMessage() {}
+
+func (x *Enums) ProtoReflect() protoreflect.Message {
+	mi := &file_pbopt_module_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Enums.ProtoReflect.Descriptor instead.
+func (*Enums) Descriptor() ([]byte, []int) {
+	return file_pbopt_module_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Enums) GetType() Type {
+	if x != nil {
+		return x.Type
+	}
+	return Type_NUMBER
+}
+
+func (x *Enums) GetTypes() []Type {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *Enums) GetMap() map[string]Type {
+	if x != nil {
+		return x.Map
+	}
+	return nil
+}
+
+func (x *Enums) GetNull() structpb.NullValue {
+	if x != nil {
+		return x.Null
+	}
+	return structpb.NullValue(0)
+}
+
+func (x *Enums) GetNulls() []structpb.NullValue {
+	if x != nil {
+		return x.Nulls
+	}
+	return nil
+}
+
+type Message struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type    Type    `protobuf:"varint,1,opt,name=type,proto3,enum=pbopt.Type" json:"type,omitempty"`
+	Number  *Number `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+	String_ *String `protobuf:"bytes,3,opt,name=string,proto3" json:"string,omitempty"`
+	Bool    *Bool   `protobuf:"bytes,4,opt,name=bool,proto3" json:"bool,omitempty"`
+}
+
+func (x *Message) Reset() {
+	*x = Message{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pbopt_module_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message) ProtoMessage() {}
+
+func (x *Message) ProtoReflect() protoreflect.Message {
+	mi := &file_pbopt_module_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Message.ProtoReflect.Descriptor instead.
+func (*Message) Descriptor() ([]byte, []int) {
+	return file_pbopt_module_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Message) GetType() Type {
+	if x != nil {
+		return x.Type
+	}
+	return Type_NUMBER
+}
+
+func (x *Message) GetNumber() *Number {
+	if x != nil {
+		return x.Number
+	}
+	return nil
+}
+
+func (x *Message) GetString_() *String {
+	if x != nil {
+		return x.String_
+	}
+	return nil
+}
+
+func (x *Message) GetBool() *Bool {
+	if x != nil {
+		return x.Bool
+	}
+	return nil
+}
+
+type Array struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Numbers  []*Number  `protobuf:"bytes,1,rep,name=numbers,proto3" json:"numbers,omitempty"`
+	Strings  []*String  `protobuf:"bytes,2,rep,name=strings,proto3" json:"strings,omitempty"`
+	Bools    []*Bool    `protobuf:"bytes,3,rep,name=bools,proto3" json:"bools,omitempty"`
+	Messages []*Message `protobuf:"bytes,4,rep,name=messages,proto3" json:"messages,omitempty"`
+	Arrays   []*Array   `protobuf:"bytes,5,rep,name=arrays,proto3" json:"arrays,omitempty"`
+	Types    []Type     `protobuf:"varint,6,rep,packed,name=types,proto3,enum=pbopt.Type" json:"types,omitempty"`
+	U32S     []uint32   `protobuf:"varint,7,rep,packed,name=u32s,proto3" json:"u32s,omitempty"`
+	Strs     []string   `protobuf:"bytes,8,rep,name=strs,proto3" json:"strs,omitempty"`
+}
+
+func (x *Array) Reset() {
+	*x = Array{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pbopt_module_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Array) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Array) ProtoMessage() {}
+
+func (x *Array) ProtoReflect() protoreflect.Message {
+	mi := &file_pbopt_module_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Array.ProtoReflect.Descriptor instead.
+func (*Array) Descriptor() ([]byte, []int) {
+	return file_pbopt_module_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Array) GetNumbers() []*Number {
+	if x != nil {
+		return x.Numbers
+	}
+	return nil
+}
+
+func (x *Array) GetStrings() []*String {
+	if x != nil {
+		return x.Strings
+	}
+	return nil
+}
+
+func (x *Array) GetBools() []*Bool {
+	if x != nil {
+		return x.Bools
+	}
+	return nil
+}
+
+func (x *Array) GetMessages() []*Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *Array) GetArrays() []*Array {
+	if x != nil {
+		return x.Arrays
+	}
+	return nil
+}
+
+func (x *Array) GetTypes() []Type {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *Array) GetU32S() []uint32 {
+	if x != nil {
+		return x.U32S
+	}
+	return nil
+}
+
+func (x *Array) GetStrs() []string {
+	if x != nil {
+		return x.Strs
+	}
+	return nil
+}
+
+type Map struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Numbers   map[uint32]*Number   `protobuf:"bytes,1,rep,name=numbers,proto3" json:"numbers,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Strings   map[string]*String   `protobuf:"bytes,2,rep,name=strings,proto3" json:"strings,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Bools     map[bool]*Bool       `protobuf:"bytes,3,rep,name=bools,proto3" json:"bools,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Messages  map[string]*Message  `protobuf:"bytes,4,rep,name=messages,proto3" json:"messages,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Arrays    map[string]*Array    `protobuf:"bytes,5,rep,name=arrays,proto3" json:"arrays,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Types     map[int32]Type       `protobuf:"bytes,6,rep,name=types,proto3" json:"types,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=pbopt.Type"`
+	U32S      map[string]uint32    `protobuf:"bytes,7,rep,name=u32s,proto3" json:"u32s,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Strs      map[string]string    `protobuf:"bytes,8,rep,name=strs,proto3" json:"strs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Empties   map[string]*Empty    `protobuf:"bytes,9,rep,name=empties,proto3" json:"empties,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Optionals map[string]*Optional `protobuf:"bytes,10,rep,name=optionals,proto3" json:"optionals,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Oneofs    map[string]*Oneof    `protobuf:"bytes,11,rep,name=oneofs,proto3" json:"oneofs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Map) Reset() {
+	*x = Map{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pbopt_module_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Map) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Map) ProtoMessage() {}
+
+func (x *Map) ProtoReflect() protoreflect.Message {
+	mi := &file_pbopt_module_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Map.ProtoReflect.Descriptor instead.
+func (*Map) Descriptor() ([]byte, []int) {
+	return file_pbopt_module_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Map) GetNumbers() map[uint32]*Number {
+	if x != nil {
+		return x.Numbers
+	}
+	return nil
+}
+
+func (x *Map) GetStrings() map[string]*String {
+	if x != nil {
+		return x.Strings
+	}
+	return nil
+}
+
+func (x *Map) GetBools() map[bool]*Bool {
+	if x != nil {
+		return x.Bools
+	}
+	return nil
+}
+
+func (x *Map) GetMessages() map[string]*Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *Map) GetArrays() map[string]*Array {
+	if x != nil {
+		return x.Arrays
+	}
+	return nil
+}
+
+func (x *Map) GetTypes() map[int32]Type {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *Map) GetU32S() map[string]uint32 {
+	if x != nil {
+		return x.U32S
+	}
+	return nil
+}
+
+func (x *Map) GetStrs() map[string]string {
+	if x != nil {
+		return x.Strs
+	}
+	return nil
+}
+
+func (x *Map) GetEmpties() map[string]*Empty {
+	if x != nil {
+		return x.Empties
+	}
+	return nil
+}
+
+func (x *Map) GetOptionals() map[string]*Optional {
+	if x != nil {
+		return x.Optionals
+	}
+	return nil
+}
+
+func (x *Map) GetOneofs() map[string]*Oneof {
+	if x != nil {
+		return x.Oneofs
+	}
+	return nil
+}
+
+type Empty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pbopt_module_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_pbopt_module_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_pbopt_module_proto_rawDescGZIP(), []int{9}
+}
+
+type Optional struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number  *Number  `protobuf:"bytes,1,opt,name=number,proto3,oneof" json:"number,omitempty"`
+	String_ *String  `protobuf:"bytes,2,opt,name=string,proto3,oneof" json:"string,omitempty"`
+	Bool    *Bool    `protobuf:"bytes,3,opt,name=bool,proto3,oneof" json:"bool,omitempty"`
+	Message *Message `protobuf:"bytes,4,opt,name=message,proto3,oneof" json:"message,omitempty"`
+	Array   *Array   `protobuf:"bytes,5,opt,name=array,proto3,oneof" json:"array,omitempty"`
+	Type    *Type    `protobuf:"varint,6,opt,name=type,proto3,enum=pbopt.Type,oneof" json:"type,omitempty"`
+	U32     *uint32  `protobuf:"varint,7,opt,name=u32,proto3,oneof" json:"u32,omitempty"`
+	Str     *string  `protobuf:"bytes,8,opt,name=str,proto3,oneof" json:"str,omitempty"`
+}
+
+func (x *Optional) Reset() {
+	*x = Optional{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pbopt_module_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Optional) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Optional) ProtoMessage() {}
+
+func (x *Optional) ProtoReflect() protoreflect.Message {
+	mi := &file_pbopt_module_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Optional.ProtoReflect.Descriptor instead.
+func (*Optional) Descriptor() ([]byte, []int) {
+	return file_pbopt_module_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Optional) GetNumber() *Number {
+	if x != nil {
+		return x.Number
+	}
+	return nil
+}
+
+func (x *Optional) GetString_() *String {
+	if x != nil {
+		return x.String_
+	}
+	return nil
+}
+
+func (x *Optional) GetBool() *Bool {
+	if x != nil {
+		return x.Bool
+	}
+	return nil
+}
+
+func (x *Optional) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *Optional) GetArray() *Array {
+	if x != nil {
+		return x.Array
+	}
+	return nil
+}
+
+func (x *Optional) GetType() Type {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return Type_NUMBER
+}
+
+func (x *Optional) GetU32() uint32 {
+	if x != nil && x.U32 != nil {
+		return *x.U32
+	}
+	return 0
+}
+
+func (x *Optional) GetStr() string {
+	if x != nil && x.Str != nil {
+		return *x.Str
+	}
+	return ""
+}
+
+type Oneof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number *Number `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	// Types that are assignable to Oneof:
+	//	*Oneof_String_
+	//	*Oneof_Bool
+	//	*Oneof_Message
+	//	*Oneof_Array
+	//	*Oneof_Type
+	//	*Oneof_U32
+	//	*Oneof_Str
+	Oneof   isOneof_Oneof `protobuf_oneof:"oneof"`
+	NumberX *Number       `protobuf:"bytes,9,opt,name=number_x,json=numberX,proto3" json:"number_x,omitempty"`
+	StringX *String       `protobuf:"bytes,10,opt,name=string_x,json=stringX,proto3" json:"string_x,omitempty"`
+}
+
+func (x *Oneof) Reset() {
+	*x = Oneof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pbopt_module_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Oneof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Oneof) ProtoMessage() {}
+
+func (x *Oneof) ProtoReflect() protoreflect.Message {
+	mi := &file_pbopt_module_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Oneof.ProtoReflect.Descriptor instead.
+func (*Oneof) Descriptor() ([]byte, []int) {
+	return file_pbopt_module_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Oneof) GetNumber() *Number {
+	if x != nil {
+		return x.Number
+	}
+	return nil
+}
+
+func (m *Oneof) GetOneof() isOneof_Oneof {
+	if m != nil {
+		return m.Oneof
+	}
+	return nil
+}
+
+func (x *Oneof) GetString_() *String {
+	if x, ok := x.GetOneof().(*Oneof_String_); ok {
+		return x.String_
+	}
+	return nil
+}
+
+func (x *Oneof) GetBool() *Bool {
+	if x, ok := x.GetOneof().(*Oneof_Bool); ok {
+		return x.Bool
+	}
+	return nil
+}
+
+func (x *Oneof) GetMessage() *Message {
+	if x, ok := x.GetOneof().(*Oneof_Message); ok {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *Oneof) GetArray() *Array {
+	if x, ok := x.GetOneof().(*Oneof_Array); ok {
+		return x.Array
+	}
+	return nil
+}
+
+func (x *Oneof) GetType() Type {
+	if x, ok := x.GetOneof().(*Oneof_Type); ok {
+		return x.Type
+	}
+	return Type_NUMBER
+}
+
+func (x *Oneof) GetU32() uint32 {
+	if x, ok := x.GetOneof().(*Oneof_U32); ok {
+		return x.U32
+	}
+	return 0
+}
+
+func (x *Oneof) GetStr() string {
+	if x, ok := x.GetOneof().(*Oneof_Str); ok {
+		return x.Str
+	}
+	return ""
+}
+
+func (x *Oneof) GetNumberX() *Number {
+	if x != nil {
+		return x.NumberX
+	}
+	return nil
+}
+
+func (x *Oneof) GetStringX() *String {
+	if x != nil {
+		return x.StringX
+	}
+	return nil
+}
+
+type isOneof_Oneof interface {
+	isOneof_Oneof()
+}
+
+type Oneof_String_ struct {
+	String_ *String `protobuf:"bytes,2,opt,name=string,proto3,oneof"`
+}
+
+type Oneof_Bool struct {
+	Bool *Bool `protobuf:"bytes,3,opt,name=bool,proto3,oneof"`
+}
+
+type Oneof_Message struct {
+	Message *Message `protobuf:"bytes,4,opt,name=message,proto3,oneof"`
+}
+
+type Oneof_Array struct {
+	Array *Array `protobuf:"bytes,5,opt,name=array,proto3,oneof"`
+}
+
+type Oneof_Type struct {
+	Type Type `protobuf:"varint,6,opt,name=type,proto3,enum=pbopt.Type,oneof"`
+}
+
+type Oneof_U32 struct {
+	U32 uint32 `protobuf:"varint,7,opt,name=u32,proto3,oneof"`
+}
+
+type Oneof_Str struct {
+	Str string `protobuf:"bytes,8,opt,name=str,proto3,oneof"`
+}
+
+func (*Oneof_String_) isOneof_Oneof() {}
+
+func (*Oneof_Bool) isOneof_Oneof() {}
+
+func (*Oneof_Message) isOneof_Oneof() {}
+
+func (*Oneof_Array) isOneof_Oneof() {}
+
+func (*Oneof_Type) isOneof_Oneof() {}
+
+func (*Oneof_U32) isOneof_Oneof() {}
+
+func (*Oneof_Str) isOneof_Oneof() {}
+
+// 第一个字段编号不是 1, 声明顺序与编号顺序不同, 中间有 reserved 的编号
+type FieldOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	E    uint32   `protobuf:"varint,5,opt,name=e,proto3" json:"e,omitempty"`
+	C    string   `protobuf:"bytes,3,opt,name=c,proto3" json:"c,omitempty"`
+	D    *bool    `protobuf:"varint,9,opt,name=d,proto3,oneof" json:"d,omitempty"`
+	List []uint32 `protobuf:"varint,2,rep,packed,name=list,proto3" json:"list,omitempty"`
+	A    uint64   `protobuf:"varint,1,opt,name=a,proto3" json:"a,omitempty"`
+}
+
+func (x *FieldOrder) Reset() {
+	*x = FieldOrder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pbopt_module_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldOrder) ProtoMessage() {}
+
+func (x *FieldOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_pbopt_module_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldOrder.ProtoReflect.Descriptor instead.
+func (*FieldOrder) Descriptor() ([]byte, []int) {
+	return file_pbopt_module_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FieldOrder) GetE() uint32 {
+	if x != nil {
+		return x.E
+	}
+	return 0
+}
+
+func (x *FieldOrder) GetC() string {
+	if x != nil {
+		return x.C
+	}
+	return ""
+}
+
+func (x *FieldOrder) GetD() bool {
+	if x != nil && x.D != nil {
+		return *x.D
+	}
+	return false
+}
+
+func (x *FieldOrder) GetList() []uint32 {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *FieldOrder) GetA() uint64 {
+	if x != nil {
+		return x.A
+	}
+	return 0
+}
+
+// 第一个字段属于 oneof
+type OneofFirst struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to First:
+	//	*OneofFirst_S
+	//	*OneofFirst_U
+	First isOneofFirst_First `protobuf_oneof:"first"`
+	Map   map[string]uint32  `protobuf:"bytes,2,rep,name=map,proto3" json:"map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Bool  *Bool              `protobuf:"bytes,7,opt,name=bool,proto3" json:"bool,omitempty"`
+	// Types that are assignable to Second:
+	//	*OneofFirst_T
+	//	*OneofFirst_B
+	Second isOneofFirst_Second `protobuf_oneof:"second"`
+}
+
+func (x *OneofFirst) Reset() {
+	*x = OneofFirst{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pbopt_module_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OneofFirst) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OneofFirst) ProtoMessage() {}
+
+func (x *OneofFirst) ProtoReflect() protoreflect.Message {
+	mi := &file_pbopt_module_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OneofFirst.ProtoReflect.Descriptor instead.
+func (*OneofFirst) Descriptor() ([]byte, []int) {
+	return file_pbopt_module_proto_rawDescGZIP(), []int{13}
+}
+
+func (m *OneofFirst) GetFirst() isOneofFirst_First {
+	if m != nil {
+		return m.First
+	}
+	return nil
+}
+
+func (x *OneofFirst) GetS() string {
+	if x, ok := x.GetFirst().(*OneofFirst_S); ok {
+		return x.S
+	}
+	return ""
+}
+
+func (x *OneofFirst) GetU() uint32 {
+	if x, ok := x.GetFirst().(*OneofFirst_U); ok {
+		return x.U
+	}
+	return 0
+}
+
+func (x *OneofFirst) GetMap() map[string]uint32 {
+	if x != nil {
+		return x.Map
+	}
+	return nil
+}
+
+func (x *OneofFirst) GetBool() *Bool {
+	if x != nil {
+		return x.Bool
+	}
+	return nil
+}
+
+func (m *OneofFirst) GetSecond() isOneofFirst_Second {
+	if m != nil {
+		return m.Second
+	}
+	return nil
+}
+
+func (x *OneofFirst) GetT() string {
+	if x, ok := x.GetSecond().(*OneofFirst_T); ok {
+		return x.T
+	}
+	return ""
+}
+
+func (x *OneofFirst) GetB() *Bool {
+	if x, ok := x.GetSecond().(*OneofFirst_B); ok {
+		return x.B
+	}
+	return nil
+}
+
+type isOneofFirst_First interface {
+	isOneofFirst_First()
+}
+
+type OneofFirst_S struct {
+	S string `protobuf:"bytes,3,opt,name=s,proto3,oneof"`
+}
+
+type OneofFirst_U struct {
+	U uint32 `protobuf:"varint,4,opt,name=u,proto3,oneof"`
+}
+
+func (*OneofFirst_S) isOneofFirst_First() {}
+
+func (*OneofFirst_U) isOneofFirst_First() {}
+
+type isOneofFirst_Second interface {
+	isOneofFirst_Second()
+}
+
+type OneofFirst_T struct {
+	T string `protobuf:"bytes,8,opt,name=t,proto3,oneof"`
+}
+
+type OneofFirst_B struct {
+	B *Bool `protobuf:"bytes,9,opt,name=b,proto3,oneof"`
+}
+
+func (*OneofFirst_T) isOneofFirst_Second() {}
+
+func (*OneofFirst_B) isOneofFirst_Second() {}
+
+// 只有一个有条件写出的字段, 且编号不是 1
+type Single struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	S string `protobuf:"bytes,2,opt,name=s,proto3" json:"s,omitempty"`
+}
+
+func (x *Single) Reset() {
+	*x = Single{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pbopt_module_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Single) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Single) ProtoMessage() {}
+
+func (x *Single) ProtoReflect() protoreflect.Message {
+	mi := &file_pbopt_module_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Single.ProtoReflect.Descriptor instead.
+func (*Single) Descriptor() ([]byte, []int) {
+	return file_pbopt_module_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Single) GetS() string {
+	if x != nil {
+		return x.S
+	}
+	return ""
+}
+
+type WellKnown struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp  *timestamppb.Timestamp          `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Duration   *durationpb.Duration            `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	Timestamps []*timestamppb.Timestamp        `protobuf:"bytes,3,rep,name=timestamps,proto3" json:"timestamps,omitempty"`
+	Durations  map[string]*durationpb.Duration `protobuf:"bytes,4,rep,name=durations,proto3" json:"durations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Types that are assignable to Time:
+	//	*WellKnown_At
+	//	*WellKnown_After
+	Time    isWellKnown_Time          `protobuf_oneof:"time"`
+	Mask    *fieldmaskpb.FieldMask    `protobuf:"bytes,7,opt,name=mask,proto3" json:"mask,omitempty"`
+	Empty   *emptypb.Empty            `protobuf:"bytes,8,opt,name=empty,proto3" json:"empty,omitempty"`
+	Masks   []*fieldmaskpb.FieldMask  `protobuf:"bytes,9,rep,name=masks,proto3" json:"masks,omitempty"`
+	Empties map[string]*emptypb.Empty `protobuf:"bytes,10,rep,name=empties,proto3" json:"empties,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *WellKnown) Reset() {
+	*x = WellKnown{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pbopt_module_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WellKnown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WellKnown) ProtoMessage() {}
+
+func (x *WellKnown) ProtoReflect() protoreflect.Message {
+	mi := &file_pbopt_module_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WellKnown.ProtoReflect.Descriptor instead.
+func (*WellKnown) Descriptor() ([]byte, []int) {
+	return file_pbopt_module_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WellKnown) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *WellKnown) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *WellKnown) GetTimestamps() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamps
+	}
+	return nil
+}
+
+func (x *WellKnown) GetDurations() map[string]*durationpb.Duration {
+	if x != nil {
+		return x.Durations
+	}
+	return nil
+}
+
+func (m *WellKnown) GetTime() isWellKnown_Time {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (x *WellKnown) GetAt() *timestamppb.Timestamp {
+	if x, ok := x.GetTime().(*WellKnown_At); ok {
+		return x.At
+	}
+	return nil
+}
+
+func (x *WellKnown) GetAfter() *durationpb.Duration {
+	if x, ok := x.GetTime().(*WellKnown_After); ok {
+		return x.After
+	}
+	return nil
+}
+
+func (x *WellKnown) GetMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.Mask
+	}
+	return nil
+}
+
+func (x *WellKnown) GetEmpty() *emptypb.Empty {
+	if x != nil {
+		return x.Empty
+	}
+	return nil
+}
+
+func (x *WellKnown) GetMasks() []*fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.Masks
+	}
+	return nil
+}
+
+func (x *WellKnown) GetEmpties() map[string]*emptypb.Empty {
+	if x != nil {
+		return x.Empties
+	}
+	return nil
+}
+
+type isWellKnown_Time interface {
+	isWellKnown_Time()
+}
+
+type WellKnown_At struct {
+	At *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=at,proto3,oneof"`
+}
+
+type WellKnown_After struct {
+	After *durationpb.Duration `protobuf:"bytes,6,opt,name=after,proto3,oneof"`
+}
+
+func (*WellKnown_At) isWellKnown_Time() {}
+
+func (*WellKnown_After) isWellKnown_Time() {}
+
+type Structs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Struct  *structpb.Struct            `protobuf:"bytes,1,opt,name=struct,proto3" json:"struct,omitempty"`
+	Value   *structpb.Value             `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	List    *structpb.ListValue         `protobuf:"bytes,3,opt,name=list,proto3" json:"list,omitempty"`
+	Values  []*structpb.Value           `protobuf:"bytes,4,rep,name=values,proto3" json:"values,omitempty"`
+	Structs map[string]*structpb.Struct `protobuf:"bytes,5,rep,name=structs,proto3" json:"structs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Types that are assignable to Oneof:
+	//	*Structs_Ov
+	//	*Structs_Os
+	Oneof isStructs_Oneof `protobuf_oneof:"oneof"`
+}
+
+func (x *Structs) Reset() {
+	*x = Structs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pbopt_module_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Structs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Structs) ProtoMessage() {}
+
+func (x *Structs) ProtoReflect() protoreflect.Message {
+	mi := &file_pbopt_module_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Structs.ProtoReflect.Descriptor instead.
+func (*Structs) Descriptor() ([]byte, []int) {
+	return file_pbopt_module_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Structs) GetStruct() *structpb.Struct {
+	if x != nil {
+		return x.Struct
+	}
+	return nil
+}
+
+func (x *Structs) GetValue() *structpb.Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *Structs) GetList() *structpb.ListValue {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *Structs) GetValues() []*structpb.Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *Structs) GetStructs() map[string]*structpb.Struct {
+	if x != nil {
+		return x.Structs
+	}
+	return nil
+}
+
+func (m *Structs) GetOneof() isStructs_Oneof {
+	if m != nil {
+		return m.Oneof
+	}
+	return nil
+}
+
+func (x *Structs) GetOv() *structpb.Value {
+	if x, ok := x.GetOneof().(*Structs_Ov); ok {
+		return x.Ov
+	}
+	return nil
+}
+
+func (x *Structs) GetOs() *structpb.Struct {
+	if x, ok := x.GetOneof().(*Structs_Os); ok {
+		return x.Os
+	}
+	return nil
+}
+
+type isStructs_Oneof interface {
+	isStructs_Oneof()
+}
+
+type Structs_Ov struct {
+	Ov *structpb.Value `protobuf:"bytes,6,opt,name=ov,proto3,oneof"`
+}
+
+type Structs_Os struct {
+	Os *structpb.Struct `protobuf:"bytes,7,opt,name=os,proto3,oneof"`
+}
+
+func (*Structs_Ov) isStructs_Oneof() {}
+
+func (*Structs_Os) isStructs_Oneof() {}
+
+type Anys struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Any  *anypb.Any            `protobuf:"bytes,1,opt,name=any,proto3" json:"any,omitempty"`
+	Anys []*anypb.Any          `protobuf:"bytes,2,rep,name=anys,proto3" json:"anys,omitempty"`
+	Map  map[string]*anypb.Any `protobuf:"bytes,3,rep,name=map,proto3" json:"map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Anys) Reset() {
+	*x = Anys{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pbopt_module_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Anys) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Anys) ProtoMessage() {}
+
+func (x *Anys) ProtoReflect() protoreflect.Message {
+	mi := &file_pbopt_module_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Anys.ProtoReflect.Descriptor instead.
+func (*Anys) Descriptor() ([]byte, []int) {
+	return file_pbopt_module_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Anys) GetAny() *anypb.Any {
+	if x != nil {
+		return x.Any
+	}
+	return nil
+}
+
+func (x *Anys) GetAnys() []*anypb.Any {
+	if x != nil {
+		return x.Anys
+	}
+	return nil
+}
+
+func (x *Anys) GetMap() map[string]*anypb.Any {
+	if x != nil {
+		return x.Map
+	}
+	return nil
+}
+
+type Wrappers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	F64   *wrapperspb.DoubleValue            `protobuf:"bytes,1,opt,name=f64,proto3" json:"f64,omitempty"`
+	F32   *wrapperspb.FloatValue             `protobuf:"bytes,2,opt,name=f32,proto3" json:"f32,omitempty"`
+	I64   *wrapperspb.Int64Value             `protobuf:"bytes,3,opt,name=i64,proto3" json:"i64,omitempty"`
+	U64   *wrapperspb.UInt64Value            `protobuf:"bytes,4,opt,name=u64,proto3" json:"u64,omitempty"`
+	I32   *wrapperspb.Int32Value             `protobuf:"bytes,5,opt,name=i32,proto3" json:"i32,omitempty"`
+	U32   *wrapperspb.UInt32Value            `protobuf:"bytes,6,opt,name=u32,proto3" json:"u32,omitempty"`
+	B     *wrapperspb.BoolValue              `protobuf:"bytes,7,opt,name=b,proto3" json:"b,omitempty"`
+	Str   *wrapperspb.StringValue            `protobuf:"bytes,8,opt,name=str,proto3" json:"str,omitempty"`
+	Bytes *wrapperspb.BytesValue             `protobuf:"bytes,9,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	I64S  []*wrapperspb.Int64Value           `protobuf:"bytes,10,rep,name=i64s,proto3" json:"i64s,omitempty"`
+	Strs  map[string]*wrapperspb.StringValue `protobuf:"bytes,11,rep,name=strs,proto3" json:"strs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Types that are assignable to Oneof:
+	//	*Wrappers_Ob
+	//	*Wrappers_Of64
+	Oneof isWrappers_Oneof `protobuf_oneof:"oneof"`
+}
+
+func (x *Wrappers) Reset() {
+	*x = Wrappers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pbopt_module_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Wrappers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Wrappers) ProtoMessage() {}
+
+func (x *Wrappers) ProtoReflect() protoreflect.Message {
+	mi := &file_pbopt_module_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Wrappers.ProtoReflect.Descriptor instead.
+func (*Wrappers) Descriptor() ([]byte, []int) {
+	return file_pbopt_module_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Wrappers) GetF64() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.F64
+	}
+	return nil
+}
+
+func (x *Wrappers) GetF32() *wrapperspb.FloatValue {
+	if x != nil {
+		return x.F32
+	}
+	return nil
+}
+
+func (x *Wrappers) GetI64() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.I64
+	}
+	return nil
+}
+
+func (x *Wrappers) GetU64() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.U64
+	}
+	return nil
+}
+
+func (x *Wrappers) GetI32() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.I32
+	}
+	return nil
+}
+
+func (x *Wrappers) GetU32() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.U32
+	}
+	return nil
+}
+
+func (x *Wrappers) GetB() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.B
+	}
+	return nil
+}
+
+func (x *Wrappers) GetStr() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Str
+	}
+	return nil
+}
+
+func (x *Wrappers) GetBytes() *wrapperspb.BytesValue {
+	if x != nil {
+		return x.Bytes
+	}
+	return nil
+}
+
+func (x *Wrappers) GetI64S() []*wrapperspb.Int64Value {
+	if x != nil {
+		return x.I64S
+	}
+	return nil
+}
+
+func (x *Wrappers) GetStrs() map[string]*wrapperspb.StringValue {
+	if x != nil {
+		return x.Strs
+	}
+	return nil
+}
+
+func (m *Wrappers) GetOneof() isWrappers_Oneof {
+	if m != nil {
+		return m.Oneof
+	}
+	return nil
+}
+
+func (x *Wrappers) GetOb() *wrapperspb.BoolValue {
+	if x, ok := x.GetOneof().(*Wrappers_Ob); ok {
+		return x.Ob
+	}
+	return nil
+}
+
+func (x *Wrappers) GetOf64() *wrapperspb.DoubleValue {
+	if x, ok := x.GetOneof().(*Wrappers_Of64); ok {
+		return x.Of64
+	}
+	return nil
+}
+
+type isWrappers_Oneof interface {
+	isWrappers_Oneof()
+}
+
+type Wrappers_Ob struct {
+	Ob *wrapperspb.BoolValue `protobuf:"bytes,12,opt,name=ob,proto3,oneof"`
+}
+
+type Wrappers_Of64 struct {
+	Of64 *wrapperspb.DoubleValue `protobuf:"bytes,13,opt,name=of64,proto3,oneof"`
+}
+
+func (*Wrappers_Ob) isWrappers_Oneof() {}
+
+func (*Wrappers_Of64) isWrappers_Oneof() {}
+
+type UnsafeTest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Sub:
+	//	*UnsafeTest_Sub1_
+	//	*UnsafeTest_Sub2_
+	//	*UnsafeTest_Sub3_
+	//	*UnsafeTest_Sub4_
+	Sub isUnsafeTest_Sub `protobuf_oneof:"sub"`
+}
+
+func (x *UnsafeTest) Reset() {
+	*x = UnsafeTest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pbopt_module_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsafeTest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsafeTest) ProtoMessage() {}
+
+func (x *UnsafeTest) ProtoReflect() protoreflect.Message {
+	mi := &file_pbopt_module_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsafeTest.ProtoReflect.Descriptor instead.
+func (*UnsafeTest) Descriptor() ([]byte, []int) {
+	return file_pbopt_module_proto_rawDescGZIP(), []int{19}
+}
+
+func (m *UnsafeTest) GetSub() isUnsafeTest_Sub {
+	if m != nil {
+		return m.Sub
+	}
+	return nil
+}
+
+func (x *UnsafeTest) GetSub1() *UnsafeTest_Sub1 {
+	if x, ok := x.GetSub().(*UnsafeTest_Sub1_); ok {
+		return x.Sub1
+	}
+	return nil
+}
+
+func (x *UnsafeTest) GetSub2() *UnsafeTest_Sub2 {
+	if x, ok := x.GetSub().(*UnsafeTest_Sub2_); ok {
+		return x.Sub2
+	}
+	return nil
+}
+
+func (x *UnsafeTest) GetSub3() *UnsafeTest_Sub3 {
+	if x, ok := x.GetSub().(*UnsafeTest_Sub3_); ok {
+		return x.Sub3
+	}
+	return nil
+}
+
+func (x *UnsafeTest) GetSub4() *UnsafeTest_Sub4 {
+	if x, ok := x.GetSub().(*UnsafeTest_Sub4_); ok {
+		return x.Sub4
+	}
+	return nil
+}
+
+type isUnsafeTest_Sub interface {
+	isUnsafeTest_Sub()
+}
+
+type UnsafeTest_Sub1_ struct {
+	Sub1 *UnsafeTest_Sub1 `protobuf:"bytes,1,opt,name=sub1,proto3,oneof"`
+}
+
+type UnsafeTest_Sub2_ struct {
+	Sub2 *UnsafeTest_Sub2 `protobuf:"bytes,2,opt,name=sub2,proto3,oneof"`
+}
+
+type UnsafeTest_Sub3_ struct {
+	Sub3 *UnsafeTest_Sub3 `protobuf:"bytes,3,opt,name=sub3,proto3,oneof"`
+}
+
+type UnsafeTest_Sub4_ struct {
+	Sub4 *UnsafeTest_Sub4 `protobuf:"bytes,4,opt,name=sub4,proto3,oneof"`
+}
+
+func (*UnsafeTest_Sub1_) isUnsafeTest_Sub() {}
+
+func (*UnsafeTest_Sub2_) isUnsafeTest_Sub() {}
+
+func (*UnsafeTest_Sub3_) isUnsafeTest_Sub() {}
+
+func (*UnsafeTest_Sub4_) isUnsafeTest_Sub() {}
+
+type UnsafeTest_Sub1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	S string `protobuf:"bytes,1,opt,name=s,proto3" json:"s,omitempty"`
+	B []byte `protobuf:"bytes,2,opt,name=b,proto3" json:"b,omitempty"`
+}
+
+func (x *UnsafeTest_Sub1) Reset() {
+	*x = UnsafeTest_Sub1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pbopt_module_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsafeTest_Sub1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsafeTest_Sub1) ProtoMessage() {}
+
+func (x *UnsafeTest_Sub1) ProtoReflect() protoreflect.Message {
+	mi := &file_pbopt_module_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsafeTest_Sub1.ProtoReflect.Descriptor instead.
+func (*UnsafeTest_Sub1) Descriptor() ([]byte, []int) {
+	return file_pbopt_module_proto_rawDescGZIP(), []int{19, 0}
+}
+
+func (x *UnsafeTest_Sub1) GetS() string {
+	if x != nil {
+		return x.S
+	}
+	return ""
+}
+
+func (x *UnsafeTest_Sub1) GetB() []byte {
+	if x != nil {
+		return x.B
+	}
+	return nil
+}
+
+type UnsafeTest_Sub2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	S []string `protobuf:"bytes,1,rep,name=s,proto3" json:"s,omitempty"`
+	B [][]byte `protobuf:"bytes,2,rep,name=b,proto3" json:"b,omitempty"`
+}
+
+func (x *UnsafeTest_Sub2) Reset() {
+	*x = UnsafeTest_Sub2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pbopt_module_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsafeTest_Sub2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsafeTest_Sub2) ProtoMessage() {}
+
+func (x *UnsafeTest_Sub2) ProtoReflect() protoreflect.Message {
+	mi := &file_pbopt_module_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsafeTest_Sub2.ProtoReflect.Descriptor instead.
+func (*UnsafeTest_Sub2) Descriptor() ([]byte, []int) {
+	return file_pbopt_module_proto_rawDescGZIP(), []int{19, 1}
+}
+
+func (x *UnsafeTest_Sub2) GetS() []string {
+	if x != nil {
+		return x.S
+	}
+	return nil
+}
+
+func (x *UnsafeTest_Sub2) GetB() [][]byte {
+	if x != nil {
+		return x.B
+	}
+	return nil
+}
+
+type UnsafeTest_Sub3 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Foo map[string]*UnsafeTest_Sub2 `protobuf:"bytes,1,rep,name=foo,proto3" json:"foo,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *UnsafeTest_Sub3) Reset() {
+	*x = UnsafeTest_Sub3{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pbopt_module_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsafeTest_Sub3) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsafeTest_Sub3) ProtoMessage() {}
+
+func (x *UnsafeTest_Sub3) ProtoReflect() protoreflect.Message {
+	mi := &file_pbopt_module_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsafeTest_Sub3.ProtoReflect.Descriptor instead.
+func (*UnsafeTest_Sub3) Descriptor() ([]byte, []int) {
+	return file_pbopt_module_proto_rawDescGZIP(), []int{19, 2}
+}
+
+func (x *UnsafeTest_Sub3) GetFoo() map[string]*UnsafeTest_Sub2 {
+	if x != nil {
+		return x.Foo
+	}
+	return nil
+}
+
+type UnsafeTest_Sub4 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Foo:
+	//	*UnsafeTest_Sub4_S
+	//	*UnsafeTest_Sub4_B
+	Foo isUnsafeTest_Sub4_Foo `protobuf_oneof:"foo"`
+}
+
+func (x *UnsafeTest_Sub4) Reset() {
+	*x = UnsafeTest_Sub4{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pbopt_module_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsafeTest_Sub4) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsafeTest_Sub4) ProtoMessage() {}
+
+func (x *UnsafeTest_Sub4) ProtoReflect() protoreflect.Message {
+	mi := &file_pbopt_module_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsafeTest_Sub4.ProtoReflect.Descriptor instead.
+func (*UnsafeTest_Sub4) Descriptor() ([]byte, []int) {
+	return file_pbopt_module_proto_rawDescGZIP(), []int{19, 3}
+}
+
+func (m *UnsafeTest_Sub4) GetFoo() isUnsafeTest_Sub4_Foo {
+	if m != nil {
+		return m.Foo
+	}
+	return nil
+}
+
+func (x *UnsafeTest_Sub4) GetS() string {
+	if x, ok := x.GetFoo().(*UnsafeTest_Sub4_S); ok {
+		return x.S
+	}
+	return ""
+}
+
+func (x *UnsafeTest_Sub4) GetB() []byte {
+	if x, ok := x.GetFoo().(*UnsafeTest_Sub4_B); ok {
+		return x.B
+	}
+	return nil
+}
+
+type isUnsafeTest_Sub4_Foo interface {
+	isUnsafeTest_Sub4_Foo()
+}
+
+type UnsafeTest_Sub4_S struct {
+	S string `protobuf:"bytes,1,opt,name=s,proto3,oneof"`
+}
+
+type UnsafeTest_Sub4_B struct {
+	B []byte `protobuf:"bytes,2,opt,name=b,proto3,oneof"`
+}
+
+func (*UnsafeTest_Sub4_S) isUnsafeTest_Sub4_Foo() {}
+
+func (*UnsafeTest_Sub4_B) isUnsafeTest_Sub4_Foo() {}
+
+var File_pbopt_module_proto protoreflect.FileDescriptor
+
+var file_pbopt_module_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x1a, 0x19, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe8, 0x01, 0x0a, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x33, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x75, 0x33,
+	0x32, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x36, 0x34, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03,
+	0x75, 0x36, 0x34, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x33, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x11,
+	0x52, 0x03, 0x73, 0x33, 0x32, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x36, 0x34, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x12, 0x52, 0x03, 0x73, 0x36, 0x34, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x66, 0x33, 0x32, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x07, 0x52, 0x04, 0x75, 0x66, 0x33, 0x32, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x66, 0x36, 0x34, 0x18, 0x06, 0x20, 0x01, 0x28, 0x06, 0x52, 0x04, 0x75, 0x66, 0x36, 0x34, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x66, 0x33, 0x32, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0f, 0x52, 0x04, 0x73,
+	0x66, 0x33, 0x32, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x66, 0x36, 0x34, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x10, 0x52, 0x04, 0x73, 0x66, 0x36, 0x34, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x33, 0x32, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x69, 0x33, 0x32, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x36, 0x34,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x69, 0x36, 0x34, 0x12, 0x10, 0x0a, 0x03, 0x66,
+	0x36, 0x34, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x66, 0x36, 0x34, 0x12, 0x10, 0x0a,
+	0x03, 0x66, 0x33, 0x32, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x03, 0x66, 0x33, 0x32, 0x22,
+	0xec, 0x01, 0x0a, 0x0a, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x33, 0x32, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x03, 0x75, 0x33, 0x32,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x36, 0x34, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x03, 0x75,
+	0x36, 0x34, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x33, 0x32, 0x18, 0x03, 0x20, 0x03, 0x28, 0x11, 0x52,
+	0x03, 0x73, 0x33, 0x32, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x36, 0x34, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x12, 0x52, 0x03, 0x73, 0x36, 0x34, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x66, 0x33, 0x32, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x07, 0x52, 0x04, 0x75, 0x66, 0x33, 0x32, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x66,
+	0x36, 0x34, 0x18, 0x06, 0x20, 0x03, 0x28, 0x06, 0x52, 0x04, 0x75, 0x66, 0x36, 0x34, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x66, 0x33, 0x32, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0f, 0x52, 0x04, 0x73, 0x66,
+	0x33, 0x32, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x66, 0x36, 0x34, 0x18, 0x08, 0x20, 0x03, 0x28, 0x10,
+	0x52, 0x04, 0x73, 0x66, 0x36, 0x34, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x33, 0x32, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x05, 0x52, 0x03, 0x69, 0x33, 0x32, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x36, 0x34, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x69, 0x36, 0x34, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x36,
+	0x34, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x01, 0x52, 0x03, 0x66, 0x36, 0x34, 0x12, 0x10, 0x0a, 0x03,
+	0x66, 0x33, 0x32, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x02, 0x52, 0x03, 0x66, 0x33, 0x32, 0x22, 0xd7,
+	0x09, 0x0a, 0x09, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x12, 0x2b, 0x0a, 0x03,
+	0x75, 0x33, 0x32, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x62, 0x6f, 0x70,
+	0x74, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x2e, 0x55, 0x33, 0x32, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x75, 0x33, 0x32, 0x12, 0x2b, 0x0a, 0x03, 0x75, 0x36, 0x34,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x2e, 0x55, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x03, 0x75, 0x36, 0x34, 0x12, 0x2b, 0x0a, 0x03, 0x73, 0x33, 0x32, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x4d, 0x61, 0x70, 0x2e, 0x53, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03,
+	0x73, 0x33, 0x32, 0x12, 0x2b, 0x0a, 0x03, 0x73, 0x36, 0x34, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4d,
+	0x61, 0x70, 0x2e, 0x53, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x73, 0x36, 0x34,
+	0x12, 0x2e, 0x0a, 0x04, 0x75, 0x66, 0x33, 0x32, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x61, 0x70,
+	0x2e, 0x55, 0x66, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x75, 0x66, 0x33, 0x32,
+	0x12, 0x2e, 0x0a, 0x04, 0x75, 0x66, 0x36, 0x34, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x61, 0x70,
+	0x2e, 0x55, 0x66, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x75, 0x66, 0x36, 0x34,
+	0x12, 0x2e, 0x0a, 0x04, 0x73, 0x66, 0x33, 0x32, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x61, 0x70,
+	0x2e, 0x53, 0x66, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x73, 0x66, 0x33, 0x32,
+	0x12, 0x2e, 0x0a, 0x04, 0x73, 0x66, 0x36, 0x34, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x61, 0x70,
+	0x2e, 0x53, 0x66, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x73, 0x66, 0x36, 0x34,
+	0x12, 0x2b, 0x0a, 0x03, 0x69, 0x33, 0x32, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x2e,
+	0x49, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x69, 0x33, 0x32, 0x12, 0x2b, 0x0a,
+	0x03, 0x69, 0x36, 0x34, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x62, 0x6f,
+	0x70, 0x74, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x2e, 0x49, 0x36, 0x34,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x69, 0x36, 0x34, 0x12, 0x2b, 0x0a, 0x03, 0x66, 0x36,
+	0x34, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x2e, 0x46, 0x36, 0x34, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x03, 0x66, 0x36, 0x34, 0x12, 0x2b, 0x0a, 0x03, 0x66, 0x33, 0x32, 0x18, 0x0c,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x2e, 0x46, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x03, 0x66, 0x33, 0x32, 0x1a, 0x36, 0x0a, 0x08, 0x55, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x36, 0x0a, 0x08,
+	0x55, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x36, 0x0a, 0x08, 0x53, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x11, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x11, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x36, 0x0a, 0x08,
+	0x53, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x12, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x12, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x37, 0x0a, 0x09, 0x55, 0x66, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x07, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x07, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x37, 0x0a,
+	0x09, 0x55, 0x66, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x06, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x37, 0x0a, 0x09, 0x53, 0x66, 0x33, 0x32, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0f,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x37, 0x0a, 0x09, 0x53, 0x66, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x10, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x10, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x36, 0x0a, 0x08, 0x49, 0x33, 0x32, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x36, 0x0a, 0x08, 0x49, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x36, 0x0a, 0x08, 0x46, 0x36, 0x34, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x36, 0x0a, 0x08, 0x46, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x30, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x74, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x73, 0x74, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0x14, 0x0a, 0x04, 0x42, 0x6f,
+	0x6f, 0x6c, 0x12, 0x0c, 0x0a, 0x01, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x01, 0x62,
+	0x22, 0x9b, 0x02, 0x0a, 0x05, 0x45, 0x6e, 0x75, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74,
+	0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x6f,
+	0x70, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x27,
+	0x0a, 0x03, 0x6d, 0x61, 0x70, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62,
+	0x6f, 0x70, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x73, 0x2e, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x03, 0x6d, 0x61, 0x70, 0x12, 0x2e, 0x0a, 0x04, 0x6e, 0x75, 0x6c, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x04, 0x6e, 0x75, 0x6c, 0x6c, 0x12, 0x30, 0x0a, 0x05, 0x6e, 0x75, 0x6c, 0x6c, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x05, 0x6e, 0x75, 0x6c, 0x6c, 0x73, 0x1a, 0x43, 0x0a, 0x08, 0x4d, 0x61, 0x70,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x99,
+	0x01, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74,
+	0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62,
+	0x6f, 0x70, 0x74, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x04, 0x62, 0x6f, 0x6f,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x22, 0x99, 0x02, 0x0a, 0x05, 0x41,
+	0x72, 0x72, 0x61, 0x79, 0x12, 0x27, 0x0a, 0x07, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x27, 0x0a,
+	0x07, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x05, 0x62, 0x6f, 0x6f, 0x6c, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x42, 0x6f,
+	0x6f, 0x6c, 0x52, 0x05, 0x62, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x2a, 0x0a, 0x08, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62,
+	0x6f, 0x70, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x06, 0x61, 0x72, 0x72, 0x61, 0x79, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x41, 0x72,
+	0x72, 0x61, 0x79, 0x52, 0x06, 0x61, 0x72, 0x72, 0x61, 0x79, 0x73, 0x12, 0x21, 0x0a, 0x05, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x6f,
+	0x70, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x33, 0x32, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x04, 0x75, 0x33,
+	0x32, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x73, 0x74, 0x72, 0x73, 0x22, 0xa9, 0x0a, 0x0a, 0x03, 0x4d, 0x61, 0x70, 0x12, 0x31,
+	0x0a, 0x07, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x4d, 0x61, 0x70, 0x2e, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x4d, 0x61, 0x70, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x2b, 0x0a, 0x05, 0x62, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x4d, 0x61, 0x70, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x62, 0x6f, 0x6f, 0x6c,
+	0x73, 0x12, 0x34, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x4d, 0x61, 0x70, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x61, 0x72, 0x72, 0x61, 0x79,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e,
+	0x4d, 0x61, 0x70, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x06, 0x61, 0x72, 0x72, 0x61, 0x79, 0x73, 0x12, 0x2b, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x4d,
+	0x61, 0x70, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x33, 0x32, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x4d, 0x61, 0x70, 0x2e, 0x55,
+	0x33, 0x32, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x75, 0x33, 0x32, 0x73, 0x12, 0x28,
+	0x0a, 0x04, 0x73, 0x74, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
+	0x62, 0x6f, 0x70, 0x74, 0x2e, 0x4d, 0x61, 0x70, 0x2e, 0x53, 0x74, 0x72, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x04, 0x73, 0x74, 0x72, 0x73, 0x12, 0x31, 0x0a, 0x07, 0x65, 0x6d, 0x70, 0x74,
+	0x69, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x6f, 0x70,
+	0x74, 0x2e, 0x4d, 0x61, 0x70, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x4d, 0x61, 0x70, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x4d, 0x61, 0x70,
+	0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6f, 0x6e,
+	0x65, 0x6f, 0x66, 0x73, 0x1a, 0x49, 0x0a, 0x0c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x49, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x23, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x45, 0x0a, 0x0a, 0x42, 0x6f,
+	0x6f, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x6f, 0x70,
+	0x74, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x4b, 0x0a, 0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x47,
+	0x0a, 0x0b, 0x41, 0x72, 0x72, 0x61, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x22, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x45, 0x0a, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x37,
+	0x0a, 0x09, 0x55, 0x33, 0x32, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x37, 0x0a, 0x09, 0x53, 0x74, 0x72, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x48, 0x0a, 0x0c, 0x45, 0x6d, 0x70, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x22, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4d, 0x0a, 0x0e, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x25,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x47, 0x0a, 0x0b, 0x4f, 0x6e, 0x65,
+	0x6f, 0x66, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x6f, 0x70,
+	0x74, 0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x82, 0x03, 0x0a, 0x08,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74,
+	0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x48, 0x01, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01,
+	0x12, 0x24, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x48, 0x02, 0x52, 0x04, 0x62,
+	0x6f, 0x6f, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x03, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x05, 0x61, 0x72, 0x72, 0x61, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x41, 0x72, 0x72,
+	0x61, 0x79, 0x48, 0x04, 0x52, 0x05, 0x61, 0x72, 0x72, 0x61, 0x79, 0x88, 0x01, 0x01, 0x12, 0x24,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x70,
+	0x62, 0x6f, 0x70, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x48, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x75, 0x33, 0x32, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x48, 0x06, 0x52, 0x03, 0x75, 0x33, 0x32, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x73,
+	0x74, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x03, 0x73, 0x74, 0x72, 0x88,
+	0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x62, 0x6f, 0x6f,
+	0x6c, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x61, 0x72, 0x72, 0x61, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x42, 0x06, 0x0a, 0x04, 0x5f, 0x75, 0x33, 0x32, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x73, 0x74, 0x72,
+	0x22, 0xf4, 0x02, 0x0a, 0x05, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x12, 0x25, 0x0a, 0x06, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x6f,
+	0x70, 0x74, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x04, 0x62, 0x6f,
+	0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x48, 0x00, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x12, 0x2a, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x61, 0x72, 0x72,
+	0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74,
+	0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x48, 0x00, 0x52, 0x05, 0x61, 0x72, 0x72, 0x61, 0x79, 0x12,
+	0x21, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e,
+	0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x48, 0x00, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x12, 0x0a, 0x03, 0x75, 0x33, 0x32, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x00, 0x52, 0x03, 0x75, 0x33, 0x32, 0x12, 0x12, 0x0a, 0x03, 0x73, 0x74, 0x72, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x73, 0x74, 0x72, 0x12, 0x28, 0x0a, 0x08, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x5f, 0x78, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70,
+	0x62, 0x6f, 0x70, 0x74, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x58, 0x12, 0x28, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x78,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x58, 0x42, 0x07,
+	0x0a, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x22, 0x6f, 0x0a, 0x0a, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0c, 0x0a, 0x01, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x01, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01,
+	0x63, 0x12, 0x11, 0x0a, 0x01, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x01,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0d, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x01, 0x61, 0x42, 0x04, 0x0a, 0x02, 0x5f, 0x64, 0x4a, 0x04, 0x08, 0x04,
+	0x10, 0x05, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x09, 0x22, 0xf9, 0x01, 0x0a, 0x0a, 0x4f, 0x6e, 0x65,
+	0x6f, 0x66, 0x46, 0x69, 0x72, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x01, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x01, 0x73, 0x12, 0x0e, 0x0a, 0x01, 0x75, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x48, 0x00, 0x52, 0x01, 0x75, 0x12, 0x2c, 0x0a, 0x03, 0x6d, 0x61, 0x70, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x4f, 0x6e, 0x65,
+	0x6f, 0x66, 0x46, 0x69, 0x72, 0x73, 0x74, 0x2e, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x03, 0x6d, 0x61, 0x70, 0x12, 0x1f, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
+	0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x12, 0x0e, 0x0a, 0x01, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x01, 0x74, 0x12, 0x1b, 0x0a, 0x01, 0x62, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x48, 0x01,
+	0x52, 0x01, 0x62, 0x1a, 0x36, 0x0a, 0x08, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x4a, 0x04,
+	0x08, 0x01, 0x10, 0x02, 0x22, 0x16, 0x0a, 0x06, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x12, 0x0c,
+	0x0a, 0x01, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x73, 0x22, 0xd6, 0x05, 0x0a,
+	0x09, 0x57, 0x65, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0a, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x12, 0x3d, 0x0a, 0x09, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x62, 0x6f,
+	0x70, 0x74, 0x2e, 0x57, 0x65, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x02, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00,
+	0x52, 0x02, 0x61, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00,
+	0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x6b, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73,
+	0x6b, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x6b, 0x12, 0x2c, 0x0a, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x05,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x6d, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b,
+	0x52, 0x05, 0x6d, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x37, 0x0a, 0x07, 0x65, 0x6d, 0x70, 0x74, 0x69,
+	0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74,
+	0x2e, 0x57, 0x65, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x69,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x65, 0x73,
+	0x1a, 0x57, 0x0a, 0x0e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x52, 0x0a, 0x0c, 0x45, 0x6d, 0x70,
+	0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x06, 0x0a,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xb2, 0x03, 0x0a, 0x07, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x73, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x06, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x2e, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74,
+	0x12, 0x2e, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x12, 0x35, 0x0a, 0x07, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x73, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x02, 0x6f, 0x76, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x02, 0x6f,
+	0x76, 0x12, 0x29, 0x0a, 0x02, 0x6f, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x73, 0x1a, 0x53, 0x0a, 0x0c,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x42, 0x07, 0x0a, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x22, 0xce, 0x01, 0x0a, 0x04, 0x41,
+	0x6e, 0x79, 0x73, 0x12, 0x26, 0x0a, 0x03, 0x61, 0x6e, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x03, 0x61, 0x6e, 0x79, 0x12, 0x28, 0x0a, 0x04, 0x61,
+	0x6e, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52,
+	0x04, 0x61, 0x6e, 0x79, 0x73, 0x12, 0x26, 0x0a, 0x03, 0x6d, 0x61, 0x70, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x41, 0x6e, 0x79, 0x73, 0x2e,
+	0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x6d, 0x61, 0x70, 0x1a, 0x4c, 0x0a,
+	0x08, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd6, 0x05, 0x0a, 0x08,
+	0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x03, 0x66, 0x36, 0x34, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x03, 0x66, 0x36, 0x34, 0x12, 0x2d, 0x0a, 0x03, 0x66, 0x33, 0x32, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x03, 0x66, 0x33, 0x32, 0x12, 0x2d, 0x0a, 0x03, 0x69, 0x36, 0x34, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x03, 0x69, 0x36, 0x34, 0x12, 0x2e, 0x0a, 0x03, 0x75, 0x36, 0x34, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x03, 0x75, 0x36, 0x34, 0x12, 0x2d, 0x0a, 0x03, 0x69, 0x33, 0x32, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x03, 0x69, 0x33, 0x32, 0x12, 0x2e, 0x0a, 0x03, 0x75, 0x33, 0x32, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x03, 0x75, 0x33, 0x32, 0x12, 0x28, 0x0a, 0x01, 0x62, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x01, 0x62, 0x12,
+	0x2e, 0x0a, 0x03, 0x73, 0x74, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x73, 0x74, 0x72, 0x12,
+	0x31, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x2f, 0x0a, 0x04, 0x69, 0x36, 0x34, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x69,
+	0x36, 0x34, 0x73, 0x12, 0x2d, 0x0a, 0x04, 0x73, 0x74, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65,
+	0x72, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x73, 0x74,
+	0x72, 0x73, 0x12, 0x2c, 0x0a, 0x02, 0x6f, 0x62, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x62,
+	0x12, 0x32, 0x0a, 0x04, 0x6f, 0x66, 0x36, 0x34, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x04,
+	0x6f, 0x66, 0x36, 0x34, 0x1a, 0x55, 0x0a, 0x09, 0x53, 0x74, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x6f,
+	0x6e, 0x65, 0x6f, 0x66, 0x22, 0xce, 0x03, 0x0a, 0x0a, 0x55, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x54,
+	0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x73, 0x75, 0x62, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x55, 0x6e, 0x73, 0x61, 0x66, 0x65,
+	0x54, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x31, 0x48, 0x00, 0x52, 0x04, 0x73, 0x75, 0x62,
+	0x31, 0x12, 0x2c, 0x0a, 0x04, 0x73, 0x75, 0x62, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x55, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x54, 0x65,
+	0x73, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x32, 0x48, 0x00, 0x52, 0x04, 0x73, 0x75, 0x62, 0x32, 0x12,
+	0x2c, 0x0a, 0x04, 0x73, 0x75, 0x62, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x70, 0x62, 0x6f, 0x70, 0x74, 0x2e, 0x55, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x54, 0x65, 0x73, 0x74,
+	0x2e, 0x53, 0x75, 0x62, 0x33, 0x48, 0x00, 0x52, 0x04, 0x73, 0x75, 0x62, 0x33, 0x12, 0x2c, 0x0a,
+	0x04, 0x73, 0x75, 0x62, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62,
+	0x6f, 0x70, 0x74, 0x2e, 0x55, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x54, 0x65, 0x73, 0x74, 0x2e, 0x53,
+	0x75, 0x62, 0x34, 0x48, 0x00, 0x52, 0x04, 0x73, 0x75, 0x62, 0x34, 0x1a, 0x22, 0x0a, 0x04, 0x53,
+	0x75, 0x62, 0x31, 0x12, 0x0c, 0x0a, 0x01, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01,
+	0x73, 0x12, 0x0c, 0x0a, 0x01, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x01, 0x62, 0x1a,
+	0x22, 0x0a, 0x04, 0x53, 0x75, 0x62, 0x32, 0x12, 0x0c, 0x0a, 0x01, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x01, 0x73, 0x12, 0x0c, 0x0a, 0x01, 0x62, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c,
+	0x52, 0x01, 0x62, 0x1a, 0x89, 0x01, 0x0a, 0x04, 0x53, 0x75, 0x62, 0x33, 0x12, 0x31, 0x0a, 0x03,
+	0x66, 0x6f, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x62, 0x6f, 0x70,
+	0x74, 0x2e, 0x55, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x54, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x62,
+	0x33, 0x2e, 0x46, 0x6f, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x66, 0x6f, 0x6f, 0x1a,
+	0x4e, 0x0a, 0x08, 0x46, 0x6f, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70,
+	0x62, 0x6f, 0x70, 0x74, 0x2e, 0x55, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x54, 0x65, 0x73, 0x74, 0x2e,
+	0x53, 0x75, 0x62, 0x32, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x2d, 0x0a, 0x04, 0x53, 0x75, 0x62, 0x34, 0x12, 0x0e, 0x0a, 0x01, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x01, 0x73, 0x12, 0x0e, 0x0a, 0x01, 0x62, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x48, 0x00, 0x52, 0x01, 0x62, 0x42, 0x05, 0x0a, 0x03, 0x66, 0x6f, 0x6f, 0x42, 0x05,
+	0x0a, 0x03, 0x73, 0x75, 0x62, 0x2a, 0x28, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a,
+	0x06, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x52,
+	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x4f, 0x4f, 0x4c, 0x10, 0x02, 0x42,
+	0x09, 0x5a, 0x07, 0x2e, 0x2f, 0x70, 0x62, 0x6f, 0x70, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_pbopt_module_proto_rawDescOnce sync.Once
+	file_pbopt_module_proto_rawDescData = file_pbopt_module_proto_rawDesc
+)
+
+func file_pbopt_module_proto_rawDescGZIP() []byte {
+	file_pbopt_module_proto_rawDescOnce.Do(func() {
+		file_pbopt_module_proto_rawDescData = protoimpl.X.CompressGZIP(file_pbopt_module_proto_rawDescData)
+	})
+	return file_pbopt_module_proto_rawDescData
+}
+
+var file_pbopt_module_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_pbopt_module_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
+var file_pbopt_module_proto_goTypes = []interface{}{
+	(Type)(0),                      // 0: pbopt.Type
+	(*Number)(nil),                 // 1: pbopt.Number
+	(*NumberList)(nil),             // 2: pbopt.NumberList
+	(*NumberMap)(nil),              // 3: pbopt.NumberMap
+	(*String)(nil),                 // 4: pbopt.String
+	(*Bool)(nil),                   // 5: pbopt.Bool
+	(*Enums)(nil),                  // 6: pbopt.Enums
+	(*Message)(nil),                // 7: pbopt.Message
+	(*Array)(nil),                  // 8: pbopt.Array
+	(*Map)(nil),                    // 9: pbopt.Map
+	(*Empty)(nil),                  // 10: pbopt.Empty
+	(*Optional)(nil),               // 11: pbopt.Optional
+	(*Oneof)(nil),                  // 12: pbopt.Oneof
+	(*FieldOrder)(nil),             // 13: pbopt.FieldOrder
+	(*OneofFirst)(nil),             // 14: pbopt.OneofFirst
+	(*Single)(nil),                 // 15: pbopt.Single
+	(*WellKnown)(nil),              // 16: pbopt.WellKnown
+	(*Structs)(nil),                // 17: pbopt.Structs
+	(*Anys)(nil),                   // 18: pbopt.Anys
+	(*Wrappers)(nil),               // 19: pbopt.Wrappers
+	(*UnsafeTest)(nil),             // 20: pbopt.UnsafeTest
+	nil,                            // 21: pbopt.NumberMap.U32Entry
+	nil,                            // 22: pbopt.NumberMap.U64Entry
+	nil,                            // 23: pbopt.NumberMap.S32Entry
+	nil,                            // 24: pbopt.NumberMap.S64Entry
+	nil,                            // 25: pbopt.NumberMap.Uf32Entry
+	nil,                            // 26: pbopt.NumberMap.Uf64Entry
+	nil,                            // 27: pbopt.NumberMap.Sf32Entry
+	nil,                            // 28: pbopt.NumberMap.Sf64Entry
+	nil,                            // 29: pbopt.NumberMap.I32Entry
+	nil,                            // 30: pbopt.NumberMap.I64Entry
+	nil,                            // 31: pbopt.NumberMap.F64Entry
+	nil,                            // 32: pbopt.NumberMap.F32Entry
+	nil,                            // 33: pbopt.Enums.MapEntry
+	nil,                            // 34: pbopt.Map.NumbersEntry
+	nil,                            // 35: pbopt.Map.StringsEntry
+	nil,                            // 36: pbopt.Map.BoolsEntry
+	nil,                            // 37: pbopt.Map.MessagesEntry
+	nil,                            // 38: pbopt.Map.ArraysEntry
+	nil,                            // 39: pbopt.Map.TypesEntry
+	nil,                            // 40: pbopt.Map.U32sEntry
+	nil,                            // 41: pbopt.Map.StrsEntry
+	nil,                            // 42: pbopt.Map.EmptiesEntry
+	nil,                            // 43: pbopt.Map.OptionalsEntry
+	nil,                            // 44: pbopt.Map.OneofsEntry
+	nil,                            // 45: pbopt.OneofFirst.MapEntry
+	nil,                            // 46: pbopt.WellKnown.DurationsEntry
+	nil,                            // 47: pbopt.WellKnown.EmptiesEntry
+	nil,                            // 48: pbopt.Structs.StructsEntry
+	nil,                            // 49: pbopt.Anys.MapEntry
+	nil,                            // 50: pbopt.Wrappers.StrsEntry
+	(*UnsafeTest_Sub1)(nil),        // 51: pbopt.UnsafeTest.Sub1
+	(*UnsafeTest_Sub2)(nil),        // 52: pbopt.UnsafeTest.Sub2
+	(*UnsafeTest_Sub3)(nil),        // 53: pbopt.UnsafeTest.Sub3
+	(*UnsafeTest_Sub4)(nil),        // 54: pbopt.UnsafeTest.Sub4
+	nil,                            // 55: pbopt.UnsafeTest.Sub3.FooEntry
+	(structpb.NullValue)(0),        // 56: google.protobuf.NullValue
+	(*timestamppb.Timestamp)(nil),  // 57: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),    // 58: google.protobuf.Duration
+	(*fieldmaskpb.FieldMask)(nil),  // 59: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),          // 60: google.protobuf.Empty
+	(*structpb.Struct)(nil),        // 61: google.protobuf.Struct
+	(*structpb.Value)(nil),         // 62: google.protobuf.Value
+	(*structpb.ListValue)(nil),     // 63: google.protobuf.ListValue
+	(*anypb.Any)(nil),              // 64: google.protobuf.Any
+	(*wrapperspb.DoubleValue)(nil), // 65: google.protobuf.DoubleValue
+	(*wrapperspb.FloatValue)(nil),  // 66: google.protobuf.FloatValue
+	(*wrapperspb.Int64Value)(nil),  // 67: google.protobuf.Int64Value
+	(*wrapperspb.UInt64Value)(nil), // 68: google.protobuf.UInt64Value
+	(*wrapperspb.Int32Value)(nil),  // 69: google.protobuf.Int32Value
+	(*wrapperspb.UInt32Value)(nil), // 70: google.protobuf.UInt32Value
+	(*wrapperspb.BoolValue)(nil),   // 71: google.protobuf.BoolValue
+	(*wrapperspb.StringValue)(nil), // 72: google.protobuf.StringValue
+	(*wrapperspb.BytesValue)(nil),  // 73: google.protobuf.BytesValue
+}
+var file_pbopt_module_proto_depIdxs = []int32{
+	21,  // 0: pbopt.NumberMap.u32:type_name -> pbopt.NumberMap.U32Entry
+	22,  // 1: pbopt.NumberMap.u64:type_name -> pbopt.NumberMap.U64Entry
+	23,  // 2: pbopt.NumberMap.s32:type_name -> pbopt.NumberMap.S32Entry
+	24,  // 3: pbopt.NumberMap.s64:type_name -> pbopt.NumberMap.S64Entry
+	25,  // 4: pbopt.NumberMap.uf32:type_name -> pbopt.NumberMap.Uf32Entry
+	26,  // 5: pbopt.NumberMap.uf64:type_name -> pbopt.NumberMap.Uf64Entry
+	27,  // 6: pbopt.NumberMap.sf32:type_name -> pbopt.NumberMap.Sf32Entry
+	28,  // 7: pbopt.NumberMap.sf64:type_name -> pbopt.NumberMap.Sf64Entry
+	29,  // 8: pbopt.NumberMap.i32:type_name -> pbopt.NumberMap.I32Entry
+	30,  // 9: pbopt.NumberMap.i64:type_name -> pbopt.NumberMap.I64Entry
+	31,  // 10: pbopt.NumberMap.f64:type_name -> pbopt.NumberMap.F64Entry
+	32,  // 11: pbopt.NumberMap.f32:type_name -> pbopt.NumberMap.F32Entry
+	0,   // 12: pbopt.Enums.type:type_name -> pbopt.Type
+	0,   // 13: pbopt.Enums.types:type_name -> pbopt.Type
+	33,  // 14: pbopt.Enums.map:type_name -> pbopt.Enums.MapEntry
+	56,  // 15: pbopt.Enums.null:type_name -> google.protobuf.NullValue
+	56,  // 16: pbopt.Enums.nulls:type_name -> google.protobuf.NullValue
+	0,   // 17: pbopt.Message.type:type_name -> pbopt.Type
+	1,   // 18: pbopt.Message.number:type_name -> pbopt.Number
+	4,   // 19: pbopt.Message.string:type_name -> pbopt.String
+	5,   // 20: pbopt.Message.bool:type_name -> pbopt.Bool
+	1,   // 21: pbopt.Array.numbers:type_name -> pbopt.Number
+	4,   // 22: pbopt.Array.strings:type_name -> pbopt.String
+	5,   // 23: pbopt.Array.bools:type_name -> pbopt.Bool
+	7,   // 24: pbopt.Array.messages:type_name -> pbopt.Message
+	8,   // 25: pbopt.Array.arrays:type_name -> pbopt.Array
+	0,   // 26: pbopt.Array.types:type_name -> pbopt.Type
+	34,  // 27: pbopt.Map.numbers:type_name -> pbopt.Map.NumbersEntry
+	35,  // 28: pbopt.Map.strings:type_name -> pbopt.Map.StringsEntry
+	36,  // 29: pbopt.Map.bools:type_name -> pbopt.Map.BoolsEntry
+	37,  // 30: pbopt.Map.messages:type_name -> pbopt.Map.MessagesEntry
+	38,  // 31: pbopt.Map.arrays:type_name -> pbopt.Map.ArraysEntry
+	39,  // 32: pbopt.Map.types:type_name -> pbopt.Map.TypesEntry
+	40,  // 33: pbopt.Map.u32s:type_name -> pbopt.Map.U32sEntry
+	41,  // 34: pbopt.Map.strs:type_name -> pbopt.Map.StrsEntry
+	42,  // 35: pbopt.Map.empties:type_name -> pbopt.Map.EmptiesEntry
+	43,  // 36: pbopt.Map.optionals:type_name -> pbopt.Map.OptionalsEntry
+	44,  // 37: pbopt.Map.oneofs:type_name -> pbopt.Map.OneofsEntry
+	1,   // 38: pbopt.Optional.number:type_name -> pbopt.Number
+	4,   // 39: pbopt.Optional.string:type_name -> pbopt.String
+	5,   // 40: pbopt.Optional.bool:type_name -> pbopt.Bool
+	7,   // 41: pbopt.Optional.message:type_name -> pbopt.Message
+	8,   // 42: pbopt.Optional.array:type_name -> pbopt.Array
+	0,   // 43: pbopt.Optional.type:type_name -> pbopt.Type
+	1,   // 44: pbopt.Oneof.number:type_name -> pbopt.Number
+	4,   // 45: pbopt.Oneof.string:type_name -> pbopt.String
+	5,   // 46: pbopt.Oneof.bool:type_name -> pbopt.Bool
+	7,   // 47: pbopt.Oneof.message:type_name -> pbopt.Message
+	8,   // 48: pbopt.Oneof.array:type_name -> pbopt.Array
+	0,   // 49: pbopt.Oneof.type:type_name -> pbopt.Type
+	1,   // 50: pbopt.Oneof.number_x:type_name -> pbopt.Number
+	4,   // 51: pbopt.Oneof.string_x:type_name -> pbopt.String
+	45,  // 52: pbopt.OneofFirst.map:type_name -> pbopt.OneofFirst.MapEntry
+	5,   // 53: pbopt.OneofFirst.bool:type_name -> pbopt.Bool
+	5,   // 54: pbopt.OneofFirst.b:type_name -> pbopt.Bool
+	57,  // 55: pbopt.WellKnown.timestamp:type_name -> google.protobuf.Timestamp
+	58,  // 56: pbopt.WellKnown.duration:type_name -> google.protobuf.Duration
+	57,  // 57: pbopt.WellKnown.timestamps:type_name -> google.protobuf.Timestamp
+	46,  // 58: pbopt.WellKnown.durations:type_name -> pbopt.WellKnown.DurationsEntry
+	57,  // 59: pbopt.WellKnown.at:type_name -> google.protobuf.Timestamp
+	58,  // 60: pbopt.WellKnown.after:type_name -> google.protobuf.Duration
+	59,  // 61: pbopt.WellKnown.mask:type_name -> google.protobuf.FieldMask
+	60,  // 62: pbopt.WellKnown.empty:type_name -> google.protobuf.Empty
+	59,  // 63: pbopt.WellKnown.masks:type_name -> google.protobuf.FieldMask
+	47,  // 64: pbopt.WellKnown.empties:type_name -> pbopt.WellKnown.EmptiesEntry
+	61,  // 65: pbopt.Structs.struct:type_name -> google.protobuf.Struct
+	62,  // 66: pbopt.Structs.value:type_name -> google.protobuf.Value
+	63,  // 67: pbopt.Structs.list:type_name -> google.protobuf.ListValue
+	62,  // 68: pbopt.Structs.values:type_name -> google.protobuf.Value
+	48,  // 69: pbopt.Structs.structs:type_name -> pbopt.Structs.StructsEntry
+	62,  // 70: pbopt.Structs.ov:type_name -> google.protobuf.Value
+	61,  // 71: pbopt.Structs.os:type_name -> google.protobuf.Struct
+	64,  // 72: pbopt.Anys.any:type_name -> google.protobuf.Any
+	64,  // 73: pbopt.Anys.anys:type_name -> google.protobuf.Any
+	49,  // 74: pbopt.Anys.map:type_name -> pbopt.Anys.MapEntry
+	65,  // 75: pbopt.Wrappers.f64:type_name -> google.protobuf.DoubleValue
+	66,  // 76: pbopt.Wrappers.f32:type_name -> google.protobuf.FloatValue
+	67,  // 77: pbopt.Wrappers.i64:type_name -> google.protobuf.Int64Value
+	68,  // 78: pbopt.Wrappers.u64:type_name -> google.protobuf.UInt64Value
+	69,  // 79: pbopt.Wrappers.i32:type_name -> google.protobuf.Int32Value
+	70,  // 80: pbopt.Wrappers.u32:type_name -> google.protobuf.UInt32Value
+	71,  // 81: pbopt.Wrappers.b:type_name -> google.protobuf.BoolValue
+	72,  // 82: pbopt.Wrappers.str:type_name -> google.protobuf.StringValue
+	73,  // 83: pbopt.Wrappers.bytes:type_name -> google.protobuf.BytesValue
+	67,  // 84: pbopt.Wrappers.i64s:type_name -> google.protobuf.Int64Value
+	50,  // 85: pbopt.Wrappers.strs:type_name -> pbopt.Wrappers.StrsEntry
+	71,  // 86: pbopt.Wrappers.ob:type_name -> google.protobuf.BoolValue
+	65,  // 87: pbopt.Wrappers.of64:type_name -> google.protobuf.DoubleValue
+	51,  // 88: pbopt.UnsafeTest.sub1:type_name -> pbopt.UnsafeTest.Sub1
+	52,  // 89: pbopt.UnsafeTest.sub2:type_name -> pbopt.UnsafeTest.Sub2
+	53,  // 90: pbopt.UnsafeTest.sub3:type_name -> pbopt.UnsafeTest.Sub3
+	54,  // 91: pbopt.UnsafeTest.sub4:type_name -> pbopt.UnsafeTest.Sub4
+	0,   // 92: pbopt.Enums.MapEntry.value:type_name -> pbopt.Type
+	1,   // 93: pbopt.Map.NumbersEntry.value:type_name -> pbopt.Number
+	4,   // 94: pbopt.Map.StringsEntry.value:type_name -> pbopt.String
+	5,   // 95: pbopt.Map.BoolsEntry.value:type_name -> pbopt.Bool
+	7,   // 96: pbopt.Map.MessagesEntry.value:type_name -> pbopt.Message
+	8,   // 97: pbopt.Map.ArraysEntry.value:type_name -> pbopt.Array
+	0,   // 98: pbopt.Map.TypesEntry.value:type_name -> pbopt.Type
+	10,  // 99: pbopt.Map.EmptiesEntry.value:type_name -> pbopt.Empty
+	11,  // 100: pbopt.Map.OptionalsEntry.value:type_name -> pbopt.Optional
+	12,  // 101: pbopt.Map.OneofsEntry.value:type_name -> pbopt.Oneof
+	58,  // 102: pbopt.WellKnown.DurationsEntry.value:type_name -> google.protobuf.Duration
+	60,  // 103: pbopt.WellKnown.EmptiesEntry.value:type_name -> google.protobuf.Empty
+	61,  // 104: pbopt.Structs.StructsEntry.value:type_name -> google.protobuf.Struct
+	64,  // 105: pbopt.Anys.MapEntry.value:type_name -> google.protobuf.Any
+	72,  // 106: pbopt.Wrappers.StrsEntry.value:type_name -> google.protobuf.StringValue
+	55,  // 107: pbopt.UnsafeTest.Sub3.foo:type_name -> pbopt.UnsafeTest.Sub3.FooEntry
+	52,  // 108: pbopt.UnsafeTest.Sub3.FooEntry.value:type_name -> pbopt.UnsafeTest.Sub2
+	109, // [109:109] is the sub-list for method output_type
+	109, // [109:109] is the sub-list for method input_type
+	109, // [109:109] is the sub-list for extension type_name
+	109, // [109:109] is the sub-list for extension extendee
+	0,   // [0:109] is the sub-list for field type_name
+}
+
+func init() { file_pbopt_module_proto_init() }
+func file_pbopt_module_proto_init() {
+	if File_pbopt_module_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pbopt_module_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Number); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pbopt_module_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NumberList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pbopt_module_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NumberMap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pbopt_module_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*String); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pbopt_module_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Bool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pbopt_module_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Enums); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pbopt_module_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Message); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pbopt_module_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Array); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pbopt_module_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Map); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pbopt_module_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pbopt_module_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Optional); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pbopt_module_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Oneof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pbopt_module_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldOrder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pbopt_module_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OneofFirst); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pbopt_module_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Single); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pbopt_module_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WellKnown); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pbopt_module_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Structs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pbopt_module_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Anys); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pbopt_module_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Wrappers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pbopt_module_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsafeTest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pbopt_module_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsafeTest_Sub1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pbopt_module_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsafeTest_Sub2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pbopt_module_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsafeTest_Sub3); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pbopt_module_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsafeTest_Sub4); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_pbopt_module_proto_msgTypes[10].OneofWrappers = []interface{}{}
+	file_pbopt_module_proto_msgTypes[11].OneofWrappers = []interface{}{
+		(*Oneof_String_)(nil),
+		(*Oneof_Bool)(nil),
+		(*Oneof_Message)(nil),
+		(*Oneof_Array)(nil),
+		(*Oneof_Type)(nil),
+		(*Oneof_U32)(nil),
+		(*Oneof_Str)(nil),
+	}
+	file_pbopt_module_proto_msgTypes[12].OneofWrappers = []interface{}{}
+	file_pbopt_module_proto_msgTypes[13].OneofWrappers = []interface{}{
+		(*OneofFirst_S)(nil),
+		(*OneofFirst_U)(nil),
+		(*OneofFirst_T)(nil),
+		(*OneofFirst_B)(nil),
+	}
+	file_pbopt_module_proto_msgTypes[15].OneofWrappers = []interface{}{
+		(*WellKnown_At)(nil),
+		(*WellKnown_After)(nil),
+	}
+	file_pbopt_module_proto_msgTypes[16].OneofWrappers = []interface{}{
+		(*Structs_Ov)(nil),
+		(*Structs_Os)(nil),
+	}
+	file_pbopt_module_proto_msgTypes[18].OneofWrappers = []interface{}{
+		(*Wrappers_Ob)(nil),
+		(*Wrappers_Of64)(nil),
+	}
+	file_pbopt_module_proto_msgTypes[19].OneofWrappers = []interface{}{
+		(*UnsafeTest_Sub1_)(nil),
+		(*UnsafeTest_Sub2_)(nil),
+		(*UnsafeTest_Sub3_)(nil),
+		(*UnsafeTest_Sub4_)(nil),
+	}
+	file_pbopt_module_proto_msgTypes[53].OneofWrappers = []interface{}{
+		(*UnsafeTest_Sub4_S)(nil),
+		(*UnsafeTest_Sub4_B)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pbopt_module_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   55,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pbopt_module_proto_goTypes,
+		DependencyIndexes: file_pbopt_module_proto_depIdxs,
+		EnumInfos:         file_pbopt_module_proto_enumTypes,
+		MessageInfos:      file_pbopt_module_proto_msgTypes,
+	}.Build()
+	File_pbopt_module_proto = out.File
+	file_pbopt_module_proto_rawDesc = nil
+	file_pbopt_module_proto_goTypes = nil
+	file_pbopt_module_proto_depIdxs = nil
+}